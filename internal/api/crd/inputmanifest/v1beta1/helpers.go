@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/berops/claudie/internal/api/manifest"
@@ -25,6 +26,22 @@ const (
 
 	// SCHEDULED_FOR_DELETION
 	STATUS_SCHEDULED_FOR_DELETION = "SCHEDULED_FOR_DELETION"
+
+	// STATUS_PLANNED indicates that the changes for a not yet created resource were only planned, see [AnnotationDryRun].
+	STATUS_PLANNED = "PLANNED"
+)
+
+const (
+	// AnnotationDryRun if set to "true" on the InputManifest, the changes made to
+	// the InputManifest are only planned and reported in the status of the resource
+	// without being applied.
+	AnnotationDryRun = "claudie.io/dry-run"
+
+	// AnnotationPausedClusters if set on the InputManifest, holds a comma separated list
+	// of the kubernetes clusters for which the reconciliation is paused. Clusters not
+	// listed are resumed. Without the annotation the pause state is left untouched.
+	// The pause state is applied also while the [AnnotationDryRun] is set.
+	AnnotationPausedClusters = "claudie.io/paused-clusters"
 )
 
 // GetNamespacedName returns a string in Namespace/Name format
@@ -89,6 +106,28 @@ func (im *InputManifest) SetUpdateResourceStatus(newStatus InputManifestStatus)
 	im.Status = newStatus
 }
 
+// IsDryRun returns true if the [AnnotationDryRun] is set on the InputManifest.
+func (im *InputManifest) IsDryRun() bool {
+	return im.GetAnnotations()[AnnotationDryRun] == "true"
+}
+
+// PausedClusters returns the names of the clusters listed in the [AnnotationPausedClusters]
+// annotation and whether the annotation is present on the InputManifest.
+func (im *InputManifest) PausedClusters() ([]string, bool) {
+	v, ok := im.GetAnnotations()[AnnotationPausedClusters]
+	if !ok {
+		return nil, false
+	}
+
+	var out []string
+	for c := range strings.SplitSeq(v, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			out = append(out, c)
+		}
+	}
+	return out, true
+}
+
 func (im *InputManifest) SetDeletingStatus() {
 	im.Status.State = STATUS_SCHEDULED_FOR_DELETION
 }
@@ -103,5 +142,7 @@ func (r *Role) IntoManifestRole() manifest.Role {
 		TargetPort:  r.TargetPort,
 		TargetPools: r.TargetPools,
 		Settings:    r.Settings,
+		TLS:         r.TLS,
+		Routes:      r.Routes,
 	}
 }
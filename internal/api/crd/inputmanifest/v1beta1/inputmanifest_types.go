@@ -39,6 +39,7 @@ const (
 	CLOUDRIFT  ProviderType = "cloudrift"
 	VERDA      ProviderType = "verda"
 	OVH        ProviderType = "ovh"
+	RFC2136    ProviderType = "rfc2136"
 )
 
 type SecretField string
@@ -78,6 +79,11 @@ const (
 	OVH_CLIENT_SECRET                SecretField = "clientsecret"
 	OVH_SERVICE_NAME                 SecretField = "servicename"
 	OVH_ENDPOINT                     SecretField = "endpoint"
+	RFC2136_SERVER                   SecretField = "server"
+	RFC2136_PORT                     SecretField = "port"
+	RFC2136_KEY_NAME                 SecretField = "keyname"
+	RFC2136_KEY_ALGORITHM            SecretField = "keyalgorithm"
+	RFC2136_KEY_SECRET               SecretField = "keysecret"
 )
 
 // ProviderWithData helper type that assist in
@@ -92,9 +98,10 @@ type ProviderWithData struct {
 }
 
 type StaticNodeWithData struct {
-	Endpoint string
-	Username string
-	Secret   corev1.Secret
+	Endpoint     string
+	EndpointIPv6 string
+	Username     string
+	Secret       corev1.Secret
 }
 
 type TemplatesReference struct {
@@ -109,7 +116,7 @@ type Provider struct {
 	// +kubebuilder:validation:MaxLength=32
 	// +kubebuilder:validation:MinLength=1
 	ProviderName string `json:"name"`
-	// +kubebuilder:validation:Enum=gcp;hetzner;aws;oci;azure;cloudflare;openstack;exoscale;cloudrift;verda;ovh;
+	// +kubebuilder:validation:Enum=gcp;hetzner;aws;oci;azure;cloudflare;openstack;exoscale;cloudrift;verda;ovh;rfc2136;
 	ProviderType ProviderType           `json:"providerType"`
 	SecretRef    corev1.SecretReference `json:"secretRef"`
 	// External template for building the cluster infrastructure.
@@ -151,6 +158,9 @@ type StaticNodePool struct {
 type StaticNode struct {
 	// Endpoint under which Claudie will access this node.
 	Endpoint string `json:"endpoint"`
+	// Public IPv6 address of a dual-stack node, used alongside the endpoint.
+	// +optional
+	EndpointIPv6 string `json:"endpointIPv6,omitempty"`
 	// Secret reference to the private key of the node.
 	SecretRef corev1.SecretReference `json:"secretRef"`
 	// Username with root access. Used in SSH connection also.
@@ -163,8 +173,8 @@ type Role struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 
-	// Protocol of the rule. Allowed values are: tcp, udp.
-	// +kubebuilder:validation:Enum=tcp;udp;
+	// Protocol of the rule. Allowed values are: tcp, udp, http.
+	// +kubebuilder:validation:Enum=tcp;udp;http;
 	Protocol string `json:"protocol"`
 
 	// Port of the incoming traffic on the loadbalancer.
@@ -184,6 +194,16 @@ type Role struct {
 	// Additional settings for a role.
 	// +optional
 	Settings *manifest.RoleSettings `yaml:"settings,omitempty" json:"settings,omitempty"`
+
+	// TLS termination on the loadbalancer nodes for the endpoint and the alternative names
+	// of the loadbalancer, with certificates obtained via ACME using the DNS-01 challenge.
+	// +optional
+	TLS *manifest.RoleTLS `yaml:"tls,omitempty" json:"tls,omitempty"`
+
+	// Routes of the role with the http protocol, matched by the host and the path prefix of the requests.
+	// Requests not matching any of the routes are forwarded to the targetPools on the targetPort.
+	// +optional
+	Routes []manifest.RoleRoute `yaml:"routes,omitempty" json:"routes,omitempty"`
 }
 
 type LoadBalancer struct {
@@ -222,11 +242,27 @@ type FinishedWorkflow struct {
 	Timestamp       string `json:"timestamp"`
 }
 
+// PlannedTask describes a task that would be scheduled for
+// the cluster, if the InputManifest would be applied.
+type PlannedTask struct {
+	Event       string   `json:"event,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Stages      []string `json:"stages,omitempty"`
+	// Affected nodepools in the format of <cluster>/<nodepool>[: <node>,...]
+	NodePools []string `json:"nodepools,omitempty"`
+}
+
 type ClustersStatus struct {
 	State    string             `json:"state,omitempty"`
 	Phase    string             `json:"phase,omitempty"`
 	Message  string             `json:"message,omitempty"`
 	Previous []FinishedWorkflow `json:"previous"`
+	// Disruptive changes that are deferred until the
+	// next maintenance window of the cluster, if any.
+	Deferred string `json:"deferred,omitempty"`
+	// Tasks that would be scheduled for the cluster, only
+	// set if the InputManifest is annotated for a dry-run.
+	Plan []PlannedTask `json:"plan,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1,6 +1,9 @@
 package manifest
 
 import (
+	"encoding/json"
+	"strconv"
+
 	k8sV1 "k8s.io/api/core/v1"
 )
 
@@ -58,6 +61,7 @@ type Provider struct {
 	CloudRift  []CloudRift  `yaml:"cloudrift"`
 	Verda      []Verda      `yaml:"verda"`
 	OVH        []OVH        `yaml:"ovh"`
+	RFC2136    []RFC2136    `yaml:"rfc2136"`
 }
 
 type Cloudflare struct {
@@ -154,6 +158,21 @@ type OVH struct {
 	Templates    *TemplateRepository `validate:"omitempty" yaml:"templates" json:"templates"`
 }
 
+// RFC2136 is a DNS only provider, managing the records of self-hosted DNS zones,
+// e.g. on BIND or PowerDNS, via dynamic updates authenticated with a TSIG key.
+type RFC2136 struct {
+	Name string `validate:"required,max=15" yaml:"name"`
+	// Hostname or IP address of the DNS server accepting the updates.
+	Server string `validate:"required,hostname_rfc1123|ip" yaml:"server"`
+	// Port of the DNS server, defaults to 53.
+	Port         int32  `validate:"omitempty,min=1,max=65535" yaml:"port"`
+	KeyName      string `validate:"required" yaml:"keyName"`
+	KeyAlgorithm string `validate:"omitempty,oneof=hmac-md5 hmac-sha1 hmac-sha224 hmac-sha256 hmac-sha384 hmac-sha512" yaml:"keyAlgorithm"`
+	KeySecret    string `validate:"required,base64" yaml:"keySecret"`
+	// Templates managing the DNS records. Required, as there are no default templates for the provider.
+	Templates *TemplateRepository `validate:"required" yaml:"templates" json:"templates"`
+}
+
 // NodePools describes nodepools used for either kubernetes clusters
 // or loadbalancer cluster defined in this manifest.
 type NodePool struct {
@@ -249,6 +268,73 @@ type DynamicNodePool struct {
 	// Worker pools only. Currently supported on: GCP, Verda, AWS, Azure, OCI.
 	// +optional
 	Spot bool `validate:"omitempty" yaml:"spot,omitempty" json:"spot,omitempty"`
+	// Strategy used when the nodes of the nodepool are replaced by a rolling update,
+	// e.g. on a change of the serverType, image, storageDiskSize or the templates.
+	// +optional
+	RollingUpdate *RollingUpdateStrategy `validate:"omitempty" yaml:"rollingUpdate,omitempty" json:"rollingUpdate,omitempty"`
+	// Policy for draining the nodes of the nodepool before they are deleted from the
+	// kubernetes cluster, e.g. during a rolling update or a scale-down.
+	// Has no effect for nodepools of loadbalancer clusters.
+	// +optional
+	DrainPolicy *DrainPolicy `validate:"omitempty" yaml:"drainPolicy,omitempty" json:"drainPolicy,omitempty"`
+}
+
+// DrainPolicy defines how the nodes of a nodepool are drained before they are deleted.
+// The pods on the node are evicted, respecting their PodDisruptionBudgets.
+type DrainPolicy struct {
+	// Timeout of the drain of a single node, e.g. 30m or 2h. Defaults to 30m.
+	// +optional
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// Action taken once the timeout is reached and some of the pods were not evicted, e.g. as
+	// their eviction is blocked by a PodDisruptionBudget. With "force" the node is deleted regardless,
+	// with "fail" the workflow fails and the drain is retried later. Defaults to "force".
+	// +optional
+	// +kubebuilder:validation:Enum=force;fail
+	OnTimeout string `validate:"omitempty,oneof=force fail" yaml:"onTimeout,omitempty" json:"onTimeout,omitempty"`
+	// Whether to drain a node with pods managed by DaemonSets, which are not evicted.
+	// If false, the drain of such a node fails. Defaults to true.
+	// +optional
+	IgnoreDaemonSets *bool `yaml:"ignoreDaemonSets,omitempty" json:"ignoreDaemonSets,omitempty"`
+	// Whether to evict pods using emptyDir volumes, deleting their data.
+	// If false, the drain of a node with such pods fails. Defaults to true.
+	// +optional
+	DeleteEmptyDirData *bool `yaml:"deleteEmptyDirData,omitempty" json:"deleteEmptyDirData,omitempty"`
+}
+
+// RollingUpdateStrategy defines how many nodes of a nodepool are replaced at once during a rolling update.
+// New nodes are added in batches and the old nodes are drained and deleted only after the new nodes
+// have joined the cluster and are ready.
+type RollingUpdateStrategy struct {
+	// Maximum number of nodes that can be created above the count of the nodepool during the rolling update.
+	// Either an absolute number or a percentage of the count, e.g. "25%". Percentages are rounded up.
+	// Defaults to "100%".
+	// +optional
+	MaxSurge IntOrPercent `validate:"omitempty,intOrPercent" yaml:"maxSurge,omitempty" json:"maxSurge,omitempty"`
+	// Maximum number of nodes of the nodepool that can be unavailable during the rolling update.
+	// Either an absolute number or a percentage of the count, e.g. "25%". Percentages are rounded down.
+	// Defaults to 0.
+	// +optional
+	MaxUnavailable IntOrPercent `validate:"omitempty,intOrPercent" yaml:"maxUnavailable,omitempty" json:"maxUnavailable,omitempty"`
+}
+
+// IntOrPercent is either an absolute number or a percentage, e.g. "25%".
+// +kubebuilder:validation:XIntOrString
+// +kubebuilder:validation:Pattern=`^[0-9]+%?$`
+type IntOrPercent string
+
+// UnmarshalJSON accepts both a JSON number and a JSON string.
+func (v *IntOrPercent) UnmarshalJSON(b []byte) error {
+	var n int64
+	if err := json.Unmarshal(b, &n); err == nil {
+		*v = IntOrPercent(strconv.FormatInt(n, 10))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*v = IntOrPercent(s)
+	return nil
 }
 
 // Autoscaler configuration on per nodepool basis. Defines the number of nodes, autoscaler will scale up or down specific nodepool.
@@ -291,12 +377,20 @@ type StaticNodePool struct {
 	// SSH port used to connect to the static nodes. Defaults to 22 if not set.
 	// +optional
 	SshPort *int32 `validate:"omitempty,min=1,max=65535" yaml:"sshPort" json:"sshPort"`
+	// Policy for draining the nodes of the nodepool before they are deleted from the
+	// kubernetes cluster, e.g. during a rolling update or a scale-down.
+	// Has no effect for nodepools of loadbalancer clusters.
+	// +optional
+	DrainPolicy *DrainPolicy `validate:"omitempty" yaml:"drainPolicy,omitempty" json:"drainPolicy,omitempty"`
 }
 
 // Node represents a static node assigned to a particular static nodepool.
 type Node struct {
 	// Endpoint under which Claudie will connect to the node.
 	Endpoint string `validate:"required,ip_addr" yaml:"endpoint" json:"endpoint"`
+	// Public IPv6 address of a dual-stack node, used alongside the endpoint.
+	// +optional
+	EndpointIPv6 string `validate:"omitempty,ipv6" yaml:"endpointIPv6,omitempty" json:"endpointIPv6,omitempty"`
 	// Private key used to ssh into the node.
 	Key string `validate:"required" yaml:"privateKey" json:"privateKey"`
 	// Username with root access. Used in SSH connection also.
@@ -317,10 +411,57 @@ type Cluster struct {
 	// +kubebuilder:validation:MaxLength=50
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Network is immutable"
 	Network string `validate:"required,cidrv4" yaml:"network" json:"network"`
+	// IPv6 network range for the VPN of the cluster, defined in format X:X::X/mask with a mask
+	// of at most /96. If set, the cluster is built as dual-stack, with the IPv6 addresses of the
+	// nodes derived from their private IPv4 addresses.
+	// +optional
+	// +kubebuilder:validation:MaxLength=50
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="NetworkIPv6 is immutable"
+	NetworkIPv6 string `validate:"omitempty,cidrv6" yaml:"networkIPv6,omitempty" json:"networkIPv6,omitempty"`
 	// List of nodepool names this cluster will use.
 	Pools Pool `yaml:"pools" json:"pools"`
 	// General information about a proxy used to build a K8s cluster.
 	InstallationProxy *InstallationProxy `yaml:"installationProxy,omitempty" json:"installationProxy,omitempty"`
+	// Time windows within which disruptive changes to the cluster, i.e. Kubernetes version
+	// upgrades, rolling updates of nodepools and moves of the API endpoint, are worked on.
+	// Outside of the windows these changes are deferred, while other changes are still applied.
+	// If no windows are defined, all changes are applied immediately.
+	MaintenanceWindows []MaintenanceWindow `validate:"dive" yaml:"maintenanceWindows,omitempty" json:"maintenanceWindows,omitempty"`
+	// Webhooks called on the lifecycle events of the nodes of the cluster, i.e. to coordinate
+	// the replacement of the nodes with the workloads running on them. If undefined, no hooks are called.
+	Hooks *NodeHooks `validate:"omitempty" yaml:"hooks,omitempty" json:"hooks,omitempty"`
+}
+
+// NodeHooks are webhooks called on the lifecycle events of the nodes of a cluster.
+type NodeHooks struct {
+	// Called before a node is cordoned and drained. The node is not drained until
+	// the hook succeeds.
+	PreDrain *NodeHook `validate:"omitempty" yaml:"preDrain,omitempty" json:"preDrain,omitempty"`
+	// Called after a node is joined into the cluster and Ready. The rolling update of
+	// a nodepool does not proceed with the next batch of nodes until the hook succeeds.
+	PostJoin *NodeHook `validate:"omitempty" yaml:"postJoin,omitempty" json:"postJoin,omitempty"`
+}
+
+// NodeHook is an HTTP endpoint to which the lifecycle events of the nodes are posted.
+type NodeHook struct {
+	// URL of the endpoint, i.e. https://hooks.example.com/claudie.
+	Url string `validate:"required,http_url" yaml:"url" json:"url"`
+	// Timeout of a single call of the hook, i.e. 30s or 2m. If undefined, 30s is used.
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+}
+
+// MaintenanceWindow is a weekly recurring time window within which disruptive changes are worked on.
+type MaintenanceWindow struct {
+	// Days of the week on which the window opens, i.e. Mon, Tue, Wed, Thu, Fri, Sat, Sun.
+	// If undefined, the window opens every day.
+	Days []string `yaml:"days,omitempty" json:"days,omitempty"`
+	// Time of the day at which the window opens in the HH:MM format, i.e. 02:30.
+	Start string `validate:"required" yaml:"start" json:"start"`
+	// Duration for which the window stays open, i.e. 4h or 90m. At most 168h.
+	Duration string `validate:"required" yaml:"duration" json:"duration"`
+	// IANA time zone in which the start of the window is interpreted, i.e. Europe/Bratislava.
+	// If undefined, UTC is used.
+	Timezone string `yaml:"timezone,omitempty" json:"timezone,omitempty"`
 }
 
 // List of nodepool names this cluster will use. Remember that nodepools defined in nodepools
@@ -350,14 +491,96 @@ type InstallationProxy struct {
 type RoleSettings struct {
 	ProxyProtocol  bool `yaml:"proxyProtocol" json:"proxyProtocol"`
 	StickySessions bool `yaml:"stickySessions" json:"stickySessions"`
+	// Active health checking of the target nodes. Nodes failing the health checks
+	// will not receive any traffic until they pass the health checks again.
+	// If undefined, no health checks are performed.
+	HealthCheck *HealthCheck `validate:"omitempty" yaml:"healthCheck,omitempty" json:"healthCheck,omitempty"`
+	// Temporary ejection of the target nodes based on the failed connections of the forwarded traffic.
+	// Supported only for roles with the tcp protocol. If undefined, no nodes are ejected.
+	OutlierDetection *OutlierDetection `validate:"omitempty" yaml:"outlierDetection,omitempty" json:"outlierDetection,omitempty"`
+	// Load balancing algorithm choosing the target node for the forwarded traffic.
+	// Allowed values are: round_robin, least_request, ring_hash, maglev, random.
+	// The ring_hash and maglev algorithms hash the source IP, the same as with stickySessions.
+	// If undefined, round_robin is used, or ring_hash if stickySessions is enabled.
+	Algorithm string `validate:"omitempty,oneof=round_robin least_request ring_hash maglev random" yaml:"algorithm,omitempty" json:"algorithm,omitempty"`
+	// Weights of the nodes of the target pools, i.e. to gradually shift the traffic from
+	// one nodepool to another. Nodes of the target pools not listed have a weight of 1.
+	Weights []TargetPoolWeight `validate:"omitempty,dive" yaml:"weights,omitempty" json:"weights,omitempty"`
+	// Source ranges in the CIDR notation from which the role is reachable, i.e. 203.0.113.0/24.
+	// Enforced by envoy and by the firewall of the loadbalancer nodes, if the templates of their
+	// provider use them. Supported only for roles with the tcp or http protocol. If undefined,
+	// the role is reachable from anywhere.
+	AllowedSourceRanges []string `validate:"omitempty,dive,cidr" yaml:"allowedSourceRanges,omitempty" json:"allowedSourceRanges,omitempty"`
+	// Maximum number of concurrent connections accepted by the role on each loadbalancer node.
+	// Supported only for roles with the tcp or http protocol. If undefined, the connections are not limited.
+	MaxConnections int32 `validate:"omitempty,min=1" yaml:"maxConnections,omitempty" json:"maxConnections,omitempty"`
+	// Limit of the rate of the new connections accepted by the role on each loadbalancer node.
+	// Supported only for roles with the tcp or http protocol. If undefined, the rate is not limited.
+	RateLimit *RateLimit `validate:"omitempty" yaml:"rateLimit,omitempty" json:"rateLimit,omitempty"`
+}
+
+// RateLimit defines the limit of the rate of the new connections of a role.
+type RateLimit struct {
+	// Number of new connections accepted per second.
+	ConnectionsPerSecond int32 `validate:"required,min=1" yaml:"connectionsPerSecond" json:"connectionsPerSecond"`
+	// Number of new connections that can be accepted at once, above the rate.
+	// If undefined, connectionsPerSecond is used.
+	Burst int32 `validate:"omitempty,min=1" yaml:"burst,omitempty" json:"burst,omitempty"`
+}
+
+// TargetPoolWeight defines the weight of the nodes of a target pool of a role.
+type TargetPoolWeight struct {
+	// Name of the target pool of the role or of its routes.
+	TargetPool string `validate:"required" yaml:"targetPool" json:"targetPool"`
+	// Weight of each node of the target pool. Nodes with a weight of 0 do not receive any traffic.
+	Weight int32 `validate:"min=0,max=100" yaml:"weight" json:"weight"`
+}
+
+// HealthCheck defines the active health checking of the target nodes of a role.
+type HealthCheck struct {
+	// Protocol of the health check. Allowed values are: tcp, http.
+	// The tcp health check only checks if a connection can be established.
+	Protocol string `validate:"required,oneof=tcp http" yaml:"protocol" json:"protocol"`
+	// Path requested by the http health check, i.e. /healthz. Any response with
+	// a 2xx status code is considered healthy. Required for the http protocol.
+	Path string `yaml:"path,omitempty" json:"path,omitempty"`
+	// Port on the target nodes to which the health checks are sent. If undefined,
+	// the targetPort of the role is used. Required for roles with the udp protocol.
+	Port int32 `validate:"omitempty,min=1,max=65535" yaml:"port,omitempty" json:"port,omitempty"`
+	// Interval between the health checks, i.e. 5s. If undefined, 5s is used.
+	Interval string `yaml:"interval,omitempty" json:"interval,omitempty"`
+	// Time to wait for a health check response, i.e. 2s. Must not be greater than
+	// the interval. If undefined, 2s is used.
+	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+	// Number of consecutive successful health checks after which the node is considered healthy.
+	// If undefined, 2 is used.
+	HealthyThreshold int32 `validate:"omitempty,min=1,max=100" yaml:"healthyThreshold,omitempty" json:"healthyThreshold,omitempty"`
+	// Number of consecutive failed health checks after which the node is considered unhealthy.
+	// If undefined, 3 is used.
+	UnhealthyThreshold int32 `validate:"omitempty,min=1,max=100" yaml:"unhealthyThreshold,omitempty" json:"unhealthyThreshold,omitempty"`
+}
+
+// OutlierDetection defines the ejection of the target nodes of a role based on the failures
+// observed on the forwarded traffic.
+type OutlierDetection struct {
+	// Number of consecutive failed connections after which the node is ejected. If undefined, 5 is used.
+	ConsecutiveFailures int32 `validate:"omitempty,min=1,max=1000" yaml:"consecutiveFailures,omitempty" json:"consecutiveFailures,omitempty"`
+	// Interval at which the ejected nodes are re-evaluated, i.e. 10s. If undefined, 10s is used.
+	Interval string `yaml:"interval,omitempty" json:"interval,omitempty"`
+	// Base duration for which a node is ejected, i.e. 30s. The duration is multiplied
+	// by the number of times the node was ejected. If undefined, 30s is used.
+	BaseEjectionTime string `yaml:"baseEjectionTime,omitempty" json:"baseEjectionTime,omitempty"`
+	// Maximum percentage of the target nodes that can be ejected at the same time,
+	// at least one node can always be ejected. If undefined, 50 is used.
+	MaxEjectionPercent int32 `validate:"omitempty,min=1,max=100" yaml:"maxEjectionPercent,omitempty" json:"maxEjectionPercent,omitempty"`
 }
 
 // Role defines a concrete loadbalancer configuration. Single loadbalancer can have multiple roles.
 type Role struct {
 	// Name of the role. Used as a reference in clusters.
 	Name string `validate:"required" yaml:"name" json:"name"`
-	// Protocol of the rule. Allowed values are: tcp, udp.
-	Protocol string `validate:"required,oneof=tcp udp" yaml:"protocol" json:"protocol"`
+	// Protocol of the rule. Allowed values are: tcp, udp, http.
+	Protocol string `validate:"required,oneof=tcp udp http" yaml:"protocol" json:"protocol"`
 	// Port of the incoming traffic on the loadbalancer.
 	Port int32 `validate:"min=0,max=65535" yaml:"port" json:"port"`
 	// Port where loadbalancer forwards the traffic.
@@ -366,6 +589,35 @@ type Role struct {
 	TargetPools []string `validate:"required,min=1" yaml:"targetPools" json:"targetPools"`
 	// Additional settings for a role.
 	Settings *RoleSettings `yaml:"settings,omitempty" json:"settings,omitempty"`
+	// TLS termination on the loadbalancer nodes for the endpoint and the alternative names
+	// of the loadbalancer, with certificates obtained via ACME using the DNS-01 challenge.
+	TLS *RoleTLS `validate:"omitempty" yaml:"tls,omitempty" json:"tls,omitempty"`
+	// Routes of the role with the http protocol, matched by the host and the path prefix of the requests.
+	// Requests not matching any of the routes are forwarded to the targetPools on the targetPort.
+	Routes []RoleRoute `validate:"omitempty,dive" yaml:"routes,omitempty" json:"routes,omitempty"`
+}
+
+// RoleRoute defines where the matching requests of a role with the http protocol are forwarded to.
+type RoleRoute struct {
+	// Host of the requests matched by the route, i.e. app.example.com or *.example.com.
+	// If undefined, requests for any host are matched.
+	Host string `validate:"omitempty" yaml:"host,omitempty" json:"host,omitempty"`
+	// Path prefix of the requests matched by the route. If undefined, / is used.
+	PathPrefix string `validate:"omitempty,startswith=/" yaml:"pathPrefix,omitempty" json:"pathPrefix,omitempty"`
+	// Nodepools of the targeted K8s cluster, to which the matched requests are forwarded.
+	TargetPools []string `validate:"required,min=1" yaml:"targetPools" json:"targetPools"`
+	// Port where the loadbalancer forwards the matched requests.
+	TargetPort int32 `validate:"min=1,max=65535" yaml:"targetPort" json:"targetPort"`
+}
+
+// RoleTLS defines the TLS termination of a role.
+type RoleTLS struct {
+	// Email used for the registration of the ACME account, to which notices about
+	// the expiring certificates are sent by the certificate authority.
+	Email string `validate:"required,email" yaml:"email" json:"email"`
+	// Directory URL of the ACME certificate authority.
+	// If undefined, the Let's Encrypt production directory is used.
+	Directory string `validate:"omitempty,url" yaml:"directory,omitempty" json:"directory,omitempty"`
 }
 
 // Collection of data used to define a loadbalancer cluster. Defines loadbalancer clusters.
@@ -374,18 +626,60 @@ type LoadBalancerCluster struct {
 	Name string `validate:"required,max=28" yaml:"name" json:"name"`
 	// List of roles the loadbalancer uses.
 	Roles []string `yaml:"roles" json:"roles"`
-	// Specification of the loadbalancer's DNS record.
-	DNS DNS `validate:"required" yaml:"dns,omitempty" json:"dns,omitempty"`
+	// Specification of the loadbalancer's DNS record. Optional if the virtualIP is set,
+	// in which case the virtual IP is used as the endpoint of the loadbalancer.
+	// +optional
+	DNS *DNS `validate:"required_without=VirtualIP,omitempty" yaml:"dns,omitempty" json:"dns,omitempty"`
 	// Name of the Kubernetes cluster targeted by this loadbalancer.
 	TargetedK8s string `validate:"required" yaml:"targetedK8s" json:"targetedK8s"`
 	// List of nodepool names this loadbalancer will use. Remember, that nodepools defined
 	// in nodepools are only "blueprints". The actual nodepool will be created once referenced here.
 	Pools []string `yaml:"pools" json:"pools"`
+	// Designates the loadbalancer for the Kubernetes Services of type LoadBalancer of the
+	// targeted cluster. At most one loadbalancer can be designated per kubernetes cluster.
+	// +optional
+	ServiceLoadBalancer *ServiceLoadBalancer `validate:"omitempty" yaml:"serviceLoadBalancer,omitempty" json:"serviceLoadBalancer,omitempty"`
+	// Floating IP address shared by the nodes of the loadbalancer, which fails over between
+	// them via VRRP. Only supported for loadbalancers built from static nodepools.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="VirtualIP is immutable"
+	VirtualIP *VirtualIP `validate:"omitempty" yaml:"virtualIP,omitempty" json:"virtualIP,omitempty"`
+}
+
+// VirtualIP defines the floating IP address of the loadbalancer, served by keepalived
+// on the nodes of the loadbalancer. The DNS records of the loadbalancer resolve to it,
+// and it is used as the endpoint of the kubernetes api server.
+type VirtualIP struct {
+	// Unused IPv4 address from the network of the loadbalancer nodes.
+	IP string `validate:"required,ipv4" yaml:"ip" json:"ip"`
+	// Network interface on the nodes to which the address is assigned.
+	// Defaults to the interface of the default route.
+	// +optional
+	Interface string `validate:"omitempty,max=15" yaml:"interface,omitempty" json:"interface,omitempty"`
+	// Virtual router ID of the VRRP instance, which has to be unique within the network.
+	// Derived from the name of the loadbalancer if not set.
+	// +optional
+	VirtualRouterID int32 `validate:"omitempty,min=1,max=255" yaml:"virtualRouterID,omitempty" json:"virtualRouterID,omitempty"`
+}
+
+// ServiceLoadBalancer defines how the ports are allocated on the loadbalancer for the
+// Kubernetes Services of type LoadBalancer.
+type ServiceLoadBalancer struct {
+	// Range of the ports on the loadbalancer from which the ports for the services are allocated.
+	PortRange PortRange `validate:"required" yaml:"portRange" json:"portRange"`
+}
+
+// PortRange defines an inclusive range of ports.
+type PortRange struct {
+	// First port of the range.
+	Min int32 `validate:"min=1,max=65535" yaml:"min" json:"min"`
+	// Last port of the range.
+	Max int32 `validate:"min=1,max=65535,gtefield=Min" yaml:"max" json:"max"`
 }
 
 // Collection of data Claudie uses to create a DNS record for the loadbalancer.
 type DNS struct {
-	// DNS zone inside of which the records will be created. GCP/AWS/OCI/Azure/Cloudflare/Hetzner/OVH DNS zone is accepted
+	// DNS zone inside of which the records will be created. GCP/AWS/OCI/Azure/Cloudflare/Hetzner/OVH/RFC2136 DNS zone is accepted
 	DNSZone string `validate:"required" yaml:"dnsZone" json:"dnsZone"`
 	// Name of provider to be used for creating an A record entry in defined DNS zone.
 	Provider string `validate:"required" yaml:"provider" json:"provider"`
@@ -14,7 +14,9 @@ import (
 // scheduled state. Scheduled Manifests will be picked up and individual tasks will be worked on
 // by other services. From this state the Manifest can end up in the Done or Error state. Any changes
 // made to the Input Manifest while in the Scheduled state will be reflected after it has been moved
-// to the Done state. So the Read/Write/Update cycle repeats.
+// to the Done state. So the Read/Write/Update cycle repeats. Manifests in the Done or Error
+// state can also be moved back to the Scheduled state directly, when a task is scheduled outside
+// of the reconciliation of the desired state, i.e. a rollback of a failed task.
 //
 //go:generate stringer -type=State
 type State int
@@ -35,8 +37,8 @@ const (
 var StateTransitionMap = map[State][]State{
 	Pending:   {Pending, Scheduled},
 	Scheduled: {Scheduled, Done, Error},
-	Done:      {Done, Pending},
-	Error:     {Error, Pending},
+	Done:      {Done, Pending, Scheduled},
+	Error:     {Error, Pending, Scheduled},
 }
 
 // ValidStateTransition validates if the state transition is acceptable.
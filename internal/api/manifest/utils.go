@@ -1,10 +1,15 @@
 package manifest
 
 import (
+	"cmp"
 	"fmt"
+	"hash/fnv"
 	"math"
+	"net/netip"
+	"slices"
 	"strings"
 
+	"github.com/berops/claudie/internal/certificates"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/go-git/go-git/v5"
@@ -303,6 +308,39 @@ func (ds *Manifest) GetProvider(providerSpecName string) (*spec.Provider, error)
 		}
 	}
 
+	for _, rConf := range ds.Providers.RFC2136 {
+		if rConf.Name == providerSpecName {
+			t, err := convertToGrpcTemplates(rConf.Templates)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert template for provider %q: %w", rConf.Name, err)
+			}
+			if err := FetchCommitHash(t); err != nil {
+				return nil, err
+			}
+			r := &spec.RFC2136Provider{
+				Server:       rConf.Server,
+				Port:         rConf.Port,
+				KeyName:      rConf.KeyName,
+				KeyAlgorithm: rConf.KeyAlgorithm,
+				KeySecret:    rConf.KeySecret,
+			}
+			if r.Port == 0 {
+				r.Port = 53
+			}
+			if r.KeyAlgorithm == "" {
+				r.KeyAlgorithm = "hmac-sha256"
+			}
+			return &spec.Provider{
+				SpecName: providerSpecName,
+				ProviderType: &spec.Provider_Rfc2136{
+					Rfc2136: r,
+				},
+				CloudProviderName: "rfc2136",
+				Templates:         t,
+			}, nil
+		}
+	}
+
 	return nil, fmt.Errorf("failed to find provider with name: %s", providerSpecName)
 }
 
@@ -385,6 +423,14 @@ func (ds *Manifest) CreateNodepools(pools []string, isControl bool) ([]*spec.Nod
 				nodePool.StorageDiskSize = &defaultDiskSize
 			}
 
+			var rollingUpdate *spec.RollingUpdateStrategy
+			if nodePool.RollingUpdate != nil {
+				rollingUpdate = &spec.RollingUpdateStrategy{
+					MaxSurge:       string(nodePool.RollingUpdate.MaxSurge),
+					MaxUnavailable: string(nodePool.RollingUpdate.MaxUnavailable),
+				}
+			}
+
 			var machineSpec *spec.MachineSpec
 			if nodePool.MachineSpec != nil {
 				// Use NvidiaGpuCount as primary, fall back to deprecated NvidiaGpu for backward compatibility
@@ -406,6 +452,7 @@ func (ds *Manifest) CreateNodepools(pools []string, isControl bool) ([]*spec.Nod
 				Labels:      nodePool.Labels,
 				Annotations: nodePool.Annotations,
 				Taints:      getTaints(nodePool.Taints),
+				DrainPolicy: nodePool.DrainPolicy.CreateDrainPolicy(),
 				// The nodes are left empty, as the desired state
 				// in the manifest does not specify each of the nodes
 				// individually, just the count of the nodes that the
@@ -425,6 +472,7 @@ func (ds *Manifest) CreateNodepools(pools []string, isControl bool) ([]*spec.Nod
 						AutoscalerConfig:    autoscalerConf,
 						MachineSpec:         machineSpec,
 						Spot:                nodePool.Spot,
+						RollingUpdate:       rollingUpdate,
 					},
 				},
 			})
@@ -445,6 +493,7 @@ func (ds *Manifest) CreateNodepools(pools []string, isControl bool) ([]*spec.Nod
 				Annotations: nodePool.Annotations,
 				Taints:      taints,
 				SshPort:     resolveSSHPort(nodePool.SshPort),
+				DrainPolicy: nodePool.DrainPolicy.CreateDrainPolicy(),
 				Type: &spec.NodePool_StaticNodePool{
 					StaticNodePool: &spec.StaticNodePool{
 						NodeKeys: keys,
@@ -539,6 +588,217 @@ func FetchCommitHash(tmpl *spec.TemplateRepository) error {
 	return nil
 }
 
+// CreateDrainPolicy converts the drain policy of a nodepool into its
+// grpc representation with the defaults filled in. Works on a nil policy.
+func (p *DrainPolicy) CreateDrainPolicy() *spec.DrainPolicy {
+	out := spec.DefaultDrainPolicy()
+	if p == nil {
+		return out
+	}
+	if p.Timeout != "" {
+		out.Timeout = p.Timeout
+	}
+	out.FailOnTimeout = p.OnTimeout == "fail"
+	if p.IgnoreDaemonSets != nil {
+		out.IgnoreDaemonSets = *p.IgnoreDaemonSets
+	}
+	if p.DeleteEmptyDirData != nil {
+		out.DeleteEmptyDirData = *p.DeleteEmptyDirData
+	}
+	return out
+}
+
+// CreateNodeHooks converts the node hooks of the cluster into their grpc representation.
+// Returns nil if no hooks are defined.
+func (c *Cluster) CreateNodeHooks() *spec.NodeHooks {
+	if c.Hooks == nil || (c.Hooks.PreDrain == nil && c.Hooks.PostJoin == nil) {
+		return nil
+	}
+	return &spec.NodeHooks{
+		PreDrain: convertToGrpcNodeHook(c.Hooks.PreDrain),
+		PostJoin: convertToGrpcNodeHook(c.Hooks.PostJoin),
+	}
+}
+
+func convertToGrpcNodeHook(h *NodeHook) *spec.NodeHook {
+	if h == nil {
+		return nil
+	}
+	return &spec.NodeHook{
+		Url:     h.Url,
+		Timeout: h.Timeout,
+	}
+}
+
+// CreateHealthCheck converts the health check of the role settings into its grpc
+// representation with the defaults filled in. Returns nil if no health check is defined.
+func (s *RoleSettings) CreateHealthCheck() *spec.Role_HealthCheck {
+	if s == nil || s.HealthCheck == nil {
+		return nil
+	}
+	hc := s.HealthCheck
+
+	// errors are checked during validation.
+	interval, _ := parsePositiveDuration(hc.Interval, DefaultHealthCheckInterval)
+	timeout, _ := parsePositiveDuration(hc.Timeout, DefaultHealthCheckTimeout)
+
+	return &spec.Role_HealthCheck{
+		Protocol:           hc.Protocol,
+		Path:               hc.Path,
+		Port:               hc.Port,
+		IntervalMs:         uint32(interval.Milliseconds()),
+		TimeoutMs:          uint32(timeout.Milliseconds()),
+		HealthyThreshold:   uint32(cmp.Or(hc.HealthyThreshold, DefaultHealthyThreshold)),
+		UnhealthyThreshold: uint32(cmp.Or(hc.UnhealthyThreshold, DefaultUnhealthyThreshold)),
+	}
+}
+
+// CreateOutlierDetection converts the outlier detection of the role settings into its grpc
+// representation with the defaults filled in. Returns nil if no outlier detection is defined.
+func (s *RoleSettings) CreateOutlierDetection() *spec.Role_OutlierDetection {
+	if s == nil || s.OutlierDetection == nil {
+		return nil
+	}
+	od := s.OutlierDetection
+
+	// errors are checked during validation.
+	interval, _ := parsePositiveDuration(od.Interval, DefaultOutlierDetectionInterval)
+	ejection, _ := parsePositiveDuration(od.BaseEjectionTime, DefaultOutlierDetectionBaseEjectionTime)
+
+	return &spec.Role_OutlierDetection{
+		ConsecutiveFailures: uint32(cmp.Or(od.ConsecutiveFailures, DefaultConsecutiveFailures)),
+		IntervalMs:          uint32(interval.Milliseconds()),
+		BaseEjectionTimeMs:  uint32(ejection.Milliseconds()),
+		MaxEjectionPercent:  uint32(cmp.Or(od.MaxEjectionPercent, DefaultMaxEjectionPercent)),
+	}
+}
+
+// CreateTLS converts the tls termination of the role into its grpc representation.
+// Returns nil if no tls termination is defined.
+func (r *Role) CreateTLS() *spec.Role_Tls {
+	if r.TLS == nil {
+		return nil
+	}
+	return &spec.Role_Tls{
+		Email:     r.TLS.Email,
+		Directory: cmp.Or(r.TLS.Directory, certificates.LetsEncryptDirectory),
+	}
+}
+
+// CreateRoutes converts the routes of the role into their grpc representation.
+func (r *Role) CreateRoutes() []*spec.Role_Route {
+	var routes []*spec.Role_Route
+	for _, route := range r.Routes {
+		routes = append(routes, &spec.Role_Route{
+			Host:        route.Host,
+			PathPrefix:  cmp.Or(route.PathPrefix, "/"),
+			TargetPools: route.TargetPools,
+			TargetPort:  route.TargetPort,
+		})
+	}
+	return routes
+}
+
+// CreateServiceLoadBalancer converts the designation of the loadbalancer for the Kubernetes
+// Services into its grpc representation. Returns nil if the loadbalancer is not designated.
+func (m *Manifest) CreateServiceLoadBalancer(lb *LoadBalancerCluster) *spec.ServiceLoadBalancer {
+	if lb.ServiceLoadBalancer == nil {
+		return nil
+	}
+
+	var pools []string
+	for _, k8s := range m.Kubernetes.Clusters {
+		if k8s.Name == lb.TargetedK8s {
+			// node ports are served on every node of the cluster,
+			// the control nodes are only used if there are no others.
+			pools = slices.Clone(k8s.Pools.Compute)
+			if len(pools) == 0 {
+				pools = slices.Clone(k8s.Pools.Control)
+			}
+		}
+	}
+
+	return &spec.ServiceLoadBalancer{
+		MinPort:     lb.ServiceLoadBalancer.PortRange.Min,
+		MaxPort:     lb.ServiceLoadBalancer.PortRange.Max,
+		TargetPools: pools,
+	}
+}
+
+// CreateVirtualIP converts the virtual IP of the loadbalancer into its grpc representation.
+// Returns nil if the loadbalancer has no virtual IP.
+func (m *Manifest) CreateVirtualIP(lb *LoadBalancerCluster) *spec.VirtualIP {
+	if lb.VirtualIP == nil {
+		return nil
+	}
+
+	id := lb.VirtualIP.VirtualRouterID
+	if id == 0 {
+		h := fnv.New32a()
+		h.Write([]byte(lb.Name))
+		id = int32(h.Sum32()%255) + 1
+	}
+
+	return &spec.VirtualIP{
+		Ip:              lb.VirtualIP.IP,
+		Interface:       lb.VirtualIP.Interface,
+		VirtualRouterId: id,
+	}
+}
+
+// CreateAlgorithm converts the algorithm of the role settings into its grpc representation.
+func (s *RoleSettings) CreateAlgorithm() spec.Role_Algorithm {
+	if s == nil || s.Algorithm == "" {
+		return spec.Role_ROUND_ROBIN
+	}
+	// values are checked during validation.
+	return spec.Role_Algorithm(spec.Role_Algorithm_value[strings.ToUpper(s.Algorithm)])
+}
+
+// CreateWeights converts the weights of the role settings into their grpc representation.
+// Returns nil if no weights are defined.
+func (s *RoleSettings) CreateWeights() map[string]uint32 {
+	if s == nil || len(s.Weights) == 0 {
+		return nil
+	}
+
+	weights := make(map[string]uint32, len(s.Weights))
+	for _, w := range s.Weights {
+		weights[w.TargetPool] = uint32(w.Weight)
+	}
+	return weights
+}
+
+// CreateAllowedSourceRanges returns the allowed source ranges of the role settings
+// with the host bits cleared, as required by the firewalls of some of the providers.
+func (s *RoleSettings) CreateAllowedSourceRanges() []string {
+	if s == nil {
+		return nil
+	}
+
+	var ranges []string
+	for _, r := range s.AllowedSourceRanges {
+		// errors are checked during validation.
+		p, _ := netip.ParsePrefix(r)
+		if m := p.Masked().String(); !slices.Contains(ranges, m) {
+			ranges = append(ranges, m)
+		}
+	}
+	return ranges
+}
+
+// CreateRateLimit converts the rate limit of the role settings into its grpc
+// representation with the defaults filled in. Returns nil if no rate limit is defined.
+func (s *RoleSettings) CreateRateLimit() *spec.Role_RateLimit {
+	if s == nil || s.RateLimit == nil {
+		return nil
+	}
+	return &spec.Role_RateLimit{
+		ConnectionsPerSecond: uint32(s.RateLimit.ConnectionsPerSecond),
+		Burst:                uint32(cmp.Or(s.RateLimit.Burst, s.RateLimit.ConnectionsPerSecond)),
+	}
+}
+
 // staticNodes returns slice of static nodes with initialised name.
 func staticNodes(np *StaticNodePool, isControl bool) []*spec.Node {
 	if len(np.Nodes) > math.MaxUint8 {
@@ -562,17 +822,36 @@ func staticNodes(np *StaticNodePool, isControl bool) []*spec.Node {
 			// the Public endpoint should be used which is an Unique Identifier
 			// of the node. If this changes in the future, relevant code may
 			// need to be adjusted.
-			Name:     fmt.Sprintf("%s-%02x", np.Name, uint8(i+1)),
-			Public:   node.Endpoint,
-			NodeType: nodeType,
-			Status:   spec.NodeStatus_Preparing,
-			Username: node.Username,
+			Name:       fmt.Sprintf("%s-%02x", np.Name, uint8(i+1)),
+			Public:     node.Endpoint,
+			PublicIPv6: node.EndpointIPv6,
+			NodeType:   nodeType,
+			Status:     spec.NodeStatus_Preparing,
+			Username:   node.Username,
 		})
 	}
 
 	return nodes
 }
 
+// CreateMaintenanceWindows converts the maintenance windows of the cluster into their grpc representation.
+func (c *Cluster) CreateMaintenanceWindows() []*spec.MaintenanceWindow {
+	var out []*spec.MaintenanceWindow
+	for _, w := range c.MaintenanceWindows {
+		out = append(out, convertToGrpcMaintenanceWindow(&w))
+	}
+	return out
+}
+
+func convertToGrpcMaintenanceWindow(w *MaintenanceWindow) *spec.MaintenanceWindow {
+	return &spec.MaintenanceWindow{
+		Days:     slices.Clone(w.Days),
+		Start:    w.Start,
+		Duration: w.Duration,
+		Timezone: w.Timezone,
+	}
+}
+
 func resolveSSHPort(port *int32) int32 {
 	if port == nil {
 		return nodepools.DefaultSSHPort
@@ -687,6 +966,11 @@ func (ds *Manifest) ForEachProvider(do func(name, typ string) bool) {
 			return
 		}
 	}
+	for _, c := range ds.Providers.RFC2136 {
+		if !do(c.Name, "rfc2136") {
+			return
+		}
+	}
 }
 
 func convertToGrpcTemplates(t *TemplateRepository) (*spec.TemplateRepository, error) {
@@ -20,7 +20,7 @@ func (m *Manifest) Validate() error {
 	providers := len(m.Providers.GCP) + len(m.Providers.Hetzner) + len(m.Providers.AWS) +
 		len(m.Providers.Azure) + len(m.Providers.OCI) + len(m.Providers.Cloudflare) +
 		len(m.Providers.Openstack) + len(m.Providers.Exoscale) + len(m.Providers.CloudRift) +
-		len(m.Providers.Verda) + len(m.Providers.OVH)
+		len(m.Providers.Verda) + len(m.Providers.OVH) + len(m.Providers.RFC2136)
 	if providers < 1 {
 		// Return error only if at least one dynamic nodepool defined.
 		if len(m.NodePools.Dynamic) > 0 {
@@ -81,9 +81,13 @@ func prettyPrintValidationError(err error) error {
 		case "required":
 			nerr = fmt.Errorf("field '%s' is required to be defined", err.StructField())
 		case "ip_addr":
-			nerr = fmt.Errorf("field '%s' is required to have a valid IPv4 address value", err.StructField())
+			nerr = fmt.Errorf("field '%s' is required to have a valid IP address value", err.StructField())
+		case "ipv6":
+			nerr = fmt.Errorf("field '%s' is required to have a valid IPv6 address value", err.StructField())
 		case "cidrv4":
 			nerr = fmt.Errorf("field '%s' is required to have a valid CIDRv4 value", err.StructField())
+		case "cidrv6":
+			nerr = fmt.Errorf("field '%s' is required to have a valid CIDRv6 value", err.StructField())
 		case "ver":
 			nerr = fmt.Errorf("field '%s' is required to have a kubernetes version of: 1.34.x, 1.35.x, 1.36.x", err.StructField())
 		case "proxyMode":
@@ -94,6 +98,12 @@ func prettyPrintValidationError(err error) error {
 			nerr = fmt.Errorf("'%s' needs to be set if '%s' is not specified", err.Field(), err.Param())
 		case "external_net":
 			nerr = fmt.Errorf("field '%s' is required to be defined when using Openstack provider", err.StructField())
+		case "intOrPercent":
+			nerr = fmt.Errorf("field '%s' is required to be either a non-negative number or a percentage between 0%% and 100%%", err.StructField())
+		case "oneof":
+			nerr = fmt.Errorf("field '%s' is required to be one of: %s", err.StructField(), err.Param())
+		case "http_url":
+			nerr = fmt.Errorf("field '%s' is required to be a valid http or https URL", err.StructField())
 		default:
 			nerr = err
 		}
@@ -2,9 +2,12 @@ package manifest
 
 import (
 	"fmt"
+	"net/netip"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -75,6 +78,59 @@ func (c *Cluster) Validate() error {
 	if err := validate.Struct(c); err != nil {
 		return prettyPrintValidationError(err)
 	}
+
+	if c.NetworkIPv6 != "" {
+		network, err := netip.ParsePrefix(c.NetworkIPv6)
+		if err != nil || network.Bits() > 96 {
+			return fmt.Errorf("networkIPv6 %q must have a mask of at most /96 to embed the IPv4 addresses of the nodes", c.NetworkIPv6)
+		}
+		for _, subnet := range []string{spec.PodSubnetIPv6, spec.ServiceSubnetIPv6} {
+			if network.Overlaps(netip.MustParsePrefix(subnet)) {
+				return fmt.Errorf("networkIPv6 %q must not overlap with %s used by the pods and services of the cluster", c.NetworkIPv6, subnet)
+			}
+		}
+	}
+
+	for i, w := range c.MaintenanceWindows {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("invalid maintenance window %d: %w", i, err)
+		}
+	}
+
+	if c.Hooks != nil {
+		if err := c.Hooks.PreDrain.Validate(); err != nil {
+			return fmt.Errorf("invalid preDrain hook: %w", err)
+		}
+		if err := c.Hooks.PostJoin.Validate(); err != nil {
+			return fmt.Errorf("invalid postJoin hook: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (h *NodeHook) Validate() error {
+	if h == nil {
+		return nil
+	}
+	_, err := parsePositiveDuration(h.Timeout, 0)
+	return err
+}
+
+func (w *MaintenanceWindow) Validate() error {
+	d, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q: %w", w.Duration, err)
+	}
+	if d <= 0 || d > spec.MaxMaintenanceWindowDuration {
+		return fmt.Errorf("duration %q must be positive and at most %v", w.Duration, spec.MaxMaintenanceWindowDuration)
+	}
+
+	// parsing the openings of the window validates the remaining fields.
+	if _, err := convertToGrpcMaintenanceWindow(w).Opens(time.Now()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1,10 +1,15 @@
 package manifest
 
 import (
+	"cmp"
 	"fmt"
 	"math"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/berops/claudie/internal/certificates"
+	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -41,6 +46,18 @@ const (
 	ReservedPortRangeStart = ReservedPortRangeEnd - (MaxRolesPerLoadBalancer + AdditionalReservedPorts)
 )
 
+// Defaults used for the health checks and the outlier detection of the roles, if not specified.
+const (
+	DefaultHealthCheckInterval              = 5 * time.Second
+	DefaultHealthCheckTimeout               = 2 * time.Second
+	DefaultHealthyThreshold                 = 2
+	DefaultUnhealthyThreshold               = 3
+	DefaultOutlierDetectionInterval         = 10 * time.Second
+	DefaultOutlierDetectionBaseEjectionTime = 30 * time.Second
+	DefaultConsecutiveFailures              = 5
+	DefaultMaxEjectionPercent               = 50
+)
+
 // Validate validates the parsed data inside the LoadBalancer section of the manifest.
 // It checks for missing/invalid filled out values defined in the LoadBalancer section
 // of the manifest.
@@ -94,10 +111,20 @@ func (l *LoadBalancer) Validate(m *Manifest) error {
 			}
 			targetPoolsDuplicates[np] = true
 		}
+		for _, route := range role.Routes {
+			for _, np := range route.TargetPools {
+				if ok, _ := m.nodePoolDefined(np); !ok {
+					return fmt.Errorf("route of role %q targets undefined nodepool %q", role.Name, np)
+				}
+			}
+		}
 		roles[role.Name] = role
 	}
 
 	apiServerLBExists := make(map[string]bool) // [Targetk8sClusterName]bool
+	serviceLBExists := make(map[string]bool)   // [Targetk8sClusterName]bool
+	virtualIPs := make(map[string]string)      // [VirtualIP]LBClusterName
+	virtualRouterIDs := make(map[int32]string) // [VirtualRouterID]LBClusterName
 	for _, cluster := range l.Clusters {
 		if len(cluster.Roles) > MaxRolesPerLoadBalancer {
 			return fmt.Errorf("a single loadbalancer cannot have more than %v roles assigned", MaxRolesPerLoadBalancer)
@@ -123,7 +150,7 @@ func (l *LoadBalancer) Validate(m *Manifest) error {
 			// check if the target pools of the role are referencing valid nodepools for the k8s cluster.
 			for _, k8s := range m.Kubernetes.Clusters {
 				if k8s.Name == cluster.TargetedK8s {
-					for _, np := range roleDef.TargetPools {
+					for _, np := range roleDef.allTargetPools() {
 						var found bool
 						for _, nnp := range k8s.Pools.Control {
 							found = found || np == nnp
@@ -143,7 +170,7 @@ func (l *LoadBalancer) Validate(m *Manifest) error {
 				if apiServerLBExists[cluster.TargetedK8s] {
 					return fmt.Errorf("role %q is used across multiple load-balancers for k8s-cluster %s. Can have only one kubeapi-server load-balancer per k8s-cluster", role, cluster.TargetedK8s)
 				}
-				if len(cluster.DNS.AlternativeNames) > 0 {
+				if cluster.DNS != nil && len(cluster.DNS.AlternativeNames) > 0 {
 					return fmt.Errorf("cannot have alternative names for the kubeapi-server load-balancer cluster %q", cluster.Name)
 				}
 
@@ -167,41 +194,105 @@ func (l *LoadBalancer) Validate(m *Manifest) error {
 			}
 		}
 
-		// check if alternative names are unique
-		seen := make(map[string]struct{})
-		for _, n := range cluster.DNS.AlternativeNames {
-			if _, ok := seen[n]; ok {
-				return fmt.Errorf("duplicate alternative names %q specified in cluster %q, must be unique", n, cluster.Name)
+		if slb := cluster.ServiceLoadBalancer; slb != nil {
+			if serviceLBExists[cluster.TargetedK8s] {
+				return fmt.Errorf("loadbalancer %q is designated for the services of k8s-cluster %s, but another loadbalancer already is. Can have only one service load-balancer per k8s-cluster", cluster.Name, cluster.TargetedK8s)
 			}
-			if cluster.DNS.Hostname != "" && n == cluster.DNS.Hostname {
-				return fmt.Errorf("alternative name %q has the same value as hostname %q in cluster %q, must be unique", n, cluster.DNS.Hostname, cluster.Name)
+			serviceLBExists[cluster.TargetedK8s] = true
+
+			r := slb.PortRange
+			if r.Max >= ReservedPortRangeStart {
+				return fmt.Errorf("port range [%v, %v] of the service load-balancer %q overlaps with the reserved port range [%v, %v)", r.Min, r.Max, cluster.Name, ReservedPortRangeStart, ReservedPortRangeEnd)
 			}
-			if zone, ok := hostnamesPerDNS[n]; ok && zone == cluster.DNS.DNSZone {
-				return fmt.Errorf("alternative name %q used in cluster %q is used across multiple clusters for the same DNS zone %q, must be unique", n, cluster.Name, zone)
+			if int(r.Max-r.Min+1)+len(cluster.Roles) > MaxRolesPerLoadBalancer {
+				return fmt.Errorf("port range [%v, %v] of the service load-balancer %q together with its roles exceeds the limit of %v roles per load-balancer", r.Min, r.Max, cluster.Name, MaxRolesPerLoadBalancer)
+			}
+			for _, role := range cluster.Roles {
+				if strings.HasPrefix(role, spec.ServiceRolePrefix) {
+					return fmt.Errorf("role %q used inside the service load-balancer %q uses the prefix %q reserved for the roles of the services", role, cluster.Name, spec.ServiceRolePrefix)
+				}
+				if p := roles[role].Port; p >= r.Min && p <= r.Max {
+					return fmt.Errorf("role %q used inside the service load-balancer %q uses port %v from the port range [%v, %v] of the services", role, cluster.Name, p, r.Min, r.Max)
+				}
 			}
-			seen[n] = struct{}{}
-			hostnamesPerDNS[n] = cluster.DNS.DNSZone
 		}
 
-		// check if the requested hostname is unique per DNS-ZONE
-		if zone, ok := hostnamesPerDNS[cluster.DNS.Hostname]; ok && zone == cluster.DNS.DNSZone {
-			return fmt.Errorf("hostname %q used in cluster %q is used across multiple clusters for the same DNS zone %q, must be unique", cluster.DNS.Hostname, cluster.Name, zone)
-		}
+		if cluster.VirtualIP != nil {
+			// VRRP relies on the nodes sharing a network, which is not the case for the dynamic nodepools.
+			for _, pool := range cluster.Pools {
+				if _, static := m.nodePoolDefined(pool); !static {
+					return fmt.Errorf("virtual IP of cluster %q is only supported for static nodepools, %q is not a static nodepool", cluster.Name, pool)
+				}
+			}
 
-		if cluster.DNS.Hostname != "" {
-			hostnamesPerDNS[cluster.DNS.Hostname] = cluster.DNS.DNSZone
+			vip := m.CreateVirtualIP(&cluster)
+			if other, ok := virtualIPs[vip.Ip]; ok {
+				return fmt.Errorf("virtual IP %q of cluster %q is already used by cluster %q, must be unique", vip.Ip, cluster.Name, other)
+			}
+			if other, ok := virtualRouterIDs[vip.VirtualRouterId]; ok {
+				return fmt.Errorf("virtual router ID %v of cluster %q is already used by cluster %q, must be unique", vip.VirtualRouterId, cluster.Name, other)
+			}
+			virtualIPs[vip.Ip] = cluster.Name
+			virtualRouterIDs[vip.VirtualRouterId] = cluster.Name
 		}
 
-		// check if the requested provider for the DNS zone
-		// is defined in the manifest and if it's a GCP provider.
-		// https://github.com/berops/claudie/blob/master/docs/input-manifest/input-manifest.md#dns
-		providerTyp, err := m.GetProviderType(cluster.DNS.Provider)
-		if err != nil {
-			return fmt.Errorf("provider %q used inside cluster %q is not defined", cluster.DNS.Provider, cluster.Name)
-		}
+		if cluster.DNS == nil {
+			// without DNS the loadbalancer is reachable only at its virtual IP.
+			for _, role := range cluster.Roles {
+				if roles[role].TLS != nil {
+					return fmt.Errorf("role %q with tls termination used inside cluster %q requires the dns of the cluster to be set", role, cluster.Name)
+				}
+			}
+		} else {
+			// check if alternative names are unique
+			seen := make(map[string]struct{})
+			for _, n := range cluster.DNS.AlternativeNames {
+				if _, ok := seen[n]; ok {
+					return fmt.Errorf("duplicate alternative names %q specified in cluster %q, must be unique", n, cluster.Name)
+				}
+				if cluster.DNS.Hostname != "" && n == cluster.DNS.Hostname {
+					return fmt.Errorf("alternative name %q has the same value as hostname %q in cluster %q, must be unique", n, cluster.DNS.Hostname, cluster.Name)
+				}
+				if zone, ok := hostnamesPerDNS[n]; ok && zone == cluster.DNS.DNSZone {
+					return fmt.Errorf("alternative name %q used in cluster %q is used across multiple clusters for the same DNS zone %q, must be unique", n, cluster.Name, zone)
+				}
+				seen[n] = struct{}{}
+				hostnamesPerDNS[n] = cluster.DNS.DNSZone
+			}
+
+			// check if the requested hostname is unique per DNS-ZONE
+			if zone, ok := hostnamesPerDNS[cluster.DNS.Hostname]; ok && zone == cluster.DNS.DNSZone {
+				return fmt.Errorf("hostname %q used in cluster %q is used across multiple clusters for the same DNS zone %q, must be unique", cluster.DNS.Hostname, cluster.Name, zone)
+			}
+
+			if cluster.DNS.Hostname != "" {
+				hostnamesPerDNS[cluster.DNS.Hostname] = cluster.DNS.DNSZone
+			}
+
+			// check if the requested provider for the DNS zone
+			// is defined in the manifest and if it's a GCP provider.
+			// https://github.com/berops/claudie/blob/master/docs/input-manifest/input-manifest.md#dns
+			providerTyp, err := m.GetProviderType(cluster.DNS.Provider)
+			if err != nil {
+				return fmt.Errorf("provider %q used inside cluster %q is not defined", cluster.DNS.Provider, cluster.Name)
+			}
 
-		if !slices.Contains([]string{"gcp", "aws", "azure", "oci", "cloudflare", "hetzner", "exoscale", "ovh"}, providerTyp) {
-			return fmt.Errorf("provider %q used inside cluster %q exists but is not a supported provider", cluster.DNS.Provider, cluster.Name)
+			if !slices.Contains([]string{"gcp", "aws", "azure", "oci", "cloudflare", "hetzner", "exoscale", "ovh", "rfc2136"}, providerTyp) {
+				return fmt.Errorf("provider %q used inside cluster %q exists but is not a supported provider", cluster.DNS.Provider, cluster.Name)
+			}
+
+			// certificates for the tls termination are obtained by solving
+			// the ACME DNS-01 challenges with the DNS provider of the cluster,
+			// which is implemented only for some of the DNS providers.
+			for _, role := range cluster.Roles {
+				if roles[role].TLS != nil && !slices.Contains(certificates.SupportedProviders, providerTyp) {
+					return fmt.Errorf(
+						"role %q with tls termination used inside cluster %q requires the DNS provider to support ACME DNS-01 challenges, "+
+							"supported are %s, but provider %q is of type %q",
+						role, cluster.Name, strings.Join(certificates.SupportedProviders, ", "), cluster.DNS.Provider, providerTyp,
+					)
+				}
+			}
 		}
 
 		// check if k8s cluster was defined in the manifest.
@@ -239,9 +330,201 @@ func (r *Role) Validate() error {
 	if err := validator.New().Struct(r); err != nil {
 		return prettyPrintValidationError(err)
 	}
+
+	if r.TLS != nil {
+		if r.Protocol == "udp" {
+			return fmt.Errorf("tls termination is not supported for roles with the udp protocol")
+		}
+		if r.TargetPort == APIServerPort {
+			return fmt.Errorf("tls termination is not supported for the kubernetes api server role")
+		}
+	}
+
+	if r.Protocol == "http" && r.TargetPort == APIServerPort {
+		return fmt.Errorf("the http protocol is not supported for the kubernetes api server role")
+	}
+
+	if len(r.Routes) > 0 && r.Protocol != "http" {
+		return fmt.Errorf("routes are supported only for roles with the http protocol")
+	}
+
+	type match struct{ host, pathPrefix string }
+	matches := make(map[match]bool)
+	for _, route := range r.Routes {
+		if route.Host != "" {
+			// wildcards are allowed only as the first label, i.e. *.example.com
+			if err := validator.New().Var(strings.TrimPrefix(route.Host, "*."), "hostname_rfc1123"); err != nil {
+				return fmt.Errorf("route host %q is not a valid hostname", route.Host)
+			}
+		}
+
+		m := match{host: route.Host, pathPrefix: cmp.Or(route.PathPrefix, "/")}
+		if matches[m] {
+			return fmt.Errorf("route for host %q and path prefix %q is defined more than once", m.host, m.pathPrefix)
+		}
+		matches[m] = true
+
+		duplicates := make(map[string]bool)
+		for _, np := range route.TargetPools {
+			if duplicates[np] {
+				return fmt.Errorf("route for host %q and path prefix %q has target pool %q referenced more than once. remove duplicates", m.host, m.pathPrefix, np)
+			}
+			duplicates[np] = true
+		}
+	}
+
+	if r.Settings == nil {
+		return nil
+	}
+
+	if hc := r.Settings.HealthCheck; hc != nil {
+		if err := hc.Validate(r); err != nil {
+			return fmt.Errorf("invalid health check: %w", err)
+		}
+	}
+
+	if od := r.Settings.OutlierDetection; od != nil {
+		if err := od.Validate(r); err != nil {
+			return fmt.Errorf("invalid outlier detection: %w", err)
+		}
+	}
+
+	switch r.Settings.Algorithm {
+	case "", "ring_hash", "maglev":
+	default:
+		if r.Settings.StickySessions {
+			return fmt.Errorf("stickySessions can only be used with the ring_hash or maglev algorithm, not %q", r.Settings.Algorithm)
+		}
+	}
+
+	if err := r.validateWeights(); err != nil {
+		return fmt.Errorf("invalid weights: %w", err)
+	}
+
+	// envoy can't limit the udp traffic, as it is not connection based,
+	// and the network filters enforcing the source ranges apply only to tcp.
+	if r.Protocol == "udp" && (r.Settings.MaxConnections > 0 || r.Settings.RateLimit != nil || len(r.Settings.AllowedSourceRanges) > 0) {
+		return fmt.Errorf("maxConnections, rateLimit and allowedSourceRanges are not supported for roles with the udp protocol")
+	}
+
+	return nil
+}
+
+// validateWeights checks that the weights are defined only for the target pools of the role
+// and that the role and each of its routes are left with at least one target pool receiving traffic.
+func (r *Role) validateWeights() error {
+	weights := make(map[string]int32, len(r.Settings.Weights))
+	for _, w := range r.Settings.Weights {
+		if _, ok := weights[w.TargetPool]; ok {
+			return fmt.Errorf("weight of target pool %q defined more than once", w.TargetPool)
+		}
+		weights[w.TargetPool] = w.Weight
+
+		used := slices.Contains(r.TargetPools, w.TargetPool)
+		for _, route := range r.Routes {
+			used = used || slices.Contains(route.TargetPools, w.TargetPool)
+		}
+		if !used {
+			return fmt.Errorf("%q is not a target pool of the role", w.TargetPool)
+		}
+	}
+
+	receivesTraffic := func(pools []string) bool {
+		return slices.ContainsFunc(pools, func(p string) bool {
+			w, ok := weights[p]
+			return !ok || w > 0
+		})
+	}
+
+	if !receivesTraffic(r.TargetPools) {
+		return fmt.Errorf("at least one of the target pools %v must have a non-zero weight", r.TargetPools)
+	}
+	for _, route := range r.Routes {
+		if !receivesTraffic(route.TargetPools) {
+			return fmt.Errorf("at least one of the target pools %v of the route must have a non-zero weight", route.TargetPools)
+		}
+	}
+
+	return nil
+}
+
+// allTargetPools returns the target pools of the role along with the target pools of its routes.
+func (r *Role) allTargetPools() []string {
+	pools := slices.Clone(r.TargetPools)
+	for _, route := range r.Routes {
+		pools = append(pools, route.TargetPools...)
+	}
+	return pools
+}
+
+func (h *HealthCheck) Validate(r *Role) error {
+	switch h.Protocol {
+	case "http":
+		if !strings.HasPrefix(h.Path, "/") {
+			return fmt.Errorf("path %q of the http health check must start with '/'", h.Path)
+		}
+	case "tcp":
+		if h.Path != "" {
+			return fmt.Errorf("path %q can only be used with the http health check", h.Path)
+		}
+	}
+
+	// envoy can't actively health check udp targets, the checks must
+	// be sent to a separate port on which the service accepts tcp.
+	if r.Protocol == "udp" && h.Port == 0 {
+		return fmt.Errorf("roles with the udp protocol must specify the port to which the tcp/http health checks are sent")
+	}
+
+	interval, err := parsePositiveDuration(h.Interval, DefaultHealthCheckInterval)
+	if err != nil {
+		return fmt.Errorf("invalid interval: %w", err)
+	}
+
+	timeout, err := parsePositiveDuration(h.Timeout, DefaultHealthCheckTimeout)
+	if err != nil {
+		return fmt.Errorf("invalid timeout: %w", err)
+	}
+
+	if timeout > interval {
+		return fmt.Errorf("timeout %v must not be greater than the interval %v", timeout, interval)
+	}
+
 	return nil
 }
 
+func (o *OutlierDetection) Validate(r *Role) error {
+	// envoy only tracks the failures of the forwarded tcp connections and http requests.
+	if r.Protocol == "udp" {
+		return fmt.Errorf("outlier detection is not supported for roles with the udp protocol")
+	}
+
+	if _, err := parsePositiveDuration(o.Interval, DefaultOutlierDetectionInterval); err != nil {
+		return fmt.Errorf("invalid interval: %w", err)
+	}
+
+	if _, err := parsePositiveDuration(o.BaseEjectionTime, DefaultOutlierDetectionBaseEjectionTime); err != nil {
+		return fmt.Errorf("invalid base ejection time: %w", err)
+	}
+
+	return nil
+}
+
+// parsePositiveDuration parses the duration, returning the default if empty.
+// Durations below a millisecond or above an hour are rejected.
+func parsePositiveDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+	}
+	if d < time.Millisecond || d > time.Hour {
+		return 0, fmt.Errorf("duration %q must be between 1ms and 1h", s)
+	}
+	return d, nil
+}
+
 func (c *LoadBalancerCluster) Validate() error {
 	if err := validator.New().Struct(c); err != nil {
 		return prettyPrintValidationError(err)
@@ -3,7 +3,9 @@ package manifest
 import (
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/berops/claudie/internal/generics"
 
@@ -26,9 +28,13 @@ func (p *NodePool) Validate(m *Manifest) error {
 		}
 
 		// check if the provider is defined in the manifest
-		if _, err := m.GetProviderType(n.ProviderSpec.Name); err != nil {
+		typ, err := m.GetProviderType(n.ProviderSpec.Name)
+		if err != nil {
 			return fmt.Errorf("provider %q specified for DynamicNodePool %q doesn't exists", n.ProviderSpec.Name, n.Name)
 		}
+		if typ == "rfc2136" {
+			return fmt.Errorf("provider %q specified for DynamicNodePool %q can only be used for DNS", n.ProviderSpec.Name, n.Name)
+		}
 
 		if err := n.Validate(m); err != nil {
 			return fmt.Errorf("failed to validate DynamicNodePool %q: %w", n.Name, err)
@@ -141,10 +147,14 @@ func (d *DynamicNodePool) Validate(m *Manifest) error {
 		return err
 	}
 
+	if err := validate.RegisterValidation("intOrPercent", validateIntOrPercent); err != nil {
+		return err
+	}
+
 	if err := validate.Struct(d); err != nil {
 		return prettyPrintValidationError(err)
 	}
-	return nil
+	return d.DrainPolicy.Validate()
 }
 
 // validateGCPGpuConfig validates that GCP nodepools with GPUs have the required type specified.
@@ -220,6 +230,20 @@ func (s *StaticNodePool) Validate() error {
 	if err := validator.New().Struct(s); err != nil {
 		return prettyPrintValidationError(err)
 	}
+	return s.DrainPolicy.Validate()
+}
+
+func (p *DrainPolicy) Validate() error {
+	if p == nil || p.Timeout == "" {
+		return nil
+	}
+	d, err := time.ParseDuration(p.Timeout)
+	if err != nil {
+		return fmt.Errorf("invalid drain timeout %q: %w", p.Timeout, err)
+	}
+	if d <= 0 {
+		return fmt.Errorf("drain timeout %q must be positive", p.Timeout)
+	}
 	return nil
 }
 
@@ -280,3 +304,16 @@ func validateExternalNet(fl validator.FieldLevel) bool {
 	}
 	return true
 }
+
+// validateIntOrPercent validates that the field is either a non-negative number or a percentage, e.g. "25%".
+func validateIntOrPercent(fl validator.FieldLevel) bool {
+	v := strings.TrimSuffix(fl.Field().String(), "%")
+	n, err := strconv.ParseUint(v, 10, 31)
+	if err != nil {
+		return false
+	}
+	if strings.HasSuffix(fl.Field().String(), "%") {
+		return n <= 100
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -299,3 +300,80 @@ func TestValidateSpot(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateRollingUpdate verifies the rolling update strategy of dynamic nodepools.
+func TestValidateRollingUpdate(t *testing.T) {
+	m := &Manifest{
+		Providers: Provider{
+			Hetzner: []Hetzner{{
+				Name:        "hetzner-1",
+				Credentials: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			}},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		strategy  *RollingUpdateStrategy
+		wantError bool
+	}{
+		{name: "not set passes", strategy: nil},
+		{name: "empty passes", strategy: &RollingUpdateStrategy{}},
+		{name: "absolute passes", strategy: &RollingUpdateStrategy{MaxSurge: "1", MaxUnavailable: "0"}},
+		{name: "percentage passes", strategy: &RollingUpdateStrategy{MaxSurge: "25%", MaxUnavailable: "100%"}},
+		{name: "negative fails", strategy: &RollingUpdateStrategy{MaxSurge: "-1"}, wantError: true},
+		{name: "percentage over 100 fails", strategy: &RollingUpdateStrategy{MaxUnavailable: "150%"}, wantError: true},
+		{name: "invalid fails", strategy: &RollingUpdateStrategy{MaxSurge: "one"}, wantError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			np := &DynamicNodePool{
+				Name:          "worker-np",
+				ServerType:    "cx21",
+				Image:         "ubuntu-22.04",
+				Count:         3,
+				RollingUpdate: tc.strategy,
+				ProviderSpec: ProviderSpec{
+					Name:   "hetzner-1",
+					Region: "fsn1",
+					Zone:   "fsn1-dc14",
+				},
+			}
+			err := np.Validate(m)
+			if tc.wantError {
+				require.ErrorContains(t, err, "non-negative number or a percentage")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	var s RollingUpdateStrategy
+	require.NoError(t, json.Unmarshal([]byte(`{"maxSurge": 2, "maxUnavailable": "50%"}`), &s))
+	require.Equal(t, RollingUpdateStrategy{MaxSurge: "2", MaxUnavailable: "50%"}, s)
+}
+
+func TestValidateDrainPolicy(t *testing.T) {
+	r := require.New(t)
+	no := false
+
+	r.NoError((*DrainPolicy)(nil).Validate())
+	r.NoError((&StaticNodePool{Name: "static", DrainPolicy: &DrainPolicy{Timeout: "2h", OnTimeout: "fail"}}).Validate())
+	r.ErrorContains((&StaticNodePool{Name: "static", DrainPolicy: &DrainPolicy{OnTimeout: "wait"}}).Validate(), "one of: force fail")
+	r.Error((&StaticNodePool{Name: "static", DrainPolicy: &DrainPolicy{Timeout: "soon"}}).Validate())
+	r.Error((&StaticNodePool{Name: "static", DrainPolicy: &DrainPolicy{Timeout: "-1m"}}).Validate())
+
+	// defaults are filled in.
+	p := (*DrainPolicy)(nil).CreateDrainPolicy()
+	r.Equal("30m0s", p.Timeout)
+	r.False(p.FailOnTimeout)
+	r.True(p.IgnoreDaemonSets)
+	r.True(p.DeleteEmptyDirData)
+
+	p = (&DrainPolicy{Timeout: "1h", OnTimeout: "fail", DeleteEmptyDirData: &no}).CreateDrainPolicy()
+	r.Equal("1h", p.Timeout)
+	r.True(p.FailOnTimeout)
+	r.True(p.IgnoreDaemonSets)
+	r.False(p.DeleteEmptyDirData)
+}
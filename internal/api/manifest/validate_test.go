@@ -1,11 +1,13 @@
 package manifest
 
 import (
+	"fmt"
 	"math/rand/v2"
 	"testing"
 
 	"github.com/berops/claudie/internal/generics"
 	"github.com/berops/claudie/internal/spectesting"
+	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	k8sV1 "k8s.io/api/core/v1"
@@ -303,6 +305,67 @@ func TestProxy(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMaintenanceWindows(t *testing.T) {
+	withWindows := func(w ...MaintenanceWindow) *Kubernetes {
+		return &Kubernetes{Clusters: []Cluster{{
+			Name:               "cluster1",
+			Network:            "10.0.0.0/8",
+			Version:            "v1.34.0",
+			Pools:              Pool{Control: []string{"np1"}},
+			MaintenanceWindows: w,
+		}}}
+	}
+
+	require.NoError(t, withWindows(MaintenanceWindow{Days: []string{"Sat", "sun"}, Start: "02:00", Duration: "4h", Timezone: "Europe/Bratislava"}).Validate(testManifest))
+	require.NoError(t, withWindows(MaintenanceWindow{Start: "23:30", Duration: "90m"}).Validate(testManifest))
+	require.Error(t, withWindows(MaintenanceWindow{Days: []string{"Someday"}, Start: "02:00", Duration: "4h"}).Validate(testManifest))
+	require.Error(t, withWindows(MaintenanceWindow{Start: "25:00", Duration: "4h"}).Validate(testManifest))
+	require.Error(t, withWindows(MaintenanceWindow{Start: "02:00", Duration: "200h"}).Validate(testManifest))
+	require.Error(t, withWindows(MaintenanceWindow{Start: "02:00", Duration: "4h", Timezone: "Mars/Olympus"}).Validate(testManifest))
+	require.Error(t, withWindows(MaintenanceWindow{Duration: "4h"}).Validate(testManifest))
+}
+
+func TestNetworkIPv6(t *testing.T) {
+	withNetwork := func(network string) *Kubernetes {
+		return &Kubernetes{Clusters: []Cluster{{
+			Name:        "cluster1",
+			Network:     "10.0.0.0/8",
+			NetworkIPv6: network,
+			Version:     "v1.34.0",
+			Pools:       Pool{Control: []string{"np1"}},
+		}}}
+	}
+
+	require.NoError(t, withNetwork("").Validate(testManifest))
+	require.NoError(t, withNetwork("fd00:10::/96").Validate(testManifest))
+	require.NoError(t, withNetwork("fd00:10::/64").Validate(testManifest))
+	require.Error(t, withNetwork("fd00:10::/112").Validate(testManifest))
+	require.Error(t, withNetwork("10.0.0.0/8").Validate(testManifest))
+	require.Error(t, withNetwork("fd00:10::").Validate(testManifest))
+	require.ErrorContains(t, withNetwork("fd01:0:0:1::/96").Validate(testManifest), "must not overlap")
+	require.ErrorContains(t, withNetwork("fd00::/8").Validate(testManifest), "must not overlap")
+}
+
+func TestNodeHooks(t *testing.T) {
+	withHooks := func(h *NodeHooks) *Kubernetes {
+		return &Kubernetes{Clusters: []Cluster{{
+			Name:    "cluster1",
+			Network: "10.0.0.0/8",
+			Version: "v1.34.0",
+			Pools:   Pool{Control: []string{"np1"}},
+			Hooks:   h,
+		}}}
+	}
+
+	require.NoError(t, withHooks(nil).Validate(testManifest))
+	require.NoError(t, withHooks(&NodeHooks{PreDrain: &NodeHook{Url: "https://hooks.example.com/drain", Timeout: "2m"}}).Validate(testManifest))
+	require.NoError(t, withHooks(&NodeHooks{PostJoin: &NodeHook{Url: "http://hooks.default.svc:8080/join"}}).Validate(testManifest))
+	require.Error(t, withHooks(&NodeHooks{PreDrain: &NodeHook{Url: "hooks.example.com"}}).Validate(testManifest))
+	require.Error(t, withHooks(&NodeHooks{PreDrain: &NodeHook{}}).Validate(testManifest))
+	require.Error(t, withHooks(&NodeHooks{PostJoin: &NodeHook{Url: "https://hooks.example.com", Timeout: "soon"}}).Validate(testManifest))
+	require.Error(t, withHooks(&NodeHooks{PostJoin: &NodeHook{Url: "https://hooks.example.com", Timeout: "2h"}}).Validate(testManifest))
+}
+
 // TestNodepool tests the nodepool spec validation
 func TestNodepool(t *testing.T) {
 	err := testNodepoolAutoScalerSuccAC.Validate(&Manifest{})
@@ -495,3 +558,332 @@ func TestGCPGpuValidation(t *testing.T) {
 	}
 	r.NoError(hetznerNodepoolDeprecatedGpu.Validate(hetznerManifest), "Non-GCP nodepool with deprecated nvidiaGpu but no type should pass validation")
 }
+
+func TestRoleHealthChecks(t *testing.T) {
+	withSettings := func(protocol string, s *RoleSettings) *Role {
+		return &Role{Name: "role", Protocol: protocol, Port: 80, TargetPort: 8080, TargetPools: []string{"np1"}, Settings: s}
+	}
+
+	require.NoError(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp"}}).Validate())
+	require.NoError(t, withSettings("tcp", &RoleSettings{
+		HealthCheck:      &HealthCheck{Protocol: "http", Path: "/healthz", Port: 10254, Interval: "500ms", Timeout: "250ms", HealthyThreshold: 1, UnhealthyThreshold: 5},
+		OutlierDetection: &OutlierDetection{ConsecutiveFailures: 3, Interval: "5s", BaseEjectionTime: "1m", MaxEjectionPercent: 100},
+	}).Validate())
+	require.NoError(t, withSettings("udp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", Port: 8081}}).Validate())
+
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "grpc"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "http"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", Path: "/healthz"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", Port: 70000}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", Interval: "1s", Timeout: "2s"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", Interval: "forever"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp", UnhealthyThreshold: -1}}).Validate())
+	require.Error(t, withSettings("udp", &RoleSettings{HealthCheck: &HealthCheck{Protocol: "tcp"}}).Validate())
+	require.Error(t, withSettings("udp", &RoleSettings{OutlierDetection: &OutlierDetection{}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{OutlierDetection: &OutlierDetection{BaseEjectionTime: "2h"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{OutlierDetection: &OutlierDetection{MaxEjectionPercent: 101}}).Validate())
+
+	// defaults are filled in for the unspecified fields.
+	s := &RoleSettings{
+		HealthCheck:      &HealthCheck{Protocol: "http", Path: "/healthz", Interval: "500ms"},
+		OutlierDetection: &OutlierDetection{},
+	}
+	require.Equal(t, &spec.Role_HealthCheck{
+		Protocol:           "http",
+		Path:               "/healthz",
+		IntervalMs:         500,
+		TimeoutMs:          2000,
+		HealthyThreshold:   DefaultHealthyThreshold,
+		UnhealthyThreshold: DefaultUnhealthyThreshold,
+	}, s.CreateHealthCheck())
+	require.Equal(t, &spec.Role_OutlierDetection{
+		ConsecutiveFailures: DefaultConsecutiveFailures,
+		IntervalMs:          10000,
+		BaseEjectionTimeMs:  30000,
+		MaxEjectionPercent:  DefaultMaxEjectionPercent,
+	}, s.CreateOutlierDetection())
+	require.Nil(t, (&RoleSettings{}).CreateHealthCheck())
+	require.Nil(t, (*RoleSettings)(nil).CreateOutlierDetection())
+}
+
+func TestRoleTLS(t *testing.T) {
+	withTLS := func(protocol string, targetPort int32, tls *RoleTLS) *Role {
+		return &Role{Name: "role", Protocol: protocol, Port: 443, TargetPort: targetPort, TargetPools: []string{"np1"}, TLS: tls}
+	}
+
+	require.NoError(t, withTLS("tcp", 80, &RoleTLS{Email: "admin@example.com"}).Validate())
+	require.NoError(t, withTLS("tcp", 80, &RoleTLS{Email: "admin@example.com", Directory: "https://acme-staging-v02.api.letsencrypt.org/directory"}).Validate())
+	require.NoError(t, withTLS("http", 80, &RoleTLS{Email: "admin@example.com"}).Validate())
+
+	require.Error(t, withTLS("tcp", 80, &RoleTLS{}).Validate())
+	require.Error(t, withTLS("tcp", 80, &RoleTLS{Email: "admin"}).Validate())
+	require.Error(t, withTLS("tcp", 80, &RoleTLS{Email: "admin@example.com", Directory: "not a url"}).Validate())
+	require.Error(t, withTLS("udp", 80, &RoleTLS{Email: "admin@example.com"}).Validate())
+	require.Error(t, withTLS("tcp", APIServerPort, &RoleTLS{Email: "admin@example.com"}).Validate())
+}
+
+func TestRoleTLSProvider(t *testing.T) {
+	bind := RFC2136{Name: "bind", Server: "ns1.example.com", KeyName: "claudie.", KeySecret: "c2VjcmV0", Templates: testK8s.Providers.Hetzner[0].Templates}
+	m := &Manifest{
+		Providers: Provider{
+			Cloudflare: []Cloudflare{{Name: "cf", ApiToken: "token", AccountID: "account"}},
+			RFC2136:    []RFC2136{bind},
+		},
+		NodePools:  NodePool{Dynamic: []DynamicNodePool{{Name: "control"}, {Name: "compute"}, {Name: "lb"}}},
+		Kubernetes: Kubernetes{Clusters: []Cluster{{Name: "cluster", Pools: Pool{Control: []string{"control"}, Compute: []string{"compute"}}}}},
+	}
+
+	lb := func(provider string) *LoadBalancer {
+		return &LoadBalancer{
+			Roles: []Role{{
+				Name:        "https",
+				Protocol:    "tcp",
+				Port:        443,
+				TargetPort:  30080,
+				TargetPools: []string{"compute"},
+				TLS:         &RoleTLS{Email: "admin@example.com"},
+			}},
+			Clusters: []LoadBalancerCluster{{
+				Name:        "lb",
+				Roles:       []string{"https"},
+				DNS:         &DNS{DNSZone: "example.com", Provider: provider, Hostname: "app"},
+				TargetedK8s: "cluster",
+				Pools:       []string{"lb"},
+			}},
+		}
+	}
+
+	require.NoError(t, lb("cf").Validate(m))
+	require.ErrorContains(t, lb("bind").Validate(m), `requires the DNS provider to support ACME DNS-01 challenges, supported are aws, cloudflare, hetzner, but provider "bind" is of type "rfc2136"`)
+}
+
+func TestRoleRoutes(t *testing.T) {
+	withRoutes := func(protocol string, routes ...RoleRoute) *Role {
+		return &Role{Name: "role", Protocol: protocol, Port: 80, TargetPort: 8080, TargetPools: []string{"np1"}, Routes: routes}
+	}
+
+	require.NoError(t, withRoutes("http").Validate())
+	require.NoError(t, withRoutes("http",
+		RoleRoute{Host: "app.example.com", TargetPools: []string{"np1"}, TargetPort: 8081},
+		RoleRoute{Host: "app.example.com", PathPrefix: "/api", TargetPools: []string{"np2"}, TargetPort: 8082},
+		RoleRoute{Host: "*.example.com", TargetPools: []string{"np1", "np2"}, TargetPort: 8083},
+		RoleRoute{PathPrefix: "/static", TargetPools: []string{"np1"}, TargetPort: 8084},
+	).Validate())
+
+	require.Error(t, withRoutes("tcp", RoleRoute{TargetPools: []string{"np1"}, TargetPort: 8081}).Validate())
+	require.Error(t, withRoutes("http", RoleRoute{TargetPort: 8081}).Validate())
+	require.Error(t, withRoutes("http", RoleRoute{TargetPools: []string{"np1"}}).Validate())
+	require.Error(t, withRoutes("http", RoleRoute{PathPrefix: "api", TargetPools: []string{"np1"}, TargetPort: 8081}).Validate())
+	require.Error(t, withRoutes("http", RoleRoute{Host: "not a host", TargetPools: []string{"np1"}, TargetPort: 8081}).Validate())
+	require.Error(t, withRoutes("http", RoleRoute{TargetPools: []string{"np1", "np1"}, TargetPort: 8081}).Validate())
+	require.Error(t, withRoutes("http",
+		RoleRoute{Host: "app.example.com", TargetPools: []string{"np1"}, TargetPort: 8081},
+		RoleRoute{Host: "app.example.com", PathPrefix: "/", TargetPools: []string{"np2"}, TargetPort: 8082},
+	).Validate())
+
+	r := &Role{Name: "role", Protocol: "http", Port: 6443, TargetPort: APIServerPort, TargetPools: []string{"np1"}}
+	require.Error(t, r.Validate())
+
+	require.Equal(t, []*spec.Role_Route{
+		{Host: "app.example.com", PathPrefix: "/", TargetPools: []string{"np1"}, TargetPort: 8081},
+		{PathPrefix: "/api", TargetPools: []string{"np2"}, TargetPort: 8082},
+	}, withRoutes("http",
+		RoleRoute{Host: "app.example.com", TargetPools: []string{"np1"}, TargetPort: 8081},
+		RoleRoute{PathPrefix: "/api", TargetPools: []string{"np2"}, TargetPort: 8082},
+	).CreateRoutes())
+}
+
+func TestRoleAlgorithmsAndWeights(t *testing.T) {
+	withSettings := func(s *RoleSettings, routes ...RoleRoute) *Role {
+		return &Role{Name: "role", Protocol: "http", Port: 80, TargetPort: 8080, TargetPools: []string{"old", "new"}, Settings: s, Routes: routes}
+	}
+
+	require.NoError(t, withSettings(&RoleSettings{Algorithm: "least_request"}).Validate())
+	require.NoError(t, withSettings(&RoleSettings{Algorithm: "maglev", StickySessions: true}).Validate())
+	require.NoError(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 90}, {TargetPool: "new", Weight: 10}}}).Validate())
+	require.NoError(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 0}}}).Validate())
+	require.NoError(t, withSettings(
+		&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "api", Weight: 5}}},
+		RoleRoute{PathPrefix: "/api", TargetPools: []string{"api"}, TargetPort: 9090},
+	).Validate())
+
+	require.Error(t, withSettings(&RoleSettings{Algorithm: "weighted"}).Validate())
+	require.Error(t, withSettings(&RoleSettings{Algorithm: "random", StickySessions: true}).Validate())
+	require.Error(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "other", Weight: 1}}}).Validate())
+	require.Error(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 1}, {TargetPool: "old", Weight: 2}}}).Validate())
+	require.Error(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 101}}}).Validate())
+	require.Error(t, withSettings(&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 0}, {TargetPool: "new", Weight: 0}}}).Validate())
+	require.Error(t, withSettings(
+		&RoleSettings{Weights: []TargetPoolWeight{{TargetPool: "api", Weight: 0}}},
+		RoleRoute{PathPrefix: "/api", TargetPools: []string{"api"}, TargetPort: 9090},
+	).Validate())
+
+	s := &RoleSettings{Algorithm: "least_request", Weights: []TargetPoolWeight{{TargetPool: "old", Weight: 0}}}
+	require.Equal(t, spec.Role_LEAST_REQUEST, s.CreateAlgorithm())
+	require.Equal(t, map[string]uint32{"old": 0}, s.CreateWeights())
+	require.Equal(t, spec.Role_ROUND_ROBIN, (*RoleSettings)(nil).CreateAlgorithm())
+	require.Nil(t, (&RoleSettings{}).CreateWeights())
+}
+
+func TestRoleSourceRangesAndLimits(t *testing.T) {
+	withSettings := func(protocol string, s *RoleSettings) *Role {
+		return &Role{Name: "role", Protocol: protocol, Port: 80, TargetPort: 8080, TargetPools: []string{"pool"}, Settings: s}
+	}
+
+	require.NoError(t, withSettings("tcp", &RoleSettings{AllowedSourceRanges: []string{"10.0.0.0/8", "2001:db8::/32"}}).Validate())
+	require.NoError(t, withSettings("http", &RoleSettings{MaxConnections: 100, RateLimit: &RateLimit{ConnectionsPerSecond: 10}}).Validate())
+
+	require.Error(t, withSettings("tcp", &RoleSettings{AllowedSourceRanges: []string{"10.0.0.1"}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{MaxConnections: -1}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{RateLimit: &RateLimit{}}).Validate())
+	require.Error(t, withSettings("tcp", &RoleSettings{RateLimit: &RateLimit{ConnectionsPerSecond: 10, Burst: -1}}).Validate())
+	require.ErrorContains(t, withSettings("udp", &RoleSettings{MaxConnections: 100}).Validate(), "not supported for roles with the udp protocol")
+	require.ErrorContains(t, withSettings("udp", &RoleSettings{RateLimit: &RateLimit{ConnectionsPerSecond: 10}}).Validate(), "not supported for roles with the udp protocol")
+	require.ErrorContains(t, withSettings("udp", &RoleSettings{AllowedSourceRanges: []string{"10.0.0.0/8"}}).Validate(), "not supported for roles with the udp protocol")
+
+	s := &RoleSettings{
+		AllowedSourceRanges: []string{"10.1.2.3/8", "10.0.0.0/8", "2001:db8::1/32"},
+		RateLimit:           &RateLimit{ConnectionsPerSecond: 10},
+	}
+	require.Equal(t, []string{"10.0.0.0/8", "2001:db8::/32"}, s.CreateAllowedSourceRanges())
+	require.Equal(t, &spec.Role_RateLimit{ConnectionsPerSecond: 10, Burst: 10}, s.CreateRateLimit())
+	require.Nil(t, (*RoleSettings)(nil).CreateAllowedSourceRanges())
+	require.Nil(t, (&RoleSettings{}).CreateRateLimit())
+}
+
+func TestServiceLoadBalancer(t *testing.T) {
+	m := &Manifest{
+		Providers: Provider{Cloudflare: []Cloudflare{{Name: "cf", ApiToken: "token", AccountID: "account"}}},
+		NodePools: NodePool{Dynamic: []DynamicNodePool{{Name: "control"}, {Name: "compute"}, {Name: "lb"}}},
+		Kubernetes: Kubernetes{Clusters: []Cluster{
+			{Name: "cluster", Pools: Pool{Control: []string{"control"}, Compute: []string{"compute"}}},
+		}},
+	}
+
+	lbs := func(ranges ...*PortRange) *LoadBalancer {
+		lb := &LoadBalancer{Roles: []Role{{Name: "ingress", Protocol: "tcp", Port: 443, TargetPort: 30443, TargetPools: []string{"compute"}}}}
+		for i, r := range ranges {
+			c := LoadBalancerCluster{
+				Name:        fmt.Sprintf("lb-%d", i),
+				Roles:       []string{"ingress"},
+				DNS:         &DNS{DNSZone: "example.com", Provider: "cf", Hostname: fmt.Sprintf("lb-%d", i)},
+				TargetedK8s: "cluster",
+				Pools:       []string{"lb"},
+			}
+			if r != nil {
+				c.ServiceLoadBalancer = &ServiceLoadBalancer{PortRange: *r}
+			}
+			lb.Clusters = append(lb.Clusters, c)
+		}
+		return lb
+	}
+
+	require.NoError(t, lbs(nil).Validate(m))
+	require.NoError(t, lbs(&PortRange{Min: 30000, Max: 30100}).Validate(m))
+	require.NoError(t, lbs(&PortRange{Min: 30000, Max: 30100}, nil).Validate(m))
+
+	require.ErrorContains(t, lbs(&PortRange{Min: 30000, Max: 30100}, &PortRange{Min: 31000, Max: 31100}).Validate(m), "only one service load-balancer")
+	require.ErrorContains(t, lbs(&PortRange{Min: 400, Max: 500}).Validate(m), "uses port 443")
+	require.ErrorContains(t, lbs(&PortRange{Min: 30000, Max: ReservedPortRangeStart}).Validate(m), "reserved port range")
+	require.ErrorContains(t, lbs(&PortRange{Min: 1000, Max: 3000}).Validate(m), "exceeds the limit")
+	require.Error(t, lbs(&PortRange{Min: 30100, Max: 30000}).Validate(m))
+	require.Error(t, lbs(&PortRange{Max: 30000}).Validate(m))
+
+	reserved := lbs(&PortRange{Min: 30000, Max: 30100})
+	reserved.Roles[0].Name = spec.ServiceRolePrefix + "443"
+	reserved.Clusters[0].Roles = []string{reserved.Roles[0].Name}
+	require.ErrorContains(t, reserved.Validate(m), "reserved for the roles of the services")
+
+	slb := m.CreateServiceLoadBalancer(&lbs(&PortRange{Min: 30000, Max: 30100}).Clusters[0])
+	require.Equal(t, &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30100, TargetPools: []string{"compute"}}, slb)
+	require.Nil(t, m.CreateServiceLoadBalancer(&lbs(nil).Clusters[0]))
+}
+
+func TestRFC2136(t *testing.T) {
+	r := RFC2136{Name: "bind", Server: "ns1.example.com", KeyName: "claudie.", KeySecret: "c2VjcmV0"}
+	require.ErrorContains(t, r.Validate(), "Templates")
+
+	r.Templates = testK8s.Providers.Hetzner[0].Templates
+	require.NoError(t, r.Validate())
+
+	withIP := r
+	withIP.Server, withIP.Port, withIP.KeyAlgorithm = "2001:db8::53", 5353, "hmac-sha512"
+	require.NoError(t, withIP.Validate())
+
+	invalid := r
+	invalid.KeyAlgorithm = "hmac-sha3"
+	require.Error(t, invalid.Validate())
+	invalid = r
+	invalid.KeySecret = "not base64"
+	require.Error(t, invalid.Validate())
+	invalid = r
+	invalid.Port = 65536
+	require.Error(t, invalid.Validate())
+
+	m := &Manifest{
+		Providers:  Provider{RFC2136: []RFC2136{r}},
+		NodePools:  NodePool{Dynamic: []DynamicNodePool{{Name: "control", ProviderSpec: ProviderSpec{Name: "bind"}}}},
+		Kubernetes: Kubernetes{Clusters: []Cluster{{Name: "cluster", Pools: Pool{Control: []string{"control"}}}}},
+	}
+	require.ErrorContains(t, m.NodePools.Validate(m), "can only be used for DNS")
+
+	typ, err := m.GetProviderType("bind")
+	require.NoError(t, err)
+	require.Equal(t, "rfc2136", typ)
+}
+
+func TestVirtualIP(t *testing.T) {
+	m := &Manifest{
+		Providers: Provider{Cloudflare: []Cloudflare{{Name: "cf", ApiToken: "token", AccountID: "account"}}},
+		NodePools: NodePool{
+			Dynamic: []DynamicNodePool{{Name: "control"}, {Name: "dynamic-lb"}},
+			Static:  []StaticNodePool{{Name: "lb-1"}, {Name: "lb-2"}},
+		},
+		Kubernetes: Kubernetes{Clusters: []Cluster{
+			{Name: "cluster", Pools: Pool{Control: []string{"control"}}},
+		}},
+	}
+
+	lbs := func(pools []string, vips ...*VirtualIP) *LoadBalancer {
+		lb := &LoadBalancer{Roles: []Role{{Name: "api", Protocol: "tcp", Port: 6443, TargetPort: 6443, TargetPools: []string{"control"}}}}
+		for i, vip := range vips {
+			c := LoadBalancerCluster{
+				Name:        fmt.Sprintf("lb-%d", i),
+				DNS:         &DNS{DNSZone: "example.com", Provider: "cf", Hostname: fmt.Sprintf("lb-%d", i)},
+				TargetedK8s: "cluster",
+				Pools:       []string{pools[i]},
+				VirtualIP:   vip,
+			}
+			// only a single loadbalancer can have the api server role.
+			if i == 0 {
+				c.Roles = []string{"api"}
+			}
+			lb.Clusters = append(lb.Clusters, c)
+		}
+		return lb
+	}
+
+	static := []string{"lb-1", "lb-2"}
+	require.NoError(t, lbs(static, &VirtualIP{IP: "192.168.1.100"}).Validate(m))
+	require.NoError(t, lbs(static, &VirtualIP{IP: "192.168.1.100", Interface: "eth1", VirtualRouterID: 10}, &VirtualIP{IP: "192.168.1.101", VirtualRouterID: 11}).Validate(m))
+
+	require.ErrorContains(t, lbs([]string{"dynamic-lb"}, &VirtualIP{IP: "192.168.1.100"}).Validate(m), "only supported for static nodepools")
+	require.ErrorContains(t, lbs(static, &VirtualIP{IP: "192.168.1.100"}, &VirtualIP{IP: "192.168.1.100"}).Validate(m), "already used by cluster")
+	require.ErrorContains(t, lbs(static, &VirtualIP{IP: "192.168.1.100", VirtualRouterID: 10}, &VirtualIP{IP: "192.168.1.101", VirtualRouterID: 10}).Validate(m), "virtual router ID 10")
+	require.Error(t, lbs(static, &VirtualIP{IP: "2001:db8::1"}).Validate(m))
+	require.Error(t, lbs(static, &VirtualIP{IP: "192.168.1.100", VirtualRouterID: 256}).Validate(m))
+
+	// the dns is optional with a virtual IP.
+	noDNS := lbs(static, &VirtualIP{IP: "192.168.1.100"}, nil)
+	noDNS.Clusters[0].DNS = nil
+	require.NoError(t, noDNS.Validate(m))
+	noDNS.Clusters[1].DNS = nil
+	require.ErrorContains(t, noDNS.Validate(m), "'DNS' needs to be set")
+
+	lb := lbs(static, &VirtualIP{IP: "192.168.1.100"}).Clusters[0]
+	vip := m.CreateVirtualIP(&lb)
+	require.Equal(t, "192.168.1.100", vip.Ip)
+	require.True(t, vip.VirtualRouterId >= 1 && vip.VirtualRouterId <= 255)
+	require.Equal(t, vip, m.CreateVirtualIP(&lb))
+	require.Nil(t, m.CreateVirtualIP(&LoadBalancerCluster{}))
+}
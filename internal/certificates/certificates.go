@@ -0,0 +1,269 @@
+// Package certificates implements obtaining of the certificates for the TLS termination
+// on the loadbalancers via ACME, using the DNS-01 challenge against the DNS provider
+// of the loadbalancer.
+package certificates
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog/log"
+
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	// LetsEncryptDirectory is the ACME directory used if none is specified.
+	LetsEncryptDirectory = acme.LetsEncryptURL
+
+	// RenewBefore is how long before the expiry the certificates are renewed.
+	RenewBefore = 30 * 24 * time.Hour
+
+	// challengePrefix is the label under which the TXT records for the DNS-01 challenge are created.
+	challengePrefix = "_acme-challenge."
+)
+
+var (
+	// propagationTimeout is the maximum time to wait for the TXT records to be resolvable.
+	propagationTimeout = 5 * time.Minute
+
+	// propagationInterval is the interval at which the TXT records are looked up.
+	propagationInterval = 10 * time.Second
+
+	// lookupTXT resolves the TXT records, replaced in tests.
+	lookupTXT = net.DefaultResolver.LookupTXT
+)
+
+// Names returns the names for which the certificates of the roles
+// of the loadbalancer are issued, the endpoint and the alternative names.
+func Names(lb *spec.LBcluster) []string {
+	var names []string
+	if ep := lb.GetDns().GetEndpoint(); ep != "" {
+		names = append(names, ep)
+	}
+	for _, n := range lb.GetDns().GetAlternativeNames() {
+		if n.Endpoint != "" {
+			names = append(names, n.Endpoint)
+		}
+	}
+	return names
+}
+
+// NeedsRenewal returns true if there is no certificate, it does not cover
+// exactly the names or it expires within [RenewBefore].
+func NeedsRenewal(tls *spec.Role_Tls, names []string, now time.Time) bool {
+	if tls.GetCertificate() == "" || tls.GetPrivateKey() == "" || len(names) == 0 {
+		return true
+	}
+
+	cert, err := parseLeaf(tls.Certificate)
+	if err != nil {
+		return true
+	}
+
+	if now.Add(RenewBefore).After(cert.NotAfter) {
+		return true
+	}
+
+	issued := slices.Sorted(slices.Values(cert.DNSNames))
+	return !slices.Equal(issued, slices.Sorted(slices.Values(names)))
+}
+
+// Obtain obtains a new certificate for the names from the ACME directory of the
+// tls settings, solving the DNS-01 challenges with the solver. On success the
+// certificate, its private key and the account key are stored in the tls settings.
+func Obtain(ctx context.Context, tls *spec.Role_Tls, names []string, solver Solver) error {
+	if len(names) == 0 {
+		return errors.New("no names to obtain the certificate for")
+	}
+
+	accountKey, err := accountKey(tls.AccountKey)
+	if err != nil {
+		return err
+	}
+
+	directory := tls.Directory
+	if directory == "" {
+		directory = LetsEncryptDirectory
+	}
+
+	client := &acme.Client{Key: accountKey, DirectoryURL: directory}
+
+	account := &acme.Account{Contact: []string{"mailto:" + tls.Email}}
+	if _, err := client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return fmt.Errorf("failed to register ACME account: %w", err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(names...))
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+
+	for _, u := range order.AuthzURLs {
+		if err := authorize(ctx, client, u, solver); err != nil {
+			return err
+		}
+	}
+
+	if order, err = client.WaitOrder(ctx, order.URI); err != nil {
+		return fmt.Errorf("failed to wait for the order to be ready: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate certificate key: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: names}, key)
+	if err != nil {
+		return fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	chain, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return fmt.Errorf("failed to finalize order: %w", err)
+	}
+
+	var certificate []byte
+	for _, der := range chain {
+		certificate = append(certificate, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+
+	privateKey, err := encodeKey(key)
+	if err != nil {
+		return err
+	}
+
+	encodedAccountKey, err := encodeKey(accountKey)
+	if err != nil {
+		return err
+	}
+
+	tls.Certificate = string(certificate)
+	tls.PrivateKey = privateKey
+	tls.AccountKey = encodedAccountKey
+	return nil
+}
+
+// authorize solves the DNS-01 challenge of the authorization, if not already valid.
+func authorize(ctx context.Context, client *acme.Client, url string, solver Solver) error {
+	authz, err := client.GetAuthorization(ctx, url)
+	if err != nil {
+		return fmt.Errorf("failed to get authorization: %w", err)
+	}
+
+	if authz.Status == acme.StatusValid {
+		return nil
+	}
+
+	idx := slices.IndexFunc(authz.Challenges, func(c *acme.Challenge) bool { return c.Type == "dns-01" })
+	if idx < 0 {
+		return fmt.Errorf("no dns-01 challenge offered for %q", authz.Identifier.Value)
+	}
+	challenge := authz.Challenges[idx]
+
+	value, err := client.DNS01ChallengeRecord(challenge.Token)
+	if err != nil {
+		return fmt.Errorf("failed to compute the dns-01 challenge record for %q: %w", authz.Identifier.Value, err)
+	}
+
+	fqdn := challengePrefix + authz.Identifier.Value
+	if err := solver.Present(ctx, fqdn, value); err != nil {
+		return fmt.Errorf("failed to create TXT record %q: %w", fqdn, err)
+	}
+
+	defer func() {
+		// The TXT record is no longer needed once the authorization is done,
+		// regardless of its result. Failed cleanups are retried by the next
+		// challenge for the same name, which replaces the record.
+		if err := solver.CleanUp(context.WithoutCancel(ctx), fqdn, value); err != nil {
+			log.Warn().Err(err).Msgf("Failed to remove TXT record %q", fqdn)
+		}
+	}()
+
+	if err := waitForRecord(ctx, fqdn, value); err != nil {
+		return err
+	}
+
+	if _, err := client.Accept(ctx, challenge); err != nil {
+		return fmt.Errorf("failed to accept the dns-01 challenge for %q: %w", authz.Identifier.Value, err)
+	}
+
+	if _, err := client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("authorization for %q failed: %w", authz.Identifier.Value, err)
+	}
+
+	return nil
+}
+
+// waitForRecord waits until the TXT record with the value is resolvable.
+func waitForRecord(ctx context.Context, fqdn, value string) error {
+	ctx, cancel := context.WithTimeout(ctx, propagationTimeout)
+	defer cancel()
+
+	for {
+		records, _ := lookupTXT(ctx, fqdn)
+		if slices.Contains(records, value) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("TXT record %q was not propagated within %v", fqdn, propagationTimeout)
+		case <-time.After(propagationInterval):
+		}
+	}
+}
+
+func accountKey(encoded string) (crypto.Signer, error) {
+	if encoded == "" {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate account key: %w", err)
+		}
+		return key, nil
+	}
+
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("failed to decode account key")
+	}
+
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse account key: %w", err)
+	}
+	return key, nil
+}
+
+func encodeKey(key crypto.Signer) (string, error) {
+	ec, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+	der, err := x509.MarshalECPrivateKey(ec)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode key: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
+
+// parseLeaf parses the first certificate of the PEM encoded chain.
+func parseLeaf(chain string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(chain))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("failed to decode certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
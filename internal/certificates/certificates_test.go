@@ -0,0 +1,174 @@
+package certificates
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/internal/spectesting"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNames(t *testing.T) {
+	lb := &spec.LBcluster{Dns: &spec.DNS{
+		Endpoint: "api.example.com",
+		AlternativeNames: []*spec.AlternativeName{
+			{Hostname: "alt", Endpoint: "alt.example.com"},
+			{Hostname: "pending"},
+		},
+	}}
+	assert.Equal(t, []string{"api.example.com", "alt.example.com"}, Names(lb))
+	assert.Empty(t, Names(&spec.LBcluster{}))
+}
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Now()
+	names := []string{"b.example.com", "a.example.com"}
+
+	valid, validKey := spectesting.GenerateFakeCertificate([]string{"a.example.com", "b.example.com"}, now.Add(60*24*time.Hour))
+	expiring, expiringKey := spectesting.GenerateFakeCertificate(names, now.Add(RenewBefore-time.Hour))
+
+	tests := []struct {
+		name  string
+		tls   *spec.Role_Tls
+		names []string
+		want  bool
+	}{
+		{name: "nil", tls: nil, names: names, want: true},
+		{name: "no-certificate", tls: &spec.Role_Tls{}, names: names, want: true},
+		{name: "invalid-certificate", tls: &spec.Role_Tls{Certificate: "invalid", PrivateKey: validKey}, names: names, want: true},
+		{name: "no-names", tls: &spec.Role_Tls{Certificate: valid, PrivateKey: validKey}, names: nil, want: true},
+		{name: "valid", tls: &spec.Role_Tls{Certificate: valid, PrivateKey: validKey}, names: names, want: false},
+		{name: "expiring", tls: &spec.Role_Tls{Certificate: expiring, PrivateKey: expiringKey}, names: names, want: true},
+		{name: "name-added", tls: &spec.Role_Tls{Certificate: valid, PrivateKey: validKey}, names: append(names, "c.example.com"), want: true},
+		{name: "name-removed", tls: &spec.Role_Tls{Certificate: valid, PrivateKey: validKey}, names: names[:1], want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NeedsRenewal(tt.tls, tt.names, now))
+		})
+	}
+}
+
+func TestNewSolver(t *testing.T) {
+	for _, p := range []*spec.Provider{
+		{ProviderType: &spec.Provider_Cloudflare{Cloudflare: &spec.CloudflareProvider{Token: "token"}}},
+		{ProviderType: &spec.Provider_Hetzner{Hetzner: &spec.HetznerProvider{Token: "token"}}},
+		{ProviderType: &spec.Provider_Aws{Aws: &spec.AWSProvider{AccessKey: "key", SecretKey: "secret"}}},
+	} {
+		s, err := NewSolver(&spec.DNS{DnsZone: "example.com", Provider: p})
+		assert.NoError(t, err)
+		assert.NotNil(t, s)
+	}
+
+	_, err := NewSolver(&spec.DNS{DnsZone: "example.com", Provider: &spec.Provider{
+		CloudProviderName: "gcp",
+		ProviderType:      &spec.Provider_Gcp{Gcp: &spec.GCPProvider{}},
+	}})
+	assert.Error(t, err)
+}
+
+type request struct {
+	method string
+	uri    string
+	body   string
+}
+
+// record starts a server that records the requests and responds with the response for the path.
+func record(t *testing.T, responses map[string]any) (*httptest.Server, *[]request) {
+	t.Helper()
+
+	var requests []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, request{method: r.Method, uri: r.URL.RequestURI(), body: string(b)})
+
+		if resp, ok := responses[r.URL.Path]; ok {
+			assert.NoError(t, json.NewEncoder(w).Encode(resp))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func TestCloudflare(t *testing.T) {
+	srv, requests := record(t, map[string]any{
+		"/zones":                     map[string]any{"result": []map[string]string{{"id": "zone-id"}}},
+		"/zones/zone-id/dns_records": map[string]any{"result": []map[string]string{{"id": "record-id"}}},
+	})
+
+	c := &cloudflare{api: newAPI(srv.URL, "token"), zone: "example.com"}
+
+	assert.NoError(t, c.Present(context.Background(), "_acme-challenge.api.example.com", "value"))
+	assert.NoError(t, c.CleanUp(context.Background(), "_acme-challenge.api.example.com", "value"))
+
+	assert.Equal(t, []request{
+		{method: http.MethodGet, uri: "/zones?name=example.com"},
+		{method: http.MethodPost, uri: "/zones/zone-id/dns_records", body: `{"type":"TXT","name":"_acme-challenge.api.example.com","content":"value","ttl":60}`},
+		{method: http.MethodGet, uri: "/zones?name=example.com"},
+		{method: http.MethodGet, uri: "/zones/zone-id/dns_records?content=value&name=_acme-challenge.api.example.com&type=TXT"},
+		{method: http.MethodDelete, uri: "/zones/zone-id/dns_records/record-id"},
+	}, *requests)
+}
+
+func TestCloudflareZoneNotFound(t *testing.T) {
+	srv, _ := record(t, map[string]any{"/zones": map[string]any{"result": []any{}}})
+
+	c := &cloudflare{api: newAPI(srv.URL, "token"), zone: "example.com"}
+	assert.ErrorContains(t, c.Present(context.Background(), "_acme-challenge.api.example.com", "value"), "not found")
+}
+
+func TestHetzner(t *testing.T) {
+	srv, requests := record(t, nil)
+
+	h := &hetzner{api: newAPI(srv.URL, "token"), zone: "example.com"}
+
+	assert.NoError(t, h.Present(context.Background(), "_acme-challenge.api.example.com", "value"))
+	assert.NoError(t, h.CleanUp(context.Background(), "_acme-challenge.api.example.com.", "value"))
+
+	assert.Equal(t, []request{
+		{method: http.MethodPost, uri: "/zones/example.com/rrsets/_acme-challenge.api/TXT/actions/add_records", body: `{"ttl":60,"records":[{"value":"\"value\""}]}`},
+		{method: http.MethodPost, uri: "/zones/example.com/rrsets/_acme-challenge.api/TXT/actions/remove_records", body: `{"records":[{"value":"\"value\""}]}`},
+	}, *requests)
+}
+
+func TestAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	err := newAPI(srv.URL, "token").do(context.Background(), http.MethodGet, "/zones", nil, nil)
+	assert.ErrorContains(t, err, "403")
+}
+
+func TestWaitForRecord(t *testing.T) {
+	defaultLookup, defaultInterval, defaultTimeout := lookupTXT, propagationInterval, propagationTimeout
+	t.Cleanup(func() {
+		lookupTXT, propagationInterval, propagationTimeout = defaultLookup, defaultInterval, defaultTimeout
+	})
+
+	propagationInterval = time.Millisecond
+	propagationTimeout = 100 * time.Millisecond
+
+	lookups := 0
+	lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		lookups++
+		if lookups < 3 {
+			return []string{"stale"}, nil
+		}
+		return []string{"stale", "value"}, nil
+	}
+
+	assert.NoError(t, waitForRecord(context.Background(), "_acme-challenge.api.example.com", "value"))
+	assert.Equal(t, 3, lookups)
+
+	assert.ErrorContains(t, waitForRecord(context.Background(), "_acme-challenge.api.example.com", "other"), "not propagated")
+}
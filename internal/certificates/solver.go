@@ -0,0 +1,102 @@
+package certificates
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+)
+
+// challengeTTL is the TTL of the TXT records created for the DNS-01 challenges.
+const challengeTTL = 60
+
+// SupportedProviders are the DNS providers for which certificates can be obtained.
+var SupportedProviders = []string{"aws", "cloudflare", "hetzner"}
+
+// Solver creates and removes the TXT records for the DNS-01 challenges.
+type Solver interface {
+	// Present creates the TXT record with the value.
+	Present(ctx context.Context, fqdn, value string) error
+	// CleanUp removes the TXT record with the value created by Present.
+	CleanUp(ctx context.Context, fqdn, value string) error
+}
+
+// NewSolver returns a [Solver] that manages the TXT records within the DNS zone
+// using the provider of the DNS.
+func NewSolver(dns *spec.DNS) (Solver, error) {
+	zone := strings.TrimSuffix(dns.GetDnsZone(), ".")
+
+	switch p := dns.GetProvider().GetProviderType().(type) {
+	case *spec.Provider_Cloudflare:
+		return &cloudflare{api: newAPI(cloudflareAPI, p.Cloudflare.Token), zone: zone}, nil
+	case *spec.Provider_Hetzner:
+		return &hetzner{api: newAPI(hetznerAPI, p.Hetzner.Token), zone: zone}, nil
+	case *spec.Provider_Aws:
+		return newRoute53Solver(p.Aws, zone), nil
+	default:
+		return nil, fmt.Errorf("obtaining certificates is not supported for DNS provider %q", dns.GetProvider().GetCloudProviderName())
+	}
+}
+
+// relativeName returns the name relative to the zone.
+func relativeName(fqdn, zone string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(fqdn, "."), "."+zone)
+}
+
+// api is a minimal client for the JSON REST APIs of the DNS providers.
+type api struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+func newAPI(baseURL, token string) api {
+	return api{baseURL: baseURL, token: token, client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// do sends the request with the body encoded as JSON and decodes the response into out, if not nil.
+func (a api) do(ctx context.Context, method, path string, body, out any) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, r)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error making http request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s: response with status code %v: %s", method, path, resp.StatusCode, bytes.TrimSpace(b))
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(b, out); err != nil {
+			return fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const cloudflareAPI = "https://api.cloudflare.com/client/v4"
+
+// cloudflare manages the TXT records via the Cloudflare API.
+type cloudflare struct {
+	api  api
+	zone string
+}
+
+type cloudflareRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+}
+
+func (c *cloudflare) Present(ctx context.Context, fqdn, value string) error {
+	zone, err := c.zoneID(ctx)
+	if err != nil {
+		return err
+	}
+
+	record := cloudflareRecord{Type: "TXT", Name: fqdn, Content: value, TTL: challengeTTL}
+	return c.api.do(ctx, http.MethodPost, fmt.Sprintf("/zones/%s/dns_records", zone), record, nil)
+}
+
+func (c *cloudflare) CleanUp(ctx context.Context, fqdn, value string) error {
+	zone, err := c.zoneID(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := url.Values{"type": {"TXT"}, "name": {fqdn}, "content": {value}}
+
+	var records struct {
+		Result []cloudflareRecord `json:"result"`
+	}
+	if err := c.api.do(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/dns_records?%s", zone, query.Encode()), nil, &records); err != nil {
+		return err
+	}
+
+	for _, r := range records.Result {
+		if err := c.api.do(ctx, http.MethodDelete, fmt.Sprintf("/zones/%s/dns_records/%s", zone, r.ID), nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *cloudflare) zoneID(ctx context.Context) (string, error) {
+	var zones struct {
+		Result []struct {
+			ID string `json:"id"`
+		} `json:"result"`
+	}
+
+	if err := c.api.do(ctx, http.MethodGet, "/zones?name="+url.QueryEscape(c.zone), nil, &zones); err != nil {
+		return "", err
+	}
+
+	if len(zones.Result) == 0 {
+		return "", fmt.Errorf("zone %q not found", c.zone)
+	}
+	return zones.Result[0].ID, nil
+}
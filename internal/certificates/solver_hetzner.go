@@ -0,0 +1,40 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const hetznerAPI = "https://api.hetzner.cloud/v1"
+
+// hetzner manages the TXT records via the Hetzner Cloud DNS API.
+type hetzner struct {
+	api  api
+	zone string
+}
+
+type hetznerRecords struct {
+	TTL     int             `json:"ttl,omitempty"`
+	Records []hetznerRecord `json:"records"`
+}
+
+type hetznerRecord struct {
+	Value string `json:"value"`
+}
+
+func (h *hetzner) Present(ctx context.Context, fqdn, value string) error {
+	body := hetznerRecords{TTL: challengeTTL, Records: []hetznerRecord{{Value: strconv.Quote(value)}}}
+	return h.api.do(ctx, http.MethodPost, h.rrset(fqdn)+"/actions/add_records", body, nil)
+}
+
+func (h *hetzner) CleanUp(ctx context.Context, fqdn, value string) error {
+	body := hetznerRecords{Records: []hetznerRecord{{Value: strconv.Quote(value)}}}
+	return h.api.do(ctx, http.MethodPost, h.rrset(fqdn)+"/actions/remove_records", body, nil)
+}
+
+func (h *hetzner) rrset(fqdn string) string {
+	return fmt.Sprintf("/zones/%s/rrsets/%s/TXT", url.PathEscape(h.zone), url.PathEscape(relativeName(fqdn, h.zone)))
+}
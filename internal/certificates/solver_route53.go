@@ -0,0 +1,81 @@
+package certificates
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/berops/claudie/proto/pb/spec"
+)
+
+// route53Solver manages the TXT records via the AWS Route53 API.
+type route53Solver struct {
+	client *route53.Client
+	zone   string
+}
+
+func newRoute53Solver(p *spec.AWSProvider, zone string) *route53Solver {
+	client := route53.New(route53.Options{
+		// Route53 is a global service served from us-east-1.
+		Region: "us-east-1",
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: p.AccessKey, SecretAccessKey: p.SecretKey}, nil
+		}),
+		RetryMaxAttempts: 5,
+		RetryMode:        aws.RetryModeStandard,
+	})
+	return &route53Solver{client: client, zone: zone}
+}
+
+func (r *route53Solver) Present(ctx context.Context, fqdn, value string) error {
+	return r.change(ctx, types.ChangeActionUpsert, fqdn, value)
+}
+
+func (r *route53Solver) CleanUp(ctx context.Context, fqdn, value string) error {
+	return r.change(ctx, types.ChangeActionDelete, fqdn, value)
+}
+
+func (r *route53Solver) change(ctx context.Context, action types.ChangeAction, fqdn, value string) error {
+	zone, err := r.zoneID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zone),
+		ChangeBatch: &types.ChangeBatch{
+			Changes: []types.Change{{
+				Action: action,
+				ResourceRecordSet: &types.ResourceRecordSet{
+					Name:            aws.String(fqdn),
+					Type:            types.RRTypeTxt,
+					TTL:             aws.Int64(challengeTTL),
+					ResourceRecords: []types.ResourceRecord{{Value: aws.String(strconv.Quote(value))}},
+				},
+			}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s TXT record: %w", strings.ToLower(string(action)), err)
+	}
+	return nil
+}
+
+func (r *route53Solver) zoneID(ctx context.Context) (string, error) {
+	out, err := r.client.ListHostedZonesByName(ctx, &route53.ListHostedZonesByNameInput{
+		DNSName:  aws.String(r.zone),
+		MaxItems: aws.Int32(1),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list hosted zones: %w", err)
+	}
+
+	if len(out.HostedZones) == 0 || strings.TrimSuffix(aws.ToString(out.HostedZones[0].Name), ".") != r.zone {
+		return "", fmt.Errorf("hosted zone %q not found", r.zone)
+	}
+	return aws.ToString(out.HostedZones[0].Id), nil
+}
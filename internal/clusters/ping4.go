@@ -33,9 +33,33 @@ var pingConcurrentWorkers = envs.GetOrDefaultInt("PING_CONCURRENT_WORKERS", 20)
 // ErrEchoTimeout is returned when the reply is not received within the requested timeout.
 var ErrEchoTimeout = errors.New("icmp request timeout")
 
+// icmpFamily holds the parts of the icmp echo that differ between IPv4 and IPv6.
+type icmpFamily struct {
+	// network and address to listen on for the replies.
+	network string
+	address string
+	// protocol number used for parsing the replies.
+	protocol int
+
+	echoRequest icmp.Type
+	echoReply   icmp.Type
+}
+
+var icmp4 = icmpFamily{
+	network:     "udp4",
+	address:     "0.0.0.0",
+	protocol:    1,
+	echoRequest: ipv4.ICMPTypeEcho,
+	echoReply:   ipv4.ICMPTypeEchoReply,
+}
+
 func ping4(logger zerolog.Logger, conn *icmp.PacketConn, id, seq int, dst *net.UDPAddr, timeout time.Duration) error {
+	return echo(logger, icmp4, conn, id, seq, dst, timeout)
+}
+
+func echo(logger zerolog.Logger, family icmpFamily, conn *icmp.PacketConn, id, seq int, dst *net.UDPAddr, timeout time.Duration) error {
 	m := icmp.Message{
-		Type: ipv4.ICMPTypeEcho,
+		Type: family.echoRequest,
 		Code: 0,
 		Body: &icmp.Echo{
 			ID:   id,
@@ -88,14 +112,14 @@ func ping4(logger zerolog.Logger, conn *icmp.PacketConn, id, seq int, dst *net.U
 				continue
 			}
 
-			reply, err := icmp.ParseMessage(1, rcv[:r]) // 1 is icmp ip4 protocol.
+			reply, err := icmp.ParseMessage(family.protocol, rcv[:r])
 			if err != nil {
 				logger.Err(err).Msgf("failed to parse icmp seq %v reply", seq)
 				continue
 			}
 
 			switch reply.Type {
-			case ipv4.ICMPTypeEchoReply:
+			case family.echoReply:
 				body, ok := reply.Body.(*icmp.Echo)
 				if !ok {
 					logger.Warn().Msg("Received icmp echo reply does not have expected echo body, skipping")
@@ -123,7 +147,7 @@ func ping4(logger zerolog.Logger, conn *icmp.PacketConn, id, seq int, dst *net.U
 	}
 }
 
-// Ping pings a single IPv4 address with the requested amount of retries.
+// Ping pings a single IPv4 or IPv6 address with the requested amount of retries.
 // An error is returned when more then count/2 packets are lost.
 func Ping(logger zerolog.Logger, count int, dst string) error {
 	dstAddr := &net.UDPAddr{IP: net.ParseIP(dst)}
@@ -132,7 +156,12 @@ func Ping(logger zerolog.Logger, count int, dst string) error {
 		return fmt.Errorf("unhealthy connection: %w, invalid IP address %q", ErrEchoTimeout, dst)
 	}
 
-	conn, err := icmp.ListenPacket("udp4", "0.0.0.0")
+	family, ping := icmp4, ping4
+	if dstAddr.IP.To4() == nil {
+		family, ping = icmp6, ping6
+	}
+
+	conn, err := icmp.ListenPacket(family.network, family.address)
 	if err != nil {
 		return fmt.Errorf("failed to listen for icmp packets: %w", err)
 	}
@@ -146,7 +175,7 @@ func Ping(logger zerolog.Logger, count int, dst string) error {
 		time.Sleep(1 * time.Second)
 		seq := i + 1
 		send := time.Now()
-		if err := ping4(logger, conn, id, seq, dstAddr, PingTimeout); err != nil {
+		if err := ping(logger, conn, id, seq, dstAddr, PingTimeout); err != nil {
 			lost++
 			if errors.Is(err, ErrEchoTimeout) {
 				logger.Warn().Msgf("[%v] node %s icmp seq %v, lost", time.Since(send).String(), dst, seq)
@@ -164,7 +193,7 @@ func Ping(logger zerolog.Logger, count int, dst string) error {
 }
 
 // PingNodes pings nodes of the cluster, including loadbalancer nodes, using
-// the public IPv4 Address of the nodes.
+// the public IPv4 or IPv6 Address of the nodes.
 func PingNodes(logger zerolog.Logger, state *spec.Clusters) (map[string][]string, map[string]map[string][]string, error) {
 	type nodemap = map[string]string
 
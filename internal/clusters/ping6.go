@@ -0,0 +1,23 @@
+package clusters
+
+import (
+	"net"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv6"
+)
+
+var icmp6 = icmpFamily{
+	network:     "udp6",
+	address:     "::",
+	protocol:    58,
+	echoRequest: ipv6.ICMPTypeEchoRequest,
+	echoReply:   ipv6.ICMPTypeEchoReply,
+}
+
+func ping6(logger zerolog.Logger, conn *icmp.PacketConn, id, seq int, dst *net.UDPAddr, timeout time.Duration) error {
+	return echo(logger, icmp6, conn, id, seq, dst, timeout)
+}
@@ -8,12 +8,15 @@ import (
 type (
 	K8sData struct{ HasAPIServer bool }
 	LBData  struct{ Roles []*spec.Role }
-	IPData  struct{ V4 string }
+	IPData  struct{ V4, V6 string }
 
 	// RecordData is a simple wrapper containing related data
 	// for DNS records to be created.
 	RecordData struct {
+		// IP holds the nodes with an IPv4 address, for the A records.
 		IP []IPData
+		// IPv6 holds the nodes with an IPv6 address, for the AAAA records.
+		IPv6 []IPData
 	}
 
 	// A grouping of regions and their networks.
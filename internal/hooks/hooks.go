@@ -0,0 +1,100 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+)
+
+// DefaultTimeout is the timeout of a single call of a hook that does not define one.
+const DefaultTimeout = 30 * time.Second
+
+// ErrRetryLater is returned by [Call] if the hook asked to be called again later,
+// i.e. because the workloads on the node are not yet safe to be moved.
+var ErrRetryLater = errors.New("hook asked to retry later")
+
+// Event is the lifecycle event of a node posted to a hook.
+type Event string
+
+const (
+	// PreDrain is posted before a node is cordoned and drained.
+	PreDrain Event = "preDrain"
+	// PostJoin is posted after a node is joined into the cluster and Ready.
+	PostJoin Event = "postJoin"
+)
+
+// Request is the JSON body posted to a hook.
+type Request struct {
+	// Event of the node.
+	Event Event `json:"event"`
+	// Cluster is the ID of the cluster, i.e. <name>-<hash>.
+	Cluster string `json:"cluster"`
+	// NodePool is the name of the nodepool of the node.
+	NodePool string `json:"nodepool"`
+	// Node is the name of the node within the cluster.
+	Node string `json:"node"`
+	// Endpoint is the public endpoint of the node.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// Call posts the request to the hook. The hook approves the event by responding with
+// a 2xx status code. The status codes 423 (Locked), 429 (Too Many Requests) and 503
+// (Service Unavailable) ask for the event to be retried later, in which case
+// [ErrRetryLater] is returned. Any other response is returned as an error.
+// A nil hook approves every event.
+func Call(ctx context.Context, hook *spec.NodeHook, req Request) error {
+	if hook.GetUrl() == "" {
+		return nil
+	}
+
+	timeout := DefaultTimeout
+	if hook.GetTimeout() != "" {
+		d, err := time.ParseDuration(hook.GetTimeout())
+		if err != nil {
+			return fmt.Errorf("invalid timeout %q of the %s hook: %w", hook.GetTimeout(), req.Event, err)
+		}
+		timeout = d
+	}
+
+	b, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.GetUrl(), bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+	r.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(r)
+	if err != nil {
+		return fmt.Errorf("error calling the %s hook for node %s: %w", req.Event, req.Node, err)
+	}
+	defer resp.Body.Close()
+
+	// the body is only used to explain the response, limit what is read of it.
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	body = bytes.TrimSpace(body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusLocked,
+		resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode == http.StatusServiceUnavailable:
+		return fmt.Errorf("%s hook for node %s: %w: %s", req.Event, req.Node, ErrRetryLater, body)
+	default:
+		return fmt.Errorf("%s hook for node %s: response with status code %v: %s", req.Event, req.Node, resp.StatusCode, body)
+	}
+}
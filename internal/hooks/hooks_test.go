@@ -0,0 +1,70 @@
+package hooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	req := Request{
+		Event:    PreDrain,
+		Cluster:  "cluster-hash",
+		NodePool: "np-aaaaaaa",
+		Node:     "np-aaaaaaa-01",
+		Endpoint: "203.0.113.10",
+	}
+
+	tests := []struct {
+		name    string
+		status  int
+		delay   time.Duration
+		timeout string
+		retry   bool
+		wantErr bool
+	}{
+		{name: "ok", status: http.StatusOK},
+		{name: "no-content", status: http.StatusNoContent},
+		{name: "locked", status: http.StatusLocked, retry: true, wantErr: true},
+		{name: "unavailable", status: http.StatusServiceUnavailable, retry: true, wantErr: true},
+		{name: "server-error", status: http.StatusInternalServerError, wantErr: true},
+		{name: "timeout", status: http.StatusOK, delay: time.Second, timeout: "50ms", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var got Request
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				assert.Equal(t, req, got)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				select {
+				case <-time.After(tt.delay):
+				case <-r.Context().Done():
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := Call(context.Background(), &spec.NodeHook{Url: srv.URL, Timeout: tt.timeout}, req)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.Equal(t, tt.retry, err != nil && errors.Is(err, ErrRetryLater))
+		})
+	}
+
+	// without a hook every event is approved.
+	require.NoError(t, Call(context.Background(), nil, req))
+}
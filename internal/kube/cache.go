@@ -0,0 +1,115 @@
+package kube
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/berops/claudie/internal/hash"
+)
+
+// idleTimeout is the duration after which a cached client that was
+// not used is dropped, so that the clients of the clusters that were
+// deleted do not stay in the cache for the lifetime of the process.
+const idleTimeout = 30 * time.Minute
+
+// clients caches the created clients, so that the connections to the
+// API servers are reused across tasks and health checks.
+var clients = newCache()
+
+type cacheKey struct {
+	kubeconfig string
+	context    string
+	timeout    time.Duration
+}
+
+type cachedClient struct {
+	client   *Client
+	lastUsed time.Time
+}
+
+type cache struct {
+	lock sync.Mutex
+
+	// now returns the current time, replaced in tests.
+	now func() time.Time
+
+	// clients per cluster ID.
+	clients map[string]map[cacheKey]*cachedClient
+}
+
+func newCache() *cache {
+	return &cache{
+		now:     time.Now,
+		clients: make(map[string]map[cacheKey]*cachedClient),
+	}
+}
+
+// ForCluster returns the [Client] for the cluster, creating it on the first use.
+// The clients are cached per cluster ID and the hash of the kubeconfig, once the
+// kubeconfig of the cluster changes the clients created for the previous one are dropped.
+// Clients not used for the [idleTimeout] are dropped as well.
+func ForCluster(cluster, kubeconfig string, opts Options) (*Client, error) {
+	return clients.get(cluster, kubeconfig, opts)
+}
+
+// Forget drops the cached clients of the cluster, to be called once the cluster is deleted.
+func Forget(cluster string) { clients.forget(cluster) }
+
+func (c *cache) get(cluster, kubeconfig string, opts Options) (*Client, error) {
+	key := cacheKey{
+		kubeconfig: hex.EncodeToString(hash.Digest(kubeconfig)),
+		context:    opts.Context,
+		timeout:    opts.timeout(),
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.now()
+	c.dropIdle(now)
+
+	if cached, ok := c.clients[cluster][key]; ok {
+		cached.lastUsed = now
+		return cached.client, nil
+	}
+
+	client, err := New(cluster, kubeconfig, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	perCluster := c.clients[cluster]
+	for k := range perCluster {
+		if k.kubeconfig != key.kubeconfig {
+			delete(perCluster, k)
+		}
+	}
+	if perCluster == nil {
+		perCluster = make(map[cacheKey]*cachedClient)
+		c.clients[cluster] = perCluster
+	}
+	perCluster[key] = &cachedClient{client: client, lastUsed: now}
+	return client, nil
+}
+
+func (c *cache) forget(cluster string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.clients, cluster)
+}
+
+// dropIdle drops the clients not used for the [idleTimeout].
+// Must be called with the lock held.
+func (c *cache) dropIdle(now time.Time) {
+	for cluster, perCluster := range c.clients {
+		for k, cached := range perCluster {
+			if now.Sub(cached.lastUsed) > idleTimeout {
+				delete(perCluster, k)
+			}
+		}
+		if len(perCluster) == 0 {
+			delete(c.clients, cluster)
+		}
+	}
+}
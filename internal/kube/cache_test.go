@@ -0,0 +1,97 @@
+package kube
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: first
+  cluster:
+    server: https://10.0.0.1:6443
+- name: second
+  cluster:
+    server: https://10.0.0.2:6443
+contexts:
+- name: first
+  context:
+    cluster: first
+    user: admin
+- name: second
+  context:
+    cluster: second
+    user: admin
+current-context: first
+users:
+- name: admin
+  user:
+    token: secret
+`
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	c := newCache()
+
+	first, err := c.get("cluster-a", testKubeconfig, Options{})
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1:6443", first.clientset.CoreV1().RESTClient().Get().URL().Host)
+
+	again, err := c.get("cluster-a", testKubeconfig, Options{Timeout: DefaultTimeout})
+	require.NoError(t, err)
+	require.Same(t, first, again)
+
+	second, err := c.get("cluster-a", testKubeconfig, Options{Context: "second"})
+	require.NoError(t, err)
+	require.NotSame(t, first, second)
+	require.Equal(t, "10.0.0.2:6443", second.clientset.CoreV1().RESTClient().Get().URL().Host)
+	require.Len(t, c.clients["cluster-a"], 2)
+
+	// a changed kubeconfig drops the clients of the previous one.
+	rotated, err := c.get("cluster-a", testKubeconfig+"\n", Options{})
+	require.NoError(t, err)
+	require.NotSame(t, first, rotated)
+	require.Len(t, c.clients["cluster-a"], 1)
+
+	_, err = c.get("cluster-b", "not a kubeconfig", Options{})
+	require.ErrorIs(t, err, ErrInvalidKubeconfig)
+
+	_, err = c.get("cluster-b", testKubeconfig, Options{Context: "missing"})
+	require.ErrorIs(t, err, ErrInvalidKubeconfig)
+	require.NotContains(t, c.clients, "cluster-b")
+
+	// deleted clusters are forgotten.
+	c.forget("cluster-a")
+	require.NotContains(t, c.clients, "cluster-a")
+}
+
+func TestCacheIdle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	c := newCache()
+	c.now = func() time.Time { return now }
+
+	first, err := c.get("cluster-a", testKubeconfig, Options{})
+	require.NoError(t, err)
+	_, err = c.get("cluster-b", testKubeconfig, Options{})
+	require.NoError(t, err)
+
+	// used clients are kept.
+	now = now.Add(idleTimeout)
+	again, err := c.get("cluster-a", testKubeconfig, Options{})
+	require.NoError(t, err)
+	require.Same(t, first, again)
+	require.Contains(t, c.clients, "cluster-b")
+
+	// idle clients are dropped.
+	now = now.Add(time.Second)
+	again, err = c.get("cluster-a", testKubeconfig, Options{})
+	require.NoError(t, err)
+	require.Same(t, first, again)
+	require.NotContains(t, c.clients, "cluster-b")
+}
@@ -0,0 +1,124 @@
+// Package kube provides a typed client for the kubernetes clusters built by claudie,
+// built on top of client-go, as a replacement for shelling out to kubectl.
+package kube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// DefaultTimeout is the timeout of a single request to the API server
+// used when no timeout is specified in the [Options].
+const DefaultTimeout = 30 * time.Second
+
+// Options configure the creation of a [Client].
+type Options struct {
+	// Context is the name of the kubeconfig context to use.
+	// If empty, the current context of the kubeconfig is used.
+	Context string
+
+	// Timeout of a single request to the API server.
+	// If zero, the [DefaultTimeout] is used.
+	Timeout time.Duration
+}
+
+func (o Options) timeout() time.Duration {
+	if o.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return o.Timeout
+}
+
+// Client is a typed client for a single kubernetes cluster. All of the errors
+// returned from the requests to the API server are of type [*Error].
+type Client struct {
+	cluster   string
+	clientset kubernetes.Interface
+	dynamic   dynamic.Interface
+}
+
+// New creates a new [Client] for the cluster from the passed in kubeconfig.
+// Prefer [ForCluster] which reuses the already created clients.
+func New(cluster, kubeconfig string, opts Options) (*Client, error) {
+	raw, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("%w for cluster %s: %w", ErrInvalidKubeconfig, cluster, err)
+	}
+
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: opts.Context}
+	config, err := clientcmd.NewNonInteractiveClientConfig(*raw, opts.Context, overrides, nil).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("%w for cluster %s: %w", ErrInvalidKubeconfig, cluster, err)
+	}
+	config.Timeout = opts.timeout()
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client for cluster %s: %w", cluster, err)
+	}
+
+	dyn, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic kubernetes client for cluster %s: %w", cluster, err)
+	}
+
+	return NewForClientsets(cluster, clientset, dyn), nil
+}
+
+// NewForClientsets creates a [Client] on top of already existing clientsets,
+// i.e. the fake clientsets of client-go in tests.
+func NewForClientsets(cluster string, clientset kubernetes.Interface, dyn dynamic.Interface) *Client {
+	return &Client{
+		cluster:   cluster,
+		clientset: clientset,
+		dynamic:   dyn,
+	}
+}
+
+// Kubernetes returns the underlying clientset for the requests not covered by the [Client].
+func (c *Client) Kubernetes() kubernetes.Interface { return c.clientset }
+
+// List lists the resources of the gvr within the namespace, if empty within all namespaces.
+func (c *Client) List(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]unstructured.Unstructured, error) {
+	list, err := c.dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, c.error("list", gvr.GroupResource().String(), namespace, "", err)
+	}
+	return list.Items, nil
+}
+
+// Patch patches the resource of the gvr with the data of the patch type pt.
+func (c *Client) Patch(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string, pt types.PatchType, data []byte) error {
+	if _, err := c.dynamic.Resource(gvr).Namespace(namespace).Patch(ctx, name, pt, data, metav1.PatchOptions{}); err != nil {
+		return c.error("patch", gvr.GroupResource().String(), namespace, name, err)
+	}
+	return nil
+}
+
+// Delete deletes the resource of the gvr.
+func (c *Client) Delete(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) error {
+	if err := c.dynamic.Resource(gvr).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return c.error("delete", gvr.GroupResource().String(), namespace, name, err)
+	}
+	return nil
+}
+
+func (c *Client) error(verb, resource, namespace, name string, err error) error {
+	return &Error{
+		Cluster:   c.cluster,
+		Verb:      verb,
+		Resource:  resource,
+		Namespace: namespace,
+		Name:      name,
+		Err:       err,
+	}
+}
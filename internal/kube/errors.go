@@ -0,0 +1,51 @@
+package kube
+
+import (
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ErrInvalidKubeconfig is returned when a [Client] could not be created from the passed in kubeconfig.
+var ErrInvalidKubeconfig = errors.New("invalid kubeconfig")
+
+// Error describes a failed request to the API server of a kubernetes cluster.
+type Error struct {
+	// Cluster is the ID of the cluster the request was made to.
+	Cluster string
+	// Verb is the operation of the request, i.e. get, list, patch, update, delete.
+	Verb string
+	// Resource is the kind of resource of the request, i.e. nodes.
+	Resource string
+	// Namespace of the resource, empty for cluster scoped resources.
+	Namespace string
+	// Name of the resource, empty for requests on a collection.
+	Name string
+	// Err is the error returned by the API server or the transport.
+	Err error
+}
+
+func (e *Error) Error() string {
+	object := e.Resource
+	if e.Name != "" {
+		object += "/" + e.Name
+	}
+	if e.Namespace != "" {
+		object += fmt.Sprintf(" in namespace %s", e.Namespace)
+	}
+	return fmt.Sprintf("failed to %s %s of cluster %s: %v", e.Verb, object, e.Cluster, e.Err)
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// Reason returns the reason of the failure as reported by the API server,
+// [metav1.StatusReasonUnknown] if the request did not reach the API server.
+func (e *Error) Reason() metav1.StatusReason { return apierrors.ReasonForError(e.Err) }
+
+// IsNotFound reports whether the err was caused by the requested resource not existing.
+func IsNotFound(err error) bool { return apierrors.IsNotFound(err) }
+
+// IsConflict reports whether the err was caused by a conflicting update of the resource.
+func IsConflict(err error) bool { return apierrors.IsConflict(err) }
@@ -0,0 +1,118 @@
+package kube
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// OutOfServiceTaint marks a node that is shut down, so that the pods with
+// volumes attached to it can be moved to other nodes.
+// https://kubernetes.io/docs/concepts/cluster-administration/node-shutdown/#non-graceful-node-shutdown
+var OutOfServiceTaint = corev1.Taint{
+	Key:    corev1.TaintNodeOutOfService,
+	Value:  "nodeshutdown",
+	Effect: corev1.TaintEffectNoExecute,
+}
+
+// Nodes returns all of the nodes of the cluster.
+func (c *Client) Nodes(ctx context.Context) ([]corev1.Node, error) {
+	list, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, c.error("list", "nodes", "", "", err)
+	}
+	return list.Items, nil
+}
+
+// NodeNames returns the names of all of the nodes of the cluster.
+func (c *Client) NodeNames(ctx context.Context) ([]string, error) {
+	nodes, err := c.Nodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		names = append(names, n.Name)
+	}
+	return names, nil
+}
+
+// Node returns the node with the given name.
+func (c *Client) Node(ctx context.Context, name string) (*corev1.Node, error) {
+	node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, c.error("get", "nodes", "", name, err)
+	}
+	return node, nil
+}
+
+// NodeHasLabel reports whether the node has the label with the given key set.
+func (c *Client) NodeHasLabel(ctx context.Context, name, key string) (bool, error) {
+	node, err := c.Node(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	_, ok := node.Labels[key]
+	return ok, nil
+}
+
+// PatchNode patches the node with the data of the patch type pt.
+func (c *Client) PatchNode(ctx context.Context, name string, pt types.PatchType, data []byte) error {
+	if _, err := c.clientset.CoreV1().Nodes().Patch(ctx, name, pt, data, metav1.PatchOptions{}); err != nil {
+		return c.error("patch", "nodes", "", name, err)
+	}
+	return nil
+}
+
+// Cordon marks the node as unschedulable.
+func (c *Client) Cordon(ctx context.Context, name string) error {
+	return c.PatchNode(ctx, name, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`))
+}
+
+// TaintNode adds the taint to the node, replacing the taint
+// with the same key and effect if already present.
+func (c *Client) TaintNode(ctx context.Context, name string, taint corev1.Taint) error {
+	return c.updateNode(ctx, name, func(node *corev1.Node) {
+		node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(t corev1.Taint) bool { return taint.MatchTaint(&t) })
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+	})
+}
+
+// RemoveTaints removes the taints matching the key and effect of the passed in taints from the node.
+func (c *Client) RemoveTaints(ctx context.Context, name string, taints ...corev1.Taint) error {
+	return c.updateNode(ctx, name, func(node *corev1.Node) {
+		node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(t corev1.Taint) bool {
+			return slices.ContainsFunc(taints, func(r corev1.Taint) bool { return r.MatchTaint(&t) })
+		})
+	})
+}
+
+// DeleteNode deletes the node from the cluster.
+func (c *Client) DeleteNode(ctx context.Context, name string) error {
+	if err := c.clientset.CoreV1().Nodes().Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return c.error("delete", "nodes", "", name, err)
+	}
+	return nil
+}
+
+// updateNode applies the mutation to the latest version of the node, retrying on conflicts.
+func (c *Client) updateNode(ctx context.Context, name string, mutate func(node *corev1.Node)) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		mutate(node)
+		_, err = c.clientset.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return c.error("update", "nodes", "", name, err)
+	}
+	return nil
+}
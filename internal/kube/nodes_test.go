@@ -0,0 +1,66 @@
+package kube
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "worker-01",
+			Labels: map[string]string{"claudie.io/upgrade-lock": ""},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{Key: OutOfServiceTaint.Key, Value: "old", Effect: corev1.TaintEffectNoExecute},
+				{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+	}
+	c := NewForClientsets("cluster-a", fake.NewClientset(node), nil)
+
+	names, err := c.NodeNames(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"worker-01"}, names)
+
+	locked, err := c.NodeHasLabel(ctx, "worker-01", "claudie.io/upgrade-lock")
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	require.NoError(t, c.Cordon(ctx, "worker-01"))
+	require.NoError(t, c.TaintNode(ctx, "worker-01", OutOfServiceTaint))
+
+	got, err := c.Node(ctx, "worker-01")
+	require.NoError(t, err)
+	require.True(t, got.Spec.Unschedulable)
+	require.ElementsMatch(t, []corev1.Taint{node.Spec.Taints[1], OutOfServiceTaint}, got.Spec.Taints)
+
+	require.NoError(t, c.RemoveTaints(ctx, "worker-01", corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}))
+	got, err = c.Node(ctx, "worker-01")
+	require.NoError(t, err)
+	require.Equal(t, []corev1.Taint{OutOfServiceTaint}, got.Spec.Taints)
+
+	require.NoError(t, c.DeleteNode(ctx, "worker-01"))
+
+	err = c.DeleteNode(ctx, "worker-01")
+	require.True(t, IsNotFound(err))
+
+	var kerr *Error
+	require.True(t, errors.As(err, &kerr))
+	require.Equal(t, "delete", kerr.Verb)
+	require.Equal(t, "nodes", kerr.Resource)
+	require.Equal(t, "worker-01", kerr.Name)
+	require.Equal(t, metav1.StatusReasonNotFound, kerr.Reason())
+	require.ErrorContains(t, err, "failed to delete nodes/worker-01 of cluster cluster-a")
+}
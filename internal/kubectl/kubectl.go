@@ -2,9 +2,7 @@
 package kubectl
 
 import (
-	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -109,88 +107,6 @@ func (k *Kubectl) KubectlDeleteString(str string, options ...string) error {
 	return k.run(command, options...)
 }
 
-// KubectlTaintNodeShutdown applies the out-of-service to the requested node
-// so that pods scheduled on that node can be moved to another node.
-// https://kubernetes.io/blog/2023/08/16/kubernetes-1-28-non-graceful-node-shutdown-ga/#what-is-a-non-graceful-node-shutdown
-func (k *Kubectl) KubectlTaintNodeShutdown(nodeName string) error {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf("kubectl taint nodes %s node.kubernetes.io/out-of-service=nodeshutdown:NoExecute --overwrite %s", nodeName, arg)
-	return k.run(command)
-}
-
-// KubectlTaintRemove removes the requested taint from the node.
-func (k *Kubectl) KubectlTaintRemove(nodeName string, key, value, effect string) error {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf("kubectl taint nodes %s %s=%s:%s- %s", nodeName, key, value, effect, arg)
-	return k.run(command)
-}
-
-// KubectlTaint adds a taint to the node.
-func (k *Kubectl) KubectlTaint(nodeName string, key, value, effect string) error {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf("kubectl taint nodes %s %s=%s:%s %s", nodeName, key, value, effect, arg)
-	return k.run(command)
-}
-
-// KubectlNodeHasLabel checks whether the node has a label with the given key, regardless of value.
-// It fetches the full labels map as JSON and tests key presence in Go so that label keys with
-// dots and slashes are handled uniformly, and the check returns true even when the label value
-// is an empty string.
-func (k *Kubectl) KubectlNodeHasLabel(nodeName, labelKey string) (bool, error) {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return false, err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf(`kubectl get node %s -o json %s`, nodeName, arg)
-	out, err := k.runWithOutput(command)
-	if err != nil {
-		return false, err
-	}
-
-	var node struct {
-		Metadata struct {
-			Labels map[string]string `json:"labels"`
-		} `json:"metadata"`
-	}
-	if err := json.Unmarshal(out, &node); err != nil {
-		return false, fmt.Errorf("failed to parse node %q labels: %w", nodeName, err)
-	}
-
-	_, ok := node.Metadata.Labels[labelKey]
-	return ok, nil
-}
-
-// KubectlDrain runs kubectl drain in k.Directory, on a specified node with flags --ignore-daemonsets --delete-emptydir-data
-// example: kubectl drain node1 -> k.KubectlDrain("node1")
-// If the timeout expires before the command finishes the [context.DeadlineExceeded] error is returned.
-func (k *Kubectl) KubectlDrainWithTimeout(timeout time.Duration, nodeName string) error {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf("kubectl drain %s --ignore-daemonsets --delete-emptydir-data %s", nodeName, arg)
-	return k.runWithTimeout(timeout, command)
-}
-
 // KubectlDescribe runs kubectl describe in k.Directory, on a specified resource, resource name and specified namespace
 // if namespace is empty string, the kubectl apply will not use -n flag
 // example: kubectl describe pod test -> k.KubectlDescribe("pod","test")
@@ -305,18 +221,6 @@ func (k *Kubectl) KubectlPatch(resource, resourceName, patchPath string, options
 	return k.run(command, options...)
 }
 
-// KubectlCordon runs kubectl cordon <node name> for a particular node in cluster
-func (k *Kubectl) KubectlCordon(nodeName string, options ...string) error {
-	arg, cleanup, err := k.getKubeconfig()
-	if err != nil {
-		return err
-	}
-	defer cleanup()
-
-	command := fmt.Sprintf("kubectl cordon %s %s", nodeName, arg)
-	return k.run(command, options...)
-}
-
 func (k Kubectl) RolloutRestart(resource string, options ...string) error {
 	arg, cleanup, err := k.getKubeconfig()
 	if err != nil {
@@ -328,56 +232,6 @@ func (k Kubectl) RolloutRestart(resource string, options ...string) error {
 	return k.run(command, options...)
 }
 
-// behaves exactly the same as [Kubectl.run], but uses a custom timeout for the command
-// on both the initial execution and on subsequent retries.
-func (k Kubectl) runWithTimeout(timeout time.Duration, command string, options ...string) error {
-	deadline := time.Now().Add(timeout)
-	ctx, cancel := context.WithDeadline(context.Background(), deadline)
-
-	cmd := exec.CommandContext(ctx, "bash", "-c", strings.Join(append([]string{command}, options...), " "))
-	cmd.Dir = k.Directory
-	cmd.Stdout = k.Stdout
-	cmd.Stderr = k.Stderr
-
-	if err := cmd.Run(); err != nil {
-		if ctx.Err() != nil {
-			err = fmt.Errorf("%w:%w", err, ctx.Err())
-		}
-
-		cancel()
-
-		if k.MaxKubectlRetries < 0 {
-			return err
-		}
-
-		retryCount := k.MaxKubectlRetries
-		if k.MaxKubectlRetries == 0 {
-			retryCount = defaultMaxKubectlRetries
-		}
-
-		remaining := time.Until(deadline)
-		if remaining <= 0 {
-			return fmt.Errorf("%w: execution timeout exceeded before retries", context.DeadlineExceeded)
-		}
-
-		retryCmd := comm.Cmd{
-			Command:        command,
-			Options:        options,
-			Dir:            k.Directory,
-			CommandTimeout: remaining,
-			Stdout:         k.Stdout,
-			Stderr:         k.Stderr,
-		}
-
-		if err = retryCmd.RetryCommand(retryCount); err != nil {
-			return err
-		}
-	}
-
-	cancel()
-	return nil
-}
-
 // runs the command in a bash shell like "bash -c command options".
 // If the initial command fails subsequent retries will have an timeout of [kubectlTimeout].
 func (k Kubectl) run(command string, options ...string) error {
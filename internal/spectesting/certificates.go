@@ -0,0 +1,33 @@
+package spectesting
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	crand "crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+)
+
+// GenerateFakeCertificate generates a self-signed PEM encoded certificate for the names
+// valid until notAfter, along with its PEM encoded private key.
+func GenerateFakeCertificate(names []string, notAfter time.Time) (cert, key string) {
+	k := must(ecdsa.GenerateKey(elliptic.P256(), crand.Reader))
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(int64(rng.Uint64() >> 1)),
+		Subject:      pkix.Name{CommonName: "claudie-testing"},
+		DNSNames:     names,
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der := must(x509.CreateCertificate(crand.Reader, template, template, &k.PublicKey, k))
+	keyDer := must(x509.MarshalECPrivateKey(k))
+
+	cert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	key = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+	return cert, key
+}
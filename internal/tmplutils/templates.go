@@ -12,6 +12,7 @@ import (
 
 	"github.com/Masterminds/sprig/v3"
 	"github.com/berops/claudie/internal/nodepools"
+	"github.com/berops/claudie/proto/pb/spec"
 )
 
 type Templates struct {
@@ -69,6 +70,7 @@ func LoadTemplate(tplFile string) (*template.Template, error) {
 	// Add custom functions.
 	funcMap["replaceAll"] = strings.ReplaceAll
 	funcMap["extractNetmaskFromCIDR"] = ExtractNetmaskFromCIDR
+	funcMap["privateIPv6"] = spec.PrivateIPv6
 	funcMap["hasExtension"] = HasExtension
 	funcMap["sshPort"] = nodepools.SSHPort
 	funcMap["nodeSshPort"] = nodepools.NodeSSHPort
@@ -10,6 +10,7 @@ import (
 	spec "github.com/berops/claudie/proto/pb/spec"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -459,6 +460,147 @@ func (x *NodePoolUpdateTargetSizeResponse) GetTargetSize() int32 {
 	return 0
 }
 
+type UpdateServiceLoadBalancerRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Config  string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cluster string                 `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Namespace and name of the service.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Ports of the service. If empty, the ports
+	// allocated for the service are released.
+	Ports         []*UpdateServiceLoadBalancerRequest_Port `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceLoadBalancerRequest) Reset() {
+	*x = UpdateServiceLoadBalancerRequest{}
+	mi := &file_manager_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceLoadBalancerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceLoadBalancerRequest) ProtoMessage() {}
+
+func (x *UpdateServiceLoadBalancerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceLoadBalancerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServiceLoadBalancerRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateServiceLoadBalancerRequest) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest) GetPorts() []*UpdateServiceLoadBalancerRequest_Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type UpdateServiceLoadBalancerResponse struct {
+	state protoimpl.MessageState                    `protogen:"open.v1"`
+	Ports []*UpdateServiceLoadBalancerResponse_Port `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Hostname of the loadbalancer, empty if not yet assigned.
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	// Public IPs of the loadbalancer nodes.
+	Ips           []string `protobuf:"bytes,3,rep,name=ips,proto3" json:"ips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceLoadBalancerResponse) Reset() {
+	*x = UpdateServiceLoadBalancerResponse{}
+	mi := &file_manager_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceLoadBalancerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceLoadBalancerResponse) ProtoMessage() {}
+
+func (x *UpdateServiceLoadBalancerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceLoadBalancerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServiceLoadBalancerResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateServiceLoadBalancerResponse) GetPorts() []*UpdateServiceLoadBalancerResponse_Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *UpdateServiceLoadBalancerResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerResponse) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
 type GetConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -468,7 +610,7 @@ type GetConfigRequest struct {
 
 func (x *GetConfigRequest) Reset() {
 	*x = GetConfigRequest{}
-	mi := &file_manager_proto_msgTypes[8]
+	mi := &file_manager_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +622,7 @@ func (x *GetConfigRequest) String() string {
 func (*GetConfigRequest) ProtoMessage() {}
 
 func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_manager_proto_msgTypes[8]
+	mi := &file_manager_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +635,7 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_manager_proto_rawDescGZIP(), []int{8}
+	return file_manager_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetConfigRequest) GetName() string {
@@ -512,7 +654,7 @@ type GetConfigResponse struct {
 
 func (x *GetConfigResponse) Reset() {
 	*x = GetConfigResponse{}
-	mi := &file_manager_proto_msgTypes[9]
+	mi := &file_manager_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -524,7 +666,7 @@ func (x *GetConfigResponse) String() string {
 func (*GetConfigResponse) ProtoMessage() {}
 
 func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_manager_proto_msgTypes[9]
+	mi := &file_manager_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +679,7 @@ func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetConfigResponse) Descriptor() ([]byte, []int) {
-	return file_manager_proto_rawDescGZIP(), []int{9}
+	return file_manager_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetConfigResponse) GetConfig() *spec.Config {
@@ -589,7 +731,7 @@ type MarkNodeForDeletionRequest struct {
 
 func (x *MarkNodeForDeletionRequest) Reset() {
 	*x = MarkNodeForDeletionRequest{}
-	mi := &file_manager_proto_msgTypes[10]
+	mi := &file_manager_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +743,7 @@ func (x *MarkNodeForDeletionRequest) String() string {
 func (*MarkNodeForDeletionRequest) ProtoMessage() {}
 
 func (x *MarkNodeForDeletionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_manager_proto_msgTypes[10]
+	mi := &file_manager_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,7 +756,7 @@ func (x *MarkNodeForDeletionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkNodeForDeletionRequest.ProtoReflect.Descriptor instead.
 func (*MarkNodeForDeletionRequest) Descriptor() ([]byte, []int) {
-	return file_manager_proto_rawDescGZIP(), []int{10}
+	return file_manager_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MarkNodeForDeletionRequest) GetConfig() string {
@@ -669,7 +811,7 @@ type MarkNodeForDeletionResponse struct {
 
 func (x *MarkNodeForDeletionResponse) Reset() {
 	*x = MarkNodeForDeletionResponse{}
-	mi := &file_manager_proto_msgTypes[11]
+	mi := &file_manager_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -681,7 +823,7 @@ func (x *MarkNodeForDeletionResponse) String() string {
 func (*MarkNodeForDeletionResponse) ProtoMessage() {}
 
 func (x *MarkNodeForDeletionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_manager_proto_msgTypes[11]
+	mi := &file_manager_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +836,7 @@ func (x *MarkNodeForDeletionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarkNodeForDeletionResponse.ProtoReflect.Descriptor instead.
 func (*MarkNodeForDeletionResponse) Descriptor() ([]byte, []int) {
-	return file_manager_proto_rawDescGZIP(), []int{11}
+	return file_manager_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MarkNodeForDeletionResponse) GetTargetSize() int64 {
@@ -704,64 +846,1144 @@ func (x *MarkNodeForDeletionResponse) GetTargetSize() int64 {
 	return 0
 }
 
-var File_manager_proto protoreflect.FileDescriptor
+type PlanManifestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// If set, the plan is computed for this manifest instead of the
+	// one that is currently stored for the config. If no config with
+	// the given name exists yet, the plan is computed against an empty
+	// current state, i.e. as if the infrastructure would be created.
+	Manifest      *spec.Manifest `protobuf:"bytes,2,opt,name=manifest,proto3,oneof" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_manager_proto_rawDesc = "" +
-	"\n" +
-	"\rmanager.proto\x12\aclaudie\x1a\x13spec/manifest.proto\"\x88\x01\n" +
-	"\x15UpsertManifestRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
-	"\x06k8sCtx\x18\x02 \x01(\v2\x17.spec.KubernetesContextR\x06k8sCtx\x12*\n" +
-	"\bmanifest\x18\x03 \x01(\v2\x0e.spec.ManifestR\bmanifest\"F\n" +
-	"\x16UpsertManifestResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x04R\aversion\"F\n" +
-	"\x16MarkForDeletionRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x04R\aversion\"G\n" +
-	"\x17MarkForDeletionResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aversion\x18\x02 \x01(\x04R\aversion\"\x14\n" +
-	"\x12ListConfigsRequest\"=\n" +
-	"\x13ListConfigsResponse\x12&\n" +
-	"\aconfigs\x18\x01 \x03(\v2\f.spec.ConfigR\aconfigs\"\xc9\x01\n" +
-	"\x1fNodePoolUpdateTargetSizeRequest\x12\x16\n" +
-	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
-	"\acluster\x18\x02 \x01(\tR\acluster\x12\x1a\n" +
-	"\bnodepool\x18\x03 \x01(\tR\bnodepool\x12'\n" +
-	"\floadbalancer\x18\x04 \x01(\tH\x00R\floadbalancer\x88\x01\x01\x12\x1e\n" +
-	"\n" +
-	"targetSize\x18\x05 \x01(\x05R\n" +
-	"targetSizeB\x0f\n" +
-	"\r_loadbalancer\"B\n" +
-	" NodePoolUpdateTargetSizeResponse\x12\x1e\n" +
-	"\n" +
-	"targetSize\x18\x01 \x01(\x05R\n" +
-	"targetSize\"&\n" +
-	"\x10GetConfigRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
-	"\x11GetConfigResponse\x12$\n" +
-	"\x06config\x18\x01 \x01(\v2\f.spec.ConfigR\x06config\"\xa8\x02\n" +
-	"\x1aMarkNodeForDeletionRequest\x12\x16\n" +
-	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
-	"\acluster\x18\x02 \x01(\tR\acluster\x12\x1a\n" +
-	"\bnodepool\x18\x03 \x01(\tR\bnodepool\x12\x12\n" +
-	"\x04node\x18\x04 \x01(\tR\x04node\x12'\n" +
-	"\floadbalancer\x18\x05 \x01(\tH\x00R\floadbalancer\x88\x01\x01\x12K\n" +
-	"\x1eshouldDecrementDesiredCapacity\x18\x06 \x01(\bH\x01R\x1eshouldDecrementDesiredCapacity\x88\x01\x01B\x0f\n" +
-	"\r_loadbalancerB!\n" +
-	"\x1f_shouldDecrementDesiredCapacity\"=\n" +
-	"\x1bMarkNodeForDeletionResponse\x12\x1e\n" +
-	"\n" +
-	"targetSize\x18\x01 \x01(\x03R\n" +
-	"targetSize2\x9a\x04\n" +
+func (x *PlanManifestRequest) Reset() {
+	*x = PlanManifestRequest{}
+	mi := &file_manager_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanManifestRequest) ProtoMessage() {}
+
+func (x *PlanManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanManifestRequest.ProtoReflect.Descriptor instead.
+func (*PlanManifestRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PlanManifestRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanManifestRequest) GetManifest() *spec.Manifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type PlanManifestResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Name          string                              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Clusters      []*PlanManifestResponse_ClusterPlan `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanManifestResponse) Reset() {
+	*x = PlanManifestResponse{}
+	mi := &file_manager_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanManifestResponse) ProtoMessage() {}
+
+func (x *PlanManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanManifestResponse.ProtoReflect.Descriptor instead.
+func (*PlanManifestResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PlanManifestResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse) GetClusters() []*PlanManifestResponse_ClusterPlan {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type ListTaskHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Config string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// If set, only the history of the specified cluster is listed.
+	Cluster *string `protobuf:"bytes,2,opt,name=cluster,proto3,oneof" json:"cluster,omitempty"`
+	// If set, only entries recorded at or after the timestamp are listed.
+	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Maximum number of entries to return. If not set a
+	// default page size of 100 is used, capped at 1000.
+	PageSize int32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Token returned by the previous [ListTaskHistoryResponse]
+	// to continue listing from. Empty to start from the oldest entry.
+	PageToken     string `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskHistoryRequest) Reset() {
+	*x = ListTaskHistoryRequest{}
+	mi := &file_manager_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskHistoryRequest) ProtoMessage() {}
+
+func (x *ListTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListTaskHistoryRequest) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *ListTaskHistoryRequest) GetCluster() string {
+	if x != nil && x.Cluster != nil {
+		return *x.Cluster
+	}
+	return ""
+}
+
+func (x *ListTaskHistoryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListTaskHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTaskHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListTaskHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entries ordered from the oldest to the newest.
+	Entries []*spec.TaskHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Token for retrieving the next page, empty if there are no more entries.
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskHistoryResponse) Reset() {
+	*x = ListTaskHistoryResponse{}
+	mi := &file_manager_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskHistoryResponse) ProtoMessage() {}
+
+func (x *ListTaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTaskHistoryResponse) GetEntries() []*spec.TaskHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListTaskHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RollbackClusterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cluster       string                 `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackClusterRequest) Reset() {
+	*x = RollbackClusterRequest{}
+	mi := &file_manager_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackClusterRequest) ProtoMessage() {}
+
+func (x *RollbackClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackClusterRequest.ProtoReflect.Descriptor instead.
+func (*RollbackClusterRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RollbackClusterRequest) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *RollbackClusterRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+type RollbackClusterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the task scheduled to roll back the partially applied changes.
+	// Empty if there was nothing left to be rolled back, in which case the
+	// failed task was discarded and the current state is kept as is.
+	TaskId        string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackClusterResponse) Reset() {
+	*x = RollbackClusterResponse{}
+	mi := &file_manager_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackClusterResponse) ProtoMessage() {}
+
+func (x *RollbackClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackClusterResponse.ProtoReflect.Descriptor instead.
+func (*RollbackClusterResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RollbackClusterResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *RollbackClusterResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type SetClusterPausedRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Config  string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cluster string                 `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Whether to pause or resume the reconciliation of the cluster.
+	Paused        bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClusterPausedRequest) Reset() {
+	*x = SetClusterPausedRequest{}
+	mi := &file_manager_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClusterPausedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClusterPausedRequest) ProtoMessage() {}
+
+func (x *SetClusterPausedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClusterPausedRequest.ProtoReflect.Descriptor instead.
+func (*SetClusterPausedRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetClusterPausedRequest) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *SetClusterPausedRequest) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *SetClusterPausedRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+type SetClusterPausedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetClusterPausedResponse) Reset() {
+	*x = SetClusterPausedResponse{}
+	mi := &file_manager_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetClusterPausedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClusterPausedResponse) ProtoMessage() {}
+
+func (x *SetClusterPausedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClusterPausedResponse.ProtoReflect.Descriptor instead.
+func (*SetClusterPausedResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{21}
+}
+
+type ExportStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If not empty, the archive is encrypted with a key derived from the passphrase.
+	Passphrase    string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStateRequest) Reset() {
+	*x = ExportStateRequest{}
+	mi := &file_manager_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateRequest) ProtoMessage() {}
+
+func (x *ExportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExportStateRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type ExportStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Next chunk of the archive.
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStateResponse) Reset() {
+	*x = ExportStateResponse{}
+	mi := &file_manager_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateResponse) ProtoMessage() {}
+
+func (x *ExportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExportStateResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportStateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Next chunk of the archive.
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// Passphrase of an encrypted archive, only read from the first message.
+	Passphrase    string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStateRequest) Reset() {
+	*x = ImportStateRequest{}
+	mi := &file_manager_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateRequest) ProtoMessage() {}
+
+func (x *ImportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateRequest.ProtoReflect.Descriptor instead.
+func (*ImportStateRequest) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ImportStateRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ImportStateRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type ImportStateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names of the configs that were restored.
+	Configs []string `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	// Number of the restored state files.
+	StateFiles    int32 `protobuf:"varint,2,opt,name=stateFiles,proto3" json:"stateFiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStateResponse) Reset() {
+	*x = ImportStateResponse{}
+	mi := &file_manager_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateResponse) ProtoMessage() {}
+
+func (x *ImportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateResponse.ProtoReflect.Descriptor instead.
+func (*ImportStateResponse) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ImportStateResponse) GetConfigs() []string {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+func (x *ImportStateResponse) GetStateFiles() int32 {
+	if x != nil {
+		return x.StateFiles
+	}
+	return 0
+}
+
+type UpdateServiceLoadBalancerRequest_Port struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the port of the service.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Protocol of the port. ["tcp", "udp"]
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Port of the service, allocated on the
+	// loadbalancer if within the range and free.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Node port of the service.
+	NodePort      int32 `protobuf:"varint,4,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) Reset() {
+	*x = UpdateServiceLoadBalancerRequest_Port{}
+	mi := &file_manager_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceLoadBalancerRequest_Port) ProtoMessage() {}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceLoadBalancerRequest_Port.ProtoReflect.Descriptor instead.
+func (*UpdateServiceLoadBalancerRequest_Port) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *UpdateServiceLoadBalancerRequest_Port) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+type UpdateServiceLoadBalancerResponse_Port struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the port of the service.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Port allocated on the loadbalancer.
+	Port          int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServiceLoadBalancerResponse_Port) Reset() {
+	*x = UpdateServiceLoadBalancerResponse_Port{}
+	mi := &file_manager_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServiceLoadBalancerResponse_Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServiceLoadBalancerResponse_Port) ProtoMessage() {}
+
+func (x *UpdateServiceLoadBalancerResponse_Port) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServiceLoadBalancerResponse_Port.ProtoReflect.Descriptor instead.
+func (*UpdateServiceLoadBalancerResponse_Port) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UpdateServiceLoadBalancerResponse_Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServiceLoadBalancerResponse_Port) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type PlanManifestResponse_AffectedNodePool struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the kubernetes or loadbalancer cluster the nodepool is part of.
+	Cluster  string `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Nodepool string `protobuf:"bytes,2,opt,name=nodepool,proto3" json:"nodepool,omitempty"`
+	// Names of the nodes within the nodepool that will be affected
+	// by the task. Empty if the whole nodepool is affected without
+	// any specific nodes, i.e. change of autoscaler settings.
+	Nodes         []string `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanManifestResponse_AffectedNodePool) Reset() {
+	*x = PlanManifestResponse_AffectedNodePool{}
+	mi := &file_manager_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanManifestResponse_AffectedNodePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanManifestResponse_AffectedNodePool) ProtoMessage() {}
+
+func (x *PlanManifestResponse_AffectedNodePool) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanManifestResponse_AffectedNodePool.ProtoReflect.Descriptor instead.
+func (*PlanManifestResponse_AffectedNodePool) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *PlanManifestResponse_AffectedNodePool) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse_AffectedNodePool) GetNodepool() string {
+	if x != nil {
+		return x.Nodepool
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse_AffectedNodePool) GetNodes() []string {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type PlanManifestResponse_PlannedTask struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event       spec.Event             `protobuf:"varint,2,opt,name=event,proto3,enum=spec.Event" json:"event,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Pipeline stages the task would pass through.
+	Pipeline []*spec.Stage `protobuf:"bytes,4,rep,name=pipeline,proto3" json:"pipeline,omitempty"`
+	// NodePools and nodes that would be touched by the task.
+	Affected      []*PlanManifestResponse_AffectedNodePool `protobuf:"bytes,5,rep,name=affected,proto3" json:"affected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanManifestResponse_PlannedTask) Reset() {
+	*x = PlanManifestResponse_PlannedTask{}
+	mi := &file_manager_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanManifestResponse_PlannedTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanManifestResponse_PlannedTask) ProtoMessage() {}
+
+func (x *PlanManifestResponse_PlannedTask) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanManifestResponse_PlannedTask.ProtoReflect.Descriptor instead.
+func (*PlanManifestResponse_PlannedTask) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *PlanManifestResponse_PlannedTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse_PlannedTask) GetEvent() spec.Event {
+	if x != nil {
+		return x.Event
+	}
+	return spec.Event(0)
+}
+
+func (x *PlanManifestResponse_PlannedTask) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse_PlannedTask) GetPipeline() []*spec.Stage {
+	if x != nil {
+		return x.Pipeline
+	}
+	return nil
+}
+
+func (x *PlanManifestResponse_PlannedTask) GetAffected() []*PlanManifestResponse_AffectedNodePool {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+type PlanManifestResponse_ClusterPlan struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Cluster string                 `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Tasks that would be scheduled for the cluster in the order in
+	// which they would be worked on. Only the tasks for the next
+	// iteration of the reconciliation loop are included, as the
+	// follow-up tasks depend on the results of the previous ones.
+	Tasks []*PlanManifestResponse_PlannedTask `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// Set if a task is already being worked on for the cluster, in
+	// which case [tasks] describes the task that is in progress.
+	InProgress bool `protobuf:"varint,3,opt,name=inProgress,proto3" json:"inProgress,omitempty"`
+	// Set if no tasks could be scheduled for the cluster, describing
+	// the reason.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanManifestResponse_ClusterPlan) Reset() {
+	*x = PlanManifestResponse_ClusterPlan{}
+	mi := &file_manager_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanManifestResponse_ClusterPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanManifestResponse_ClusterPlan) ProtoMessage() {}
+
+func (x *PlanManifestResponse_ClusterPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_manager_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanManifestResponse_ClusterPlan.ProtoReflect.Descriptor instead.
+func (*PlanManifestResponse_ClusterPlan) Descriptor() ([]byte, []int) {
+	return file_manager_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *PlanManifestResponse_ClusterPlan) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *PlanManifestResponse_ClusterPlan) GetTasks() []*PlanManifestResponse_PlannedTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *PlanManifestResponse_ClusterPlan) GetInProgress() bool {
+	if x != nil {
+		return x.InProgress
+	}
+	return false
+}
+
+func (x *PlanManifestResponse_ClusterPlan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_manager_proto protoreflect.FileDescriptor
+
+const file_manager_proto_rawDesc = "" +
+	"\n" +
+	"\rmanager.proto\x12\aclaudie\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x12spec/history.proto\x1a\x13spec/manifest.proto\x1a\x0fspec/pass.proto\"\x88\x01\n" +
+	"\x15UpsertManifestRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\x06k8sCtx\x18\x02 \x01(\v2\x17.spec.KubernetesContextR\x06k8sCtx\x12*\n" +
+	"\bmanifest\x18\x03 \x01(\v2\x0e.spec.ManifestR\bmanifest\"F\n" +
+	"\x16UpsertManifestResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"F\n" +
+	"\x16MarkForDeletionRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"G\n" +
+	"\x17MarkForDeletionResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x04R\aversion\"\x14\n" +
+	"\x12ListConfigsRequest\"=\n" +
+	"\x13ListConfigsResponse\x12&\n" +
+	"\aconfigs\x18\x01 \x03(\v2\f.spec.ConfigR\aconfigs\"\xc9\x01\n" +
+	"\x1fNodePoolUpdateTargetSizeRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x12\x1a\n" +
+	"\bnodepool\x18\x03 \x01(\tR\bnodepool\x12'\n" +
+	"\floadbalancer\x18\x04 \x01(\tH\x00R\floadbalancer\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"targetSize\x18\x05 \x01(\x05R\n" +
+	"targetSizeB\x0f\n" +
+	"\r_loadbalancer\"B\n" +
+	" NodePoolUpdateTargetSizeResponse\x12\x1e\n" +
+	"\n" +
+	"targetSize\x18\x01 \x01(\x05R\n" +
+	"targetSize\"\xb4\x02\n" +
+	" UpdateServiceLoadBalancerRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12D\n" +
+	"\x05ports\x18\x05 \x03(\v2..claudie.UpdateServiceLoadBalancerRequest.PortR\x05ports\x1af\n" +
+	"\x04Port\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bnodePort\x18\x04 \x01(\x05R\bnodePort\"\xc8\x01\n" +
+	"!UpdateServiceLoadBalancerResponse\x12E\n" +
+	"\x05ports\x18\x01 \x03(\v2/.claudie.UpdateServiceLoadBalancerResponse.PortR\x05ports\x12\x1a\n" +
+	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x10\n" +
+	"\x03ips\x18\x03 \x03(\tR\x03ips\x1a.\n" +
+	"\x04Port\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\"&\n" +
+	"\x10GetConfigRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"9\n" +
+	"\x11GetConfigResponse\x12$\n" +
+	"\x06config\x18\x01 \x01(\v2\f.spec.ConfigR\x06config\"\xa8\x02\n" +
+	"\x1aMarkNodeForDeletionRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x12\x1a\n" +
+	"\bnodepool\x18\x03 \x01(\tR\bnodepool\x12\x12\n" +
+	"\x04node\x18\x04 \x01(\tR\x04node\x12'\n" +
+	"\floadbalancer\x18\x05 \x01(\tH\x00R\floadbalancer\x88\x01\x01\x12K\n" +
+	"\x1eshouldDecrementDesiredCapacity\x18\x06 \x01(\bH\x01R\x1eshouldDecrementDesiredCapacity\x88\x01\x01B\x0f\n" +
+	"\r_loadbalancerB!\n" +
+	"\x1f_shouldDecrementDesiredCapacity\"=\n" +
+	"\x1bMarkNodeForDeletionResponse\x12\x1e\n" +
+	"\n" +
+	"targetSize\x18\x01 \x01(\x03R\n" +
+	"targetSize\"g\n" +
+	"\x13PlanManifestRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\bmanifest\x18\x02 \x01(\v2\x0e.spec.ManifestH\x00R\bmanifest\x88\x01\x01B\v\n" +
+	"\t_manifest\"\xcc\x04\n" +
+	"\x14PlanManifestResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
+	"\bclusters\x18\x02 \x03(\v2).claudie.PlanManifestResponse.ClusterPlanR\bclusters\x1a^\n" +
+	"\x10AffectedNodePool\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12\x1a\n" +
+	"\bnodepool\x18\x02 \x01(\tR\bnodepool\x12\x14\n" +
+	"\x05nodes\x18\x03 \x03(\tR\x05nodes\x1a\xd7\x01\n" +
+	"\vPlannedTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\x05event\x18\x02 \x01(\x0e2\v.spec.EventR\x05event\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\bpipeline\x18\x04 \x03(\v2\v.spec.StageR\bpipeline\x12J\n" +
+	"\baffected\x18\x05 \x03(\v2..claudie.PlanManifestResponse.AffectedNodePoolR\baffected\x1a\x9e\x01\n" +
+	"\vClusterPlan\x12\x18\n" +
+	"\acluster\x18\x01 \x01(\tR\acluster\x12?\n" +
+	"\x05tasks\x18\x02 \x03(\v2).claudie.PlanManifestResponse.PlannedTaskR\x05tasks\x12\x1e\n" +
+	"\n" +
+	"inProgress\x18\x03 \x01(\bR\n" +
+	"inProgress\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xd6\x01\n" +
+	"\x16ListTaskHistoryRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x1d\n" +
+	"\acluster\x18\x02 \x01(\tH\x00R\acluster\x88\x01\x01\x125\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\x05since\x88\x01\x01\x12\x1a\n" +
+	"\bpageSize\x18\x04 \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\x05 \x01(\tR\tpageTokenB\n" +
+	"\n" +
+	"\b_clusterB\b\n" +
+	"\x06_since\"q\n" +
+	"\x17ListTaskHistoryResponse\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.spec.TaskHistoryEntryR\aentries\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\tR\rnextPageToken\"J\n" +
+	"\x16RollbackClusterRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\"S\n" +
+	"\x17RollbackClusterResponse\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"c\n" +
+	"\x17SetClusterPausedRequest\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x12\x16\n" +
+	"\x06paused\x18\x03 \x01(\bR\x06paused\"\x1a\n" +
+	"\x18SetClusterPausedResponse\"4\n" +
+	"\x12ExportStateRequest\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x01 \x01(\tR\n" +
+	"passphrase\"+\n" +
+	"\x13ExportStateResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"J\n" +
+	"\x12ImportStateRequest\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x02 \x01(\tR\n" +
+	"passphrase\"O\n" +
+	"\x13ImportStateResponse\x12\x18\n" +
+	"\aconfigs\x18\x01 \x03(\tR\aconfigs\x12\x1e\n" +
+	"\n" +
+	"stateFiles\x18\x02 \x01(\x05R\n" +
+	"stateFiles2\x84\b\n" +
 	"\x0eManagerService\x12Q\n" +
 	"\x0eUpsertManifest\x12\x1e.claudie.UpsertManifestRequest\x1a\x1f.claudie.UpsertManifestResponse\x12T\n" +
 	"\x0fMarkForDeletion\x12\x1f.claudie.MarkForDeletionRequest\x1a .claudie.MarkForDeletionResponse\x12`\n" +
 	"\x13MarkNodeForDeletion\x12#.claudie.MarkNodeForDeletionRequest\x1a$.claudie.MarkNodeForDeletionResponse\x12H\n" +
 	"\vListConfigs\x12\x1b.claudie.ListConfigsRequest\x1a\x1c.claudie.ListConfigsResponse\x12B\n" +
 	"\tGetConfig\x12\x19.claudie.GetConfigRequest\x1a\x1a.claudie.GetConfigResponse\x12o\n" +
-	"\x18NodePoolUpdateTargetSize\x12(.claudie.NodePoolUpdateTargetSizeRequest\x1a).claudie.NodePoolUpdateTargetSizeResponseB\n" +
+	"\x18NodePoolUpdateTargetSize\x12(.claudie.NodePoolUpdateTargetSizeRequest\x1a).claudie.NodePoolUpdateTargetSizeResponse\x12K\n" +
+	"\fPlanManifest\x12\x1c.claudie.PlanManifestRequest\x1a\x1d.claudie.PlanManifestResponse\x12T\n" +
+	"\x0fListTaskHistory\x12\x1f.claudie.ListTaskHistoryRequest\x1a .claudie.ListTaskHistoryResponse\x12T\n" +
+	"\x0fRollbackCluster\x12\x1f.claudie.RollbackClusterRequest\x1a .claudie.RollbackClusterResponse\x12W\n" +
+	"\x10SetClusterPaused\x12 .claudie.SetClusterPausedRequest\x1a!.claudie.SetClusterPausedResponse\x12J\n" +
+	"\vExportState\x12\x1b.claudie.ExportStateRequest\x1a\x1c.claudie.ExportStateResponse0\x01\x12J\n" +
+	"\vImportState\x12\x1b.claudie.ImportStateRequest\x1a\x1c.claudie.ImportStateResponse(\x012\x90\x01\n" +
+	"\x1aServiceLoadBalancerService\x12r\n" +
+	"\x19UpdateServiceLoadBalancer\x12).claudie.UpdateServiceLoadBalancerRequest\x1a*.claudie.UpdateServiceLoadBalancerResponseB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -776,46 +1998,93 @@ func file_manager_proto_rawDescGZIP() []byte {
 	return file_manager_proto_rawDescData
 }
 
-var file_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_manager_proto_goTypes = []any{
-	(*UpsertManifestRequest)(nil),            // 0: claudie.UpsertManifestRequest
-	(*UpsertManifestResponse)(nil),           // 1: claudie.UpsertManifestResponse
-	(*MarkForDeletionRequest)(nil),           // 2: claudie.MarkForDeletionRequest
-	(*MarkForDeletionResponse)(nil),          // 3: claudie.MarkForDeletionResponse
-	(*ListConfigsRequest)(nil),               // 4: claudie.ListConfigsRequest
-	(*ListConfigsResponse)(nil),              // 5: claudie.ListConfigsResponse
-	(*NodePoolUpdateTargetSizeRequest)(nil),  // 6: claudie.NodePoolUpdateTargetSizeRequest
-	(*NodePoolUpdateTargetSizeResponse)(nil), // 7: claudie.NodePoolUpdateTargetSizeResponse
-	(*GetConfigRequest)(nil),                 // 8: claudie.GetConfigRequest
-	(*GetConfigResponse)(nil),                // 9: claudie.GetConfigResponse
-	(*MarkNodeForDeletionRequest)(nil),       // 10: claudie.MarkNodeForDeletionRequest
-	(*MarkNodeForDeletionResponse)(nil),      // 11: claudie.MarkNodeForDeletionResponse
-	(*spec.KubernetesContext)(nil),           // 12: spec.KubernetesContext
-	(*spec.Manifest)(nil),                    // 13: spec.Manifest
-	(*spec.Config)(nil),                      // 14: spec.Config
+	(*UpsertManifestRequest)(nil),                  // 0: claudie.UpsertManifestRequest
+	(*UpsertManifestResponse)(nil),                 // 1: claudie.UpsertManifestResponse
+	(*MarkForDeletionRequest)(nil),                 // 2: claudie.MarkForDeletionRequest
+	(*MarkForDeletionResponse)(nil),                // 3: claudie.MarkForDeletionResponse
+	(*ListConfigsRequest)(nil),                     // 4: claudie.ListConfigsRequest
+	(*ListConfigsResponse)(nil),                    // 5: claudie.ListConfigsResponse
+	(*NodePoolUpdateTargetSizeRequest)(nil),        // 6: claudie.NodePoolUpdateTargetSizeRequest
+	(*NodePoolUpdateTargetSizeResponse)(nil),       // 7: claudie.NodePoolUpdateTargetSizeResponse
+	(*UpdateServiceLoadBalancerRequest)(nil),       // 8: claudie.UpdateServiceLoadBalancerRequest
+	(*UpdateServiceLoadBalancerResponse)(nil),      // 9: claudie.UpdateServiceLoadBalancerResponse
+	(*GetConfigRequest)(nil),                       // 10: claudie.GetConfigRequest
+	(*GetConfigResponse)(nil),                      // 11: claudie.GetConfigResponse
+	(*MarkNodeForDeletionRequest)(nil),             // 12: claudie.MarkNodeForDeletionRequest
+	(*MarkNodeForDeletionResponse)(nil),            // 13: claudie.MarkNodeForDeletionResponse
+	(*PlanManifestRequest)(nil),                    // 14: claudie.PlanManifestRequest
+	(*PlanManifestResponse)(nil),                   // 15: claudie.PlanManifestResponse
+	(*ListTaskHistoryRequest)(nil),                 // 16: claudie.ListTaskHistoryRequest
+	(*ListTaskHistoryResponse)(nil),                // 17: claudie.ListTaskHistoryResponse
+	(*RollbackClusterRequest)(nil),                 // 18: claudie.RollbackClusterRequest
+	(*RollbackClusterResponse)(nil),                // 19: claudie.RollbackClusterResponse
+	(*SetClusterPausedRequest)(nil),                // 20: claudie.SetClusterPausedRequest
+	(*SetClusterPausedResponse)(nil),               // 21: claudie.SetClusterPausedResponse
+	(*ExportStateRequest)(nil),                     // 22: claudie.ExportStateRequest
+	(*ExportStateResponse)(nil),                    // 23: claudie.ExportStateResponse
+	(*ImportStateRequest)(nil),                     // 24: claudie.ImportStateRequest
+	(*ImportStateResponse)(nil),                    // 25: claudie.ImportStateResponse
+	(*UpdateServiceLoadBalancerRequest_Port)(nil),  // 26: claudie.UpdateServiceLoadBalancerRequest.Port
+	(*UpdateServiceLoadBalancerResponse_Port)(nil), // 27: claudie.UpdateServiceLoadBalancerResponse.Port
+	(*PlanManifestResponse_AffectedNodePool)(nil),  // 28: claudie.PlanManifestResponse.AffectedNodePool
+	(*PlanManifestResponse_PlannedTask)(nil),       // 29: claudie.PlanManifestResponse.PlannedTask
+	(*PlanManifestResponse_ClusterPlan)(nil),       // 30: claudie.PlanManifestResponse.ClusterPlan
+	(*spec.KubernetesContext)(nil),                 // 31: spec.KubernetesContext
+	(*spec.Manifest)(nil),                          // 32: spec.Manifest
+	(*spec.Config)(nil),                            // 33: spec.Config
+	(*timestamppb.Timestamp)(nil),                  // 34: google.protobuf.Timestamp
+	(*spec.TaskHistoryEntry)(nil),                  // 35: spec.TaskHistoryEntry
+	(spec.Event)(0),                                // 36: spec.Event
+	(*spec.Stage)(nil),                             // 37: spec.Stage
 }
 var file_manager_proto_depIdxs = []int32{
-	12, // 0: claudie.UpsertManifestRequest.k8sCtx:type_name -> spec.KubernetesContext
-	13, // 1: claudie.UpsertManifestRequest.manifest:type_name -> spec.Manifest
-	14, // 2: claudie.ListConfigsResponse.configs:type_name -> spec.Config
-	14, // 3: claudie.GetConfigResponse.config:type_name -> spec.Config
-	0,  // 4: claudie.ManagerService.UpsertManifest:input_type -> claudie.UpsertManifestRequest
-	2,  // 5: claudie.ManagerService.MarkForDeletion:input_type -> claudie.MarkForDeletionRequest
-	10, // 6: claudie.ManagerService.MarkNodeForDeletion:input_type -> claudie.MarkNodeForDeletionRequest
-	4,  // 7: claudie.ManagerService.ListConfigs:input_type -> claudie.ListConfigsRequest
-	8,  // 8: claudie.ManagerService.GetConfig:input_type -> claudie.GetConfigRequest
-	6,  // 9: claudie.ManagerService.NodePoolUpdateTargetSize:input_type -> claudie.NodePoolUpdateTargetSizeRequest
-	1,  // 10: claudie.ManagerService.UpsertManifest:output_type -> claudie.UpsertManifestResponse
-	3,  // 11: claudie.ManagerService.MarkForDeletion:output_type -> claudie.MarkForDeletionResponse
-	11, // 12: claudie.ManagerService.MarkNodeForDeletion:output_type -> claudie.MarkNodeForDeletionResponse
-	5,  // 13: claudie.ManagerService.ListConfigs:output_type -> claudie.ListConfigsResponse
-	9,  // 14: claudie.ManagerService.GetConfig:output_type -> claudie.GetConfigResponse
-	7,  // 15: claudie.ManagerService.NodePoolUpdateTargetSize:output_type -> claudie.NodePoolUpdateTargetSizeResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	31, // 0: claudie.UpsertManifestRequest.k8sCtx:type_name -> spec.KubernetesContext
+	32, // 1: claudie.UpsertManifestRequest.manifest:type_name -> spec.Manifest
+	33, // 2: claudie.ListConfigsResponse.configs:type_name -> spec.Config
+	26, // 3: claudie.UpdateServiceLoadBalancerRequest.ports:type_name -> claudie.UpdateServiceLoadBalancerRequest.Port
+	27, // 4: claudie.UpdateServiceLoadBalancerResponse.ports:type_name -> claudie.UpdateServiceLoadBalancerResponse.Port
+	33, // 5: claudie.GetConfigResponse.config:type_name -> spec.Config
+	32, // 6: claudie.PlanManifestRequest.manifest:type_name -> spec.Manifest
+	30, // 7: claudie.PlanManifestResponse.clusters:type_name -> claudie.PlanManifestResponse.ClusterPlan
+	34, // 8: claudie.ListTaskHistoryRequest.since:type_name -> google.protobuf.Timestamp
+	35, // 9: claudie.ListTaskHistoryResponse.entries:type_name -> spec.TaskHistoryEntry
+	36, // 10: claudie.PlanManifestResponse.PlannedTask.event:type_name -> spec.Event
+	37, // 11: claudie.PlanManifestResponse.PlannedTask.pipeline:type_name -> spec.Stage
+	28, // 12: claudie.PlanManifestResponse.PlannedTask.affected:type_name -> claudie.PlanManifestResponse.AffectedNodePool
+	29, // 13: claudie.PlanManifestResponse.ClusterPlan.tasks:type_name -> claudie.PlanManifestResponse.PlannedTask
+	0,  // 14: claudie.ManagerService.UpsertManifest:input_type -> claudie.UpsertManifestRequest
+	2,  // 15: claudie.ManagerService.MarkForDeletion:input_type -> claudie.MarkForDeletionRequest
+	12, // 16: claudie.ManagerService.MarkNodeForDeletion:input_type -> claudie.MarkNodeForDeletionRequest
+	4,  // 17: claudie.ManagerService.ListConfigs:input_type -> claudie.ListConfigsRequest
+	10, // 18: claudie.ManagerService.GetConfig:input_type -> claudie.GetConfigRequest
+	6,  // 19: claudie.ManagerService.NodePoolUpdateTargetSize:input_type -> claudie.NodePoolUpdateTargetSizeRequest
+	14, // 20: claudie.ManagerService.PlanManifest:input_type -> claudie.PlanManifestRequest
+	16, // 21: claudie.ManagerService.ListTaskHistory:input_type -> claudie.ListTaskHistoryRequest
+	18, // 22: claudie.ManagerService.RollbackCluster:input_type -> claudie.RollbackClusterRequest
+	20, // 23: claudie.ManagerService.SetClusterPaused:input_type -> claudie.SetClusterPausedRequest
+	22, // 24: claudie.ManagerService.ExportState:input_type -> claudie.ExportStateRequest
+	24, // 25: claudie.ManagerService.ImportState:input_type -> claudie.ImportStateRequest
+	8,  // 26: claudie.ServiceLoadBalancerService.UpdateServiceLoadBalancer:input_type -> claudie.UpdateServiceLoadBalancerRequest
+	1,  // 27: claudie.ManagerService.UpsertManifest:output_type -> claudie.UpsertManifestResponse
+	3,  // 28: claudie.ManagerService.MarkForDeletion:output_type -> claudie.MarkForDeletionResponse
+	13, // 29: claudie.ManagerService.MarkNodeForDeletion:output_type -> claudie.MarkNodeForDeletionResponse
+	5,  // 30: claudie.ManagerService.ListConfigs:output_type -> claudie.ListConfigsResponse
+	11, // 31: claudie.ManagerService.GetConfig:output_type -> claudie.GetConfigResponse
+	7,  // 32: claudie.ManagerService.NodePoolUpdateTargetSize:output_type -> claudie.NodePoolUpdateTargetSizeResponse
+	15, // 33: claudie.ManagerService.PlanManifest:output_type -> claudie.PlanManifestResponse
+	17, // 34: claudie.ManagerService.ListTaskHistory:output_type -> claudie.ListTaskHistoryResponse
+	19, // 35: claudie.ManagerService.RollbackCluster:output_type -> claudie.RollbackClusterResponse
+	21, // 36: claudie.ManagerService.SetClusterPaused:output_type -> claudie.SetClusterPausedResponse
+	23, // 37: claudie.ManagerService.ExportState:output_type -> claudie.ExportStateResponse
+	25, // 38: claudie.ManagerService.ImportState:output_type -> claudie.ImportStateResponse
+	9,  // 39: claudie.ServiceLoadBalancerService.UpdateServiceLoadBalancer:output_type -> claudie.UpdateServiceLoadBalancerResponse
+	27, // [27:40] is the sub-list for method output_type
+	14, // [14:27] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_manager_proto_init() }
@@ -824,16 +2093,18 @@ func file_manager_proto_init() {
 		return
 	}
 	file_manager_proto_msgTypes[6].OneofWrappers = []any{}
-	file_manager_proto_msgTypes[10].OneofWrappers = []any{}
+	file_manager_proto_msgTypes[12].OneofWrappers = []any{}
+	file_manager_proto_msgTypes[14].OneofWrappers = []any{}
+	file_manager_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_manager_proto_rawDesc), len(file_manager_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   31,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_manager_proto_goTypes,
 		DependencyIndexes: file_manager_proto_depIdxs,
@@ -25,6 +25,12 @@ const (
 	ManagerService_ListConfigs_FullMethodName              = "/claudie.ManagerService/ListConfigs"
 	ManagerService_GetConfig_FullMethodName                = "/claudie.ManagerService/GetConfig"
 	ManagerService_NodePoolUpdateTargetSize_FullMethodName = "/claudie.ManagerService/NodePoolUpdateTargetSize"
+	ManagerService_PlanManifest_FullMethodName             = "/claudie.ManagerService/PlanManifest"
+	ManagerService_ListTaskHistory_FullMethodName          = "/claudie.ManagerService/ListTaskHistory"
+	ManagerService_RollbackCluster_FullMethodName          = "/claudie.ManagerService/RollbackCluster"
+	ManagerService_SetClusterPaused_FullMethodName         = "/claudie.ManagerService/SetClusterPaused"
+	ManagerService_ExportState_FullMethodName              = "/claudie.ManagerService/ExportState"
+	ManagerService_ImportState_FullMethodName              = "/claudie.ManagerService/ImportState"
 )
 
 // ManagerServiceClient is the client API for ManagerService service.
@@ -47,6 +53,27 @@ type ManagerServiceClient interface {
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
 	// NodePoolUpdateTargetSize updates the target size of the nodepool.
 	NodePoolUpdateTargetSize(ctx context.Context, in *NodePoolUpdateTargetSizeRequest, opts ...grpc.CallOption) (*NodePoolUpdateTargetSizeResponse, error)
+	// PlanManifest computes the tasks that would be scheduled for the requested
+	// configuration without persisting or scheduling any of them. The infrastructure
+	// is not probed, the health of the clusters as last checked by the reconciliation
+	// loop is used instead.
+	PlanManifest(ctx context.Context, in *PlanManifestRequest, opts ...grpc.CallOption) (*PlanManifestResponse, error)
+	// ListTaskHistory lists the recorded history of the tasks that were worked on
+	// for the requested configuration.
+	ListTaskHistory(ctx context.Context, in *ListTaskHistoryRequest, opts ...grpc.CallOption) (*ListTaskHistoryResponse, error)
+	// RollbackCluster schedules a task that reverts the partially applied changes
+	// of a failed update task, moving the cluster back to its last known current state.
+	// The cluster is kept at that state until the manifest changes.
+	RollbackCluster(ctx context.Context, in *RollbackClusterRequest, opts ...grpc.CallOption) (*RollbackClusterResponse, error)
+	// SetClusterPaused pauses or resumes the reconciliation of the cluster. A task
+	// that is already being worked on is finished before the pause takes effect.
+	SetClusterPaused(ctx context.Context, in *SetClusterPausedRequest, opts ...grpc.CallOption) (*SetClusterPausedResponse, error)
+	// ExportState streams a versioned archive of all of the stored configs along
+	// with the OpenTofu state files of their infrastructure from the state bucket.
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStateResponse], error)
+	// ImportState restores the configs and state files from an archive produced by
+	// ExportState. None of the configs in the archive may already exist.
+	ImportState(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStateRequest, ImportStateResponse], error)
 }
 
 type managerServiceClient struct {
@@ -117,6 +144,78 @@ func (c *managerServiceClient) NodePoolUpdateTargetSize(ctx context.Context, in
 	return out, nil
 }
 
+func (c *managerServiceClient) PlanManifest(ctx context.Context, in *PlanManifestRequest, opts ...grpc.CallOption) (*PlanManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlanManifestResponse)
+	err := c.cc.Invoke(ctx, ManagerService_PlanManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) ListTaskHistory(ctx context.Context, in *ListTaskHistoryRequest, opts ...grpc.CallOption) (*ListTaskHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskHistoryResponse)
+	err := c.cc.Invoke(ctx, ManagerService_ListTaskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) RollbackCluster(ctx context.Context, in *RollbackClusterRequest, opts ...grpc.CallOption) (*RollbackClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackClusterResponse)
+	err := c.cc.Invoke(ctx, ManagerService_RollbackCluster_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) SetClusterPaused(ctx context.Context, in *SetClusterPausedRequest, opts ...grpc.CallOption) (*SetClusterPausedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetClusterPausedResponse)
+	err := c.cc.Invoke(ctx, ManagerService_SetClusterPaused_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managerServiceClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportStateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagerService_ServiceDesc.Streams[0], ManagerService_ExportState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportStateRequest, ExportStateResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagerService_ExportStateClient = grpc.ServerStreamingClient[ExportStateResponse]
+
+func (c *managerServiceClient) ImportState(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportStateRequest, ImportStateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ManagerService_ServiceDesc.Streams[1], ManagerService_ImportState_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportStateRequest, ImportStateResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagerService_ImportStateClient = grpc.ClientStreamingClient[ImportStateRequest, ImportStateResponse]
+
 // ManagerServiceServer is the server API for ManagerService service.
 // All implementations must embed UnimplementedManagerServiceServer
 // for forward compatibility.
@@ -137,6 +236,27 @@ type ManagerServiceServer interface {
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
 	// NodePoolUpdateTargetSize updates the target size of the nodepool.
 	NodePoolUpdateTargetSize(context.Context, *NodePoolUpdateTargetSizeRequest) (*NodePoolUpdateTargetSizeResponse, error)
+	// PlanManifest computes the tasks that would be scheduled for the requested
+	// configuration without persisting or scheduling any of them. The infrastructure
+	// is not probed, the health of the clusters as last checked by the reconciliation
+	// loop is used instead.
+	PlanManifest(context.Context, *PlanManifestRequest) (*PlanManifestResponse, error)
+	// ListTaskHistory lists the recorded history of the tasks that were worked on
+	// for the requested configuration.
+	ListTaskHistory(context.Context, *ListTaskHistoryRequest) (*ListTaskHistoryResponse, error)
+	// RollbackCluster schedules a task that reverts the partially applied changes
+	// of a failed update task, moving the cluster back to its last known current state.
+	// The cluster is kept at that state until the manifest changes.
+	RollbackCluster(context.Context, *RollbackClusterRequest) (*RollbackClusterResponse, error)
+	// SetClusterPaused pauses or resumes the reconciliation of the cluster. A task
+	// that is already being worked on is finished before the pause takes effect.
+	SetClusterPaused(context.Context, *SetClusterPausedRequest) (*SetClusterPausedResponse, error)
+	// ExportState streams a versioned archive of all of the stored configs along
+	// with the OpenTofu state files of their infrastructure from the state bucket.
+	ExportState(*ExportStateRequest, grpc.ServerStreamingServer[ExportStateResponse]) error
+	// ImportState restores the configs and state files from an archive produced by
+	// ExportState. None of the configs in the archive may already exist.
+	ImportState(grpc.ClientStreamingServer[ImportStateRequest, ImportStateResponse]) error
 	mustEmbedUnimplementedManagerServiceServer()
 }
 
@@ -165,6 +285,24 @@ func (UnimplementedManagerServiceServer) GetConfig(context.Context, *GetConfigRe
 func (UnimplementedManagerServiceServer) NodePoolUpdateTargetSize(context.Context, *NodePoolUpdateTargetSizeRequest) (*NodePoolUpdateTargetSizeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method NodePoolUpdateTargetSize not implemented")
 }
+func (UnimplementedManagerServiceServer) PlanManifest(context.Context, *PlanManifestRequest) (*PlanManifestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PlanManifest not implemented")
+}
+func (UnimplementedManagerServiceServer) ListTaskHistory(context.Context, *ListTaskHistoryRequest) (*ListTaskHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTaskHistory not implemented")
+}
+func (UnimplementedManagerServiceServer) RollbackCluster(context.Context, *RollbackClusterRequest) (*RollbackClusterResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackCluster not implemented")
+}
+func (UnimplementedManagerServiceServer) SetClusterPaused(context.Context, *SetClusterPausedRequest) (*SetClusterPausedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetClusterPaused not implemented")
+}
+func (UnimplementedManagerServiceServer) ExportState(*ExportStateRequest, grpc.ServerStreamingServer[ExportStateResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedManagerServiceServer) ImportState(grpc.ClientStreamingServer[ImportStateRequest, ImportStateResponse]) error {
+	return status.Error(codes.Unimplemented, "method ImportState not implemented")
+}
 func (UnimplementedManagerServiceServer) mustEmbedUnimplementedManagerServiceServer() {}
 func (UnimplementedManagerServiceServer) testEmbeddedByValue()                        {}
 
@@ -294,6 +432,96 @@ func _ManagerService_NodePoolUpdateTargetSize_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagerService_PlanManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).PlanManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagerService_PlanManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).PlanManifest(ctx, req.(*PlanManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_ListTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).ListTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagerService_ListTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).ListTaskHistory(ctx, req.(*ListTaskHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_RollbackCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).RollbackCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagerService_RollbackCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).RollbackCluster(ctx, req.(*RollbackClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_SetClusterPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClusterPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagerServiceServer).SetClusterPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagerService_SetClusterPaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagerServiceServer).SetClusterPaused(ctx, req.(*SetClusterPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManagerService_ExportState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagerServiceServer).ExportState(m, &grpc.GenericServerStream[ExportStateRequest, ExportStateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagerService_ExportStateServer = grpc.ServerStreamingServer[ExportStateResponse]
+
+func _ManagerService_ImportState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagerServiceServer).ImportState(&grpc.GenericServerStream[ImportStateRequest, ImportStateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ManagerService_ImportStateServer = grpc.ClientStreamingServer[ImportStateRequest, ImportStateResponse]
+
 // ManagerService_ServiceDesc is the grpc.ServiceDesc for ManagerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +553,150 @@ var ManagerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NodePoolUpdateTargetSize",
 			Handler:    _ManagerService_NodePoolUpdateTargetSize_Handler,
 		},
+		{
+			MethodName: "PlanManifest",
+			Handler:    _ManagerService_PlanManifest_Handler,
+		},
+		{
+			MethodName: "ListTaskHistory",
+			Handler:    _ManagerService_ListTaskHistory_Handler,
+		},
+		{
+			MethodName: "RollbackCluster",
+			Handler:    _ManagerService_RollbackCluster_Handler,
+		},
+		{
+			MethodName: "SetClusterPaused",
+			Handler:    _ManagerService_SetClusterPaused_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportState",
+			Handler:       _ManagerService_ExportState_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportState",
+			Handler:       _ManagerService_ImportState_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "manager.proto",
+}
+
+const (
+	ServiceLoadBalancerService_UpdateServiceLoadBalancer_FullMethodName = "/claudie.ServiceLoadBalancerService/UpdateServiceLoadBalancer"
+)
+
+// ServiceLoadBalancerServiceClient is the client API for ServiceLoadBalancerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ServiceLoadBalancerService is served by the manager on a separate port from the ManagerService
+// as it is reachable from within the workload clusters. Each request must be authenticated with
+// the token of the designated loadbalancer passed in the "authorization" metadata as "Bearer <token>".
+type ServiceLoadBalancerServiceClient interface {
+	// UpdateServiceLoadBalancer allocates the ports for the Kubernetes Service of type LoadBalancer
+	// on the loadbalancer designated for the services of the cluster. The roles for the allocated
+	// ports are added by the next reconciliation of the cluster.
+	UpdateServiceLoadBalancer(ctx context.Context, in *UpdateServiceLoadBalancerRequest, opts ...grpc.CallOption) (*UpdateServiceLoadBalancerResponse, error)
+}
+
+type serviceLoadBalancerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceLoadBalancerServiceClient(cc grpc.ClientConnInterface) ServiceLoadBalancerServiceClient {
+	return &serviceLoadBalancerServiceClient{cc}
+}
+
+func (c *serviceLoadBalancerServiceClient) UpdateServiceLoadBalancer(ctx context.Context, in *UpdateServiceLoadBalancerRequest, opts ...grpc.CallOption) (*UpdateServiceLoadBalancerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateServiceLoadBalancerResponse)
+	err := c.cc.Invoke(ctx, ServiceLoadBalancerService_UpdateServiceLoadBalancer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceLoadBalancerServiceServer is the server API for ServiceLoadBalancerService service.
+// All implementations must embed UnimplementedServiceLoadBalancerServiceServer
+// for forward compatibility.
+//
+// ServiceLoadBalancerService is served by the manager on a separate port from the ManagerService
+// as it is reachable from within the workload clusters. Each request must be authenticated with
+// the token of the designated loadbalancer passed in the "authorization" metadata as "Bearer <token>".
+type ServiceLoadBalancerServiceServer interface {
+	// UpdateServiceLoadBalancer allocates the ports for the Kubernetes Service of type LoadBalancer
+	// on the loadbalancer designated for the services of the cluster. The roles for the allocated
+	// ports are added by the next reconciliation of the cluster.
+	UpdateServiceLoadBalancer(context.Context, *UpdateServiceLoadBalancerRequest) (*UpdateServiceLoadBalancerResponse, error)
+	mustEmbedUnimplementedServiceLoadBalancerServiceServer()
+}
+
+// UnimplementedServiceLoadBalancerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedServiceLoadBalancerServiceServer struct{}
+
+func (UnimplementedServiceLoadBalancerServiceServer) UpdateServiceLoadBalancer(context.Context, *UpdateServiceLoadBalancerRequest) (*UpdateServiceLoadBalancerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateServiceLoadBalancer not implemented")
+}
+func (UnimplementedServiceLoadBalancerServiceServer) mustEmbedUnimplementedServiceLoadBalancerServiceServer() {
+}
+func (UnimplementedServiceLoadBalancerServiceServer) testEmbeddedByValue() {}
+
+// UnsafeServiceLoadBalancerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceLoadBalancerServiceServer will
+// result in compilation errors.
+type UnsafeServiceLoadBalancerServiceServer interface {
+	mustEmbedUnimplementedServiceLoadBalancerServiceServer()
+}
+
+func RegisterServiceLoadBalancerServiceServer(s grpc.ServiceRegistrar, srv ServiceLoadBalancerServiceServer) {
+	// If the following call panics, it indicates UnimplementedServiceLoadBalancerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ServiceLoadBalancerService_ServiceDesc, srv)
+}
+
+func _ServiceLoadBalancerService_UpdateServiceLoadBalancer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceLoadBalancerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceLoadBalancerServiceServer).UpdateServiceLoadBalancer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceLoadBalancerService_UpdateServiceLoadBalancer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceLoadBalancerServiceServer).UpdateServiceLoadBalancer(ctx, req.(*UpdateServiceLoadBalancerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceLoadBalancerService_ServiceDesc is the grpc.ServiceDesc for ServiceLoadBalancerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceLoadBalancerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "claudie.ServiceLoadBalancerService",
+	HandlerType: (*ServiceLoadBalancerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateServiceLoadBalancer",
+			Handler:    _ServiceLoadBalancerService_UpdateServiceLoadBalancer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "manager.proto",
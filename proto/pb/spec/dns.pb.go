@@ -86,8 +86,11 @@ type DNS struct {
 	Endpoint string `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	// alternative names for which A records will be created in addition to the hostname.
 	AlternativeNames []*AlternativeName `protobuf:"bytes,5,rep,name=alternativeNames,proto3" json:"alternativeNames,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Public endpoints of the loadbalancer nodes which were found unreachable
+	// and are left out of the DNS records until they're reachable again.
+	UnreachableEndpoints []string `protobuf:"bytes,6,rep,name=unreachableEndpoints,proto3" json:"unreachableEndpoints,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *DNS) Reset() {
@@ -155,6 +158,13 @@ func (x *DNS) GetAlternativeNames() []*AlternativeName {
 	return nil
 }
 
+func (x *DNS) GetUnreachableEndpoints() []string {
+	if x != nil {
+		return x.UnreachableEndpoints
+	}
+	return nil
+}
+
 var File_spec_dns_proto protoreflect.FileDescriptor
 
 const file_spec_dns_proto_rawDesc = "" +
@@ -162,13 +172,14 @@ const file_spec_dns_proto_rawDesc = "" +
 	"\x0espec/dns.proto\x12\x04spec\x1a\x13spec/provider.proto\"I\n" +
 	"\x0fAlternativeName\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x1a\n" +
-	"\bendpoint\x18\x02 \x01(\tR\bendpoint\"\xc6\x01\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\"\xfa\x01\n" +
 	"\x03DNS\x12\x18\n" +
 	"\adnsZone\x18\x01 \x01(\tR\adnsZone\x12\x1a\n" +
 	"\bhostname\x18\x02 \x01(\tR\bhostname\x12*\n" +
 	"\bprovider\x18\x03 \x01(\v2\x0e.spec.ProviderR\bprovider\x12\x1a\n" +
 	"\bendpoint\x18\x04 \x01(\tR\bendpoint\x12A\n" +
-	"\x10alternativeNames\x18\x05 \x03(\v2\x15.spec.AlternativeNameR\x10alternativeNamesB)Z'github.com/berops/claudie/proto/pb/specb\x06proto3"
+	"\x10alternativeNames\x18\x05 \x03(\v2\x15.spec.AlternativeNameR\x10alternativeNames\x122\n" +
+	"\x14unreachableEndpoints\x18\x06 \x03(\tR\x14unreachableEndpointsB)Z'github.com/berops/claudie/proto/pb/specb\x06proto3"
 
 var (
 	file_spec_dns_proto_rawDescOnce sync.Once
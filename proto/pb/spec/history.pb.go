@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.34.1
+// source: spec/history.proto
+
+package spec
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskHistoryEntry_Kind int32
+
+const (
+	// The task was scheduled to be worked on.
+	TaskHistoryEntry_TASK_SCHEDULED TaskHistoryEntry_Kind = 0
+	// A stage of the task was picked up by one of the services.
+	TaskHistoryEntry_STAGE_STARTED TaskHistoryEntry_Kind = 1
+	// The result of a stage of the task was received.
+	TaskHistoryEntry_STAGE_FINISHED TaskHistoryEntry_Kind = 2
+)
+
+// Enum value maps for TaskHistoryEntry_Kind.
+var (
+	TaskHistoryEntry_Kind_name = map[int32]string{
+		0: "TASK_SCHEDULED",
+		1: "STAGE_STARTED",
+		2: "STAGE_FINISHED",
+	}
+	TaskHistoryEntry_Kind_value = map[string]int32{
+		"TASK_SCHEDULED": 0,
+		"STAGE_STARTED":  1,
+		"STAGE_FINISHED": 2,
+	}
+)
+
+func (x TaskHistoryEntry_Kind) Enum() *TaskHistoryEntry_Kind {
+	p := new(TaskHistoryEntry_Kind)
+	*p = x
+	return p
+}
+
+func (x TaskHistoryEntry_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskHistoryEntry_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_spec_history_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskHistoryEntry_Kind) Type() protoreflect.EnumType {
+	return &file_spec_history_proto_enumTypes[0]
+}
+
+func (x TaskHistoryEntry_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskHistoryEntry_Kind.Descriptor instead.
+func (TaskHistoryEntry_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_spec_history_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// TaskHistoryEntry is a single, immutable, record in the history
+// of the tasks that were worked on for a cluster.
+type TaskHistoryEntry struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Config      string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Cluster     string                 `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	TaskId      string                 `protobuf:"bytes,3,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Kind        TaskHistoryEntry_Kind  `protobuf:"varint,4,opt,name=kind,proto3,enum=spec.TaskHistoryEntry_Kind" json:"kind,omitempty"`
+	Timestamp   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Event       Event                  `protobuf:"varint,6,opt,name=event,proto3,enum=spec.Event" json:"event,omitempty"`
+	Description string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Stage of the pipeline of the task, only set
+	// for the STAGE_STARTED and STAGE_FINISHED kinds.
+	Stage      string `protobuf:"bytes,8,opt,name=stage,proto3" json:"stage,omitempty"`
+	StageIndex uint32 `protobuf:"varint,9,opt,name=stageIndex,proto3" json:"stageIndex,omitempty"`
+	// Kind of result that was received for the stage
+	// [Update, Clear, None], only set for the STAGE_FINISHED kind.
+	Result string `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	// Set if the stage finished with an error.
+	Error         *TaskResult_Error `protobuf:"bytes,11,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskHistoryEntry) Reset() {
+	*x = TaskHistoryEntry{}
+	mi := &file_spec_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskHistoryEntry) ProtoMessage() {}
+
+func (x *TaskHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TaskHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_spec_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskHistoryEntry) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetKind() TaskHistoryEntry_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return TaskHistoryEntry_TASK_SCHEDULED
+}
+
+func (x *TaskHistoryEntry) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TaskHistoryEntry) GetEvent() Event {
+	if x != nil {
+		return x.Event
+	}
+	return Event_UNKNOWN
+}
+
+func (x *TaskHistoryEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetStageIndex() uint32 {
+	if x != nil {
+		return x.StageIndex
+	}
+	return 0
+}
+
+func (x *TaskHistoryEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetError() *TaskResult_Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_spec_history_proto protoreflect.FileDescriptor
+
+const file_spec_history_proto_rawDesc = "" +
+	"\n" +
+	"\x12spec/history.proto\x12\x04spec\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x13spec/manifest.proto\"\xda\x03\n" +
+	"\x10TaskHistoryEntry\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12\x18\n" +
+	"\acluster\x18\x02 \x01(\tR\acluster\x12\x16\n" +
+	"\x06taskId\x18\x03 \x01(\tR\x06taskId\x12/\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x1b.spec.TaskHistoryEntry.KindR\x04kind\x128\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n" +
+	"\x05event\x18\x06 \x01(\x0e2\v.spec.EventR\x05event\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x14\n" +
+	"\x05stage\x18\b \x01(\tR\x05stage\x12\x1e\n" +
+	"\n" +
+	"stageIndex\x18\t \x01(\rR\n" +
+	"stageIndex\x12\x16\n" +
+	"\x06result\x18\n" +
+	" \x01(\tR\x06result\x121\n" +
+	"\x05error\x18\v \x01(\v2\x16.spec.TaskResult.ErrorH\x00R\x05error\x88\x01\x01\"A\n" +
+	"\x04Kind\x12\x12\n" +
+	"\x0eTASK_SCHEDULED\x10\x00\x12\x11\n" +
+	"\rSTAGE_STARTED\x10\x01\x12\x12\n" +
+	"\x0eSTAGE_FINISHED\x10\x02B\b\n" +
+	"\x06_errorB)Z'github.com/berops/claudie/proto/pb/specb\x06proto3"
+
+var (
+	file_spec_history_proto_rawDescOnce sync.Once
+	file_spec_history_proto_rawDescData []byte
+)
+
+func file_spec_history_proto_rawDescGZIP() []byte {
+	file_spec_history_proto_rawDescOnce.Do(func() {
+		file_spec_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spec_history_proto_rawDesc), len(file_spec_history_proto_rawDesc)))
+	})
+	return file_spec_history_proto_rawDescData
+}
+
+var file_spec_history_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_spec_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_spec_history_proto_goTypes = []any{
+	(TaskHistoryEntry_Kind)(0),    // 0: spec.TaskHistoryEntry.Kind
+	(*TaskHistoryEntry)(nil),      // 1: spec.TaskHistoryEntry
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(Event)(0),                    // 3: spec.Event
+	(*TaskResult_Error)(nil),      // 4: spec.TaskResult.Error
+}
+var file_spec_history_proto_depIdxs = []int32{
+	0, // 0: spec.TaskHistoryEntry.kind:type_name -> spec.TaskHistoryEntry.Kind
+	2, // 1: spec.TaskHistoryEntry.timestamp:type_name -> google.protobuf.Timestamp
+	3, // 2: spec.TaskHistoryEntry.event:type_name -> spec.Event
+	4, // 3: spec.TaskHistoryEntry.error:type_name -> spec.TaskResult.Error
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_spec_history_proto_init() }
+func file_spec_history_proto_init() {
+	if File_spec_history_proto != nil {
+		return
+	}
+	file_spec_manifest_proto_init()
+	file_spec_history_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spec_history_proto_rawDesc), len(file_spec_history_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spec_history_proto_goTypes,
+		DependencyIndexes: file_spec_history_proto_depIdxs,
+		EnumInfos:         file_spec_history_proto_enumTypes,
+		MessageInfos:      file_spec_history_proto_msgTypes,
+	}.Build()
+	File_spec_history_proto = out.File
+	file_spec_history_proto_goTypes = nil
+	file_spec_history_proto_depIdxs = nil
+}
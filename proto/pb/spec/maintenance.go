@@ -0,0 +1,140 @@
+package spec
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	// The images of the services do not ship with the
+	// time zone database, embed it for the maintenance windows.
+	_ "time/tzdata"
+)
+
+// MaxMaintenanceWindowDuration is the longest duration a single maintenance window can stay open.
+const MaxMaintenanceWindowDuration = 7 * 24 * time.Hour
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseWeekday parses the three letter abbreviation of the day of the week, case-insensitive.
+func ParseWeekday(day string) (time.Weekday, error) {
+	d, ok := weekdays[strings.ToLower(day)]
+	if !ok {
+		return 0, fmt.Errorf("invalid day of the week %q, expected one of Mon, Tue, Wed, Thu, Fri, Sat, Sun", day)
+	}
+	return d, nil
+}
+
+// ParseClock parses the time of the day in the HH:MM format, returning the offset from midnight.
+func ParseClock(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of the day %q, expected HH:MM format: %w", clock, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Opens returns the times at which the window opens, that could have an effect
+// on the time t. That is the openings of the window within the last 7 days and
+// the next 7 days relative to t, in ascending order.
+func (w *MaintenanceWindow) Opens(t time.Time) ([]time.Time, error) {
+	loc := time.UTC
+	if w.Timezone != "" {
+		l, err := time.LoadLocation(w.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q: %w", w.Timezone, err)
+		}
+		loc = l
+	}
+
+	start, err := ParseClock(w.Start)
+	if err != nil {
+		return nil, err
+	}
+
+	var days []time.Weekday
+	for _, d := range w.Days {
+		wd, err := ParseWeekday(d)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, wd)
+	}
+
+	var (
+		out   []time.Time
+		local = t.In(loc)
+	)
+	for offset := -7; offset <= 7; offset++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, loc)
+		if len(days) > 0 && !slices.Contains(days, day.Weekday()) {
+			continue
+		}
+		out = append(out, day.Add(start))
+	}
+
+	return out, nil
+}
+
+// Contains returns whether the time t is within the window.
+func (w *MaintenanceWindow) Contains(t time.Time) (bool, error) {
+	d, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return false, fmt.Errorf("invalid duration %q: %w", w.Duration, err)
+	}
+
+	opens, err := w.Opens(t)
+	if err != nil {
+		return false, err
+	}
+
+	for _, o := range opens {
+		if !t.Before(o) && t.Before(o.Add(d)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// InMaintenanceWindow returns whether the time t is within any of the windows.
+// If there are no windows, any time is considered to be within a window. If t is
+// not within any of the windows, the time at which the next window opens is returned.
+func InMaintenanceWindow(windows []*MaintenanceWindow, t time.Time) (bool, time.Time, error) {
+	if len(windows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	var next time.Time
+	for _, w := range windows {
+		ok, err := w.Contains(t)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+		if ok {
+			return true, time.Time{}, nil
+		}
+
+		opens, err := w.Opens(t)
+		if err != nil {
+			return false, time.Time{}, err
+		}
+
+		for _, o := range opens {
+			if o.After(t) && (next.IsZero() || o.Before(next)) {
+				next = o
+				break
+			}
+		}
+	}
+
+	return false, next, nil
+}
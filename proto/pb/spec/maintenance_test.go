@@ -0,0 +1,84 @@
+package spec
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	// 2024-06-15 is a Saturday.
+	saturday := func(hour, minute int) time.Time {
+		return time.Date(2024, 6, 15, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		windows  []*MaintenanceWindow
+		now      time.Time
+		want     bool
+		wantNext time.Time
+		wantErr  bool
+	}{
+		{
+			name: "no-windows",
+			now:  saturday(12, 0),
+			want: true,
+		},
+		{
+			name:    "within-window",
+			windows: []*MaintenanceWindow{{Days: []string{"Sat"}, Start: "02:00", Duration: "4h"}},
+			now:     saturday(3, 30),
+			want:    true,
+		},
+		{
+			name:     "after-window",
+			windows:  []*MaintenanceWindow{{Days: []string{"Sat"}, Start: "02:00", Duration: "4h"}},
+			now:      saturday(6, 0),
+			want:     false,
+			wantNext: time.Date(2024, 6, 22, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "window-spanning-midnight",
+			windows: []*MaintenanceWindow{{Days: []string{"fri"}, Start: "22:00", Duration: "6h"}},
+			now:     saturday(1, 0),
+			want:    true,
+		},
+		{
+			name:     "every-day-in-timezone",
+			windows:  []*MaintenanceWindow{{Start: "01:00", Duration: "1h", Timezone: "Europe/Bratislava"}},
+			now:      saturday(0, 30),
+			want:     false,
+			wantNext: time.Date(2024, 6, 15, 23, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "multiple-windows",
+			windows: []*MaintenanceWindow{{Days: []string{"Mon"}, Start: "02:00", Duration: "1h"}, {Days: []string{"Sat"}, Start: "11:00", Duration: "2h"}},
+			now:     saturday(12, 0),
+			want:    true,
+		},
+		{
+			name:    "invalid-day",
+			windows: []*MaintenanceWindow{{Days: []string{"Someday"}, Start: "02:00", Duration: "1h"}},
+			now:     saturday(12, 0),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, next, err := InMaintenanceWindow(tt.windows, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("InMaintenanceWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("InMaintenanceWindow() = %v, want %v", got, tt.want)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("InMaintenanceWindow() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
@@ -257,6 +257,9 @@ const (
 	// WAIT_FOR_PICKUP indicates that new a new [Task] was created or moved to
 	// the next stage to be worked on.
 	Workflow_WAIT_FOR_PICKUP Workflow_Status = 3
+	// PAUSED indicates that the reconciliation of the cluster is paused
+	// and no [Task] will be scheduled until it is resumed.
+	Workflow_PAUSED Workflow_Status = 4
 )
 
 // Enum value maps for Workflow_Status.
@@ -266,12 +269,14 @@ var (
 		1: "ERROR",
 		2: "IN_PROGRESS",
 		3: "WAIT_FOR_PICKUP",
+		4: "PAUSED",
 	}
 	Workflow_Status_value = map[string]int32{
 		"DONE":            0,
 		"ERROR":           1,
 		"IN_PROGRESS":     2,
 		"WAIT_FOR_PICKUP": 3,
+		"PAUSED":          4,
 	}
 )
 
@@ -299,7 +304,63 @@ func (x Workflow_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Workflow_Status.Descriptor instead.
 func (Workflow_Status) EnumDescriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{8, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{9, 0}
+}
+
+// Algorithm names match the envoy lb_policy values.
+type Role_Algorithm int32
+
+const (
+	Role_ROUND_ROBIN   Role_Algorithm = 0
+	Role_LEAST_REQUEST Role_Algorithm = 1
+	Role_RING_HASH     Role_Algorithm = 2
+	Role_MAGLEV        Role_Algorithm = 3
+	Role_RANDOM        Role_Algorithm = 4
+)
+
+// Enum value maps for Role_Algorithm.
+var (
+	Role_Algorithm_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "LEAST_REQUEST",
+		2: "RING_HASH",
+		3: "MAGLEV",
+		4: "RANDOM",
+	}
+	Role_Algorithm_value = map[string]int32{
+		"ROUND_ROBIN":   0,
+		"LEAST_REQUEST": 1,
+		"RING_HASH":     2,
+		"MAGLEV":        3,
+		"RANDOM":        4,
+	}
+)
+
+func (x Role_Algorithm) Enum() *Role_Algorithm {
+	p := new(Role_Algorithm)
+	*p = x
+	return p
+}
+
+func (x Role_Algorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role_Algorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_spec_manifest_proto_enumTypes[5].Descriptor()
+}
+
+func (Role_Algorithm) Type() protoreflect.EnumType {
+	return &file_spec_manifest_proto_enumTypes[5]
+}
+
+func (x Role_Algorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role_Algorithm.Descriptor instead.
+func (Role_Algorithm) EnumDescriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 0}
 }
 
 type TaskResult_Error_Kind int32
@@ -332,11 +393,11 @@ func (x TaskResult_Error_Kind) String() string {
 }
 
 func (TaskResult_Error_Kind) Descriptor() protoreflect.EnumDescriptor {
-	return file_spec_manifest_proto_enumTypes[5].Descriptor()
+	return file_spec_manifest_proto_enumTypes[6].Descriptor()
 }
 
 func (TaskResult_Error_Kind) Type() protoreflect.EnumType {
-	return &file_spec_manifest_proto_enumTypes[5]
+	return &file_spec_manifest_proto_enumTypes[6]
 }
 
 func (x TaskResult_Error_Kind) Number() protoreflect.EnumNumber {
@@ -345,7 +406,7 @@ func (x TaskResult_Error_Kind) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TaskResult_Error_Kind.Descriptor instead.
 func (TaskResult_Error_Kind) EnumDescriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 0, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27, 0, 0}
 }
 
 // Config holds data for a single manifest.
@@ -551,11 +612,17 @@ func (x *Counters) GetK8SNodePoolScaleUpFailed() map[string]int64 {
 }
 
 type ClusterState struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Current       *Clusters              `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
-	State         *Workflow              `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	InFlight      *TaskEvent             `protobuf:"bytes,5,opt,name=inFlight,proto3" json:"inFlight,omitempty"`
-	Counters      *Counters              `protobuf:"bytes,6,opt,name=counters,proto3" json:"counters,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Current  *Clusters              `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	State    *Workflow              `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	InFlight *TaskEvent             `protobuf:"bytes,5,opt,name=inFlight,proto3" json:"inFlight,omitempty"`
+	Counters *Counters              `protobuf:"bytes,6,opt,name=counters,proto3" json:"counters,omitempty"`
+	// Whether the reconciliation of the cluster is paused, in which
+	// case no new tasks are scheduled for the cluster.
+	Paused bool `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Set once a failed task of the cluster was rolled back, in which case
+	// the cluster is kept at its current state until the manifest changes.
+	Rollback      *Rollback `protobuf:"bytes,8,opt,name=rollback,proto3" json:"rollback,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -618,6 +685,74 @@ func (x *ClusterState) GetCounters() *Counters {
 	return nil
 }
 
+func (x *ClusterState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ClusterState) GetRollback() *Rollback {
+	if x != nil {
+		return x.Rollback
+	}
+	return nil
+}
+
+type Rollback struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the failed task that was rolled back.
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	// Checksum of the manifest at the time of the rollback.
+	Checksum      []byte `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rollback) Reset() {
+	*x = Rollback{}
+	mi := &file_spec_manifest_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rollback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollback) ProtoMessage() {}
+
+func (x *Rollback) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollback.ProtoReflect.Descriptor instead.
+func (*Rollback) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Rollback) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Rollback) GetChecksum() []byte {
+	if x != nil {
+		return x.Checksum
+	}
+	return nil
+}
+
 type Clusters struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	K8S           *K8Scluster            `protobuf:"bytes,1,opt,name=k8s,proto3" json:"k8s,omitempty"`
@@ -628,7 +763,7 @@ type Clusters struct {
 
 func (x *Clusters) Reset() {
 	*x = Clusters{}
-	mi := &file_spec_manifest_proto_msgTypes[4]
+	mi := &file_spec_manifest_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -640,7 +775,7 @@ func (x *Clusters) String() string {
 func (*Clusters) ProtoMessage() {}
 
 func (x *Clusters) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[4]
+	mi := &file_spec_manifest_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -653,7 +788,7 @@ func (x *Clusters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Clusters.ProtoReflect.Descriptor instead.
 func (*Clusters) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{4}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Clusters) GetK8S() *K8Scluster {
@@ -679,7 +814,7 @@ type LoadBalancers struct {
 
 func (x *LoadBalancers) Reset() {
 	*x = LoadBalancers{}
-	mi := &file_spec_manifest_proto_msgTypes[5]
+	mi := &file_spec_manifest_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -691,7 +826,7 @@ func (x *LoadBalancers) String() string {
 func (*LoadBalancers) ProtoMessage() {}
 
 func (x *LoadBalancers) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[5]
+	mi := &file_spec_manifest_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +839,7 @@ func (x *LoadBalancers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadBalancers.ProtoReflect.Descriptor instead.
 func (*LoadBalancers) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{5}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *LoadBalancers) GetClusters() []*LBcluster {
@@ -726,7 +861,7 @@ type KubernetesContext struct {
 
 func (x *KubernetesContext) Reset() {
 	*x = KubernetesContext{}
-	mi := &file_spec_manifest_proto_msgTypes[6]
+	mi := &file_spec_manifest_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +873,7 @@ func (x *KubernetesContext) String() string {
 func (*KubernetesContext) ProtoMessage() {}
 
 func (x *KubernetesContext) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[6]
+	mi := &file_spec_manifest_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +886,7 @@ func (x *KubernetesContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesContext.ProtoReflect.Descriptor instead.
 func (*KubernetesContext) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{6}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *KubernetesContext) GetName() string {
@@ -780,7 +915,7 @@ type FinishedWorkflow struct {
 
 func (x *FinishedWorkflow) Reset() {
 	*x = FinishedWorkflow{}
-	mi := &file_spec_manifest_proto_msgTypes[7]
+	mi := &file_spec_manifest_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -792,7 +927,7 @@ func (x *FinishedWorkflow) String() string {
 func (*FinishedWorkflow) ProtoMessage() {}
 
 func (x *FinishedWorkflow) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[7]
+	mi := &file_spec_manifest_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,7 +940,7 @@ func (x *FinishedWorkflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinishedWorkflow.ProtoReflect.Descriptor instead.
 func (*FinishedWorkflow) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{7}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *FinishedWorkflow) GetStatus() Workflow_Status {
@@ -848,13 +983,16 @@ type Workflow struct {
 	// 0 the refresh of the infrastructure should be scheduled and this
 	// value should be reset back to its original starting value.
 	TicksUntilRefresh int32 `protobuf:"varint,10,opt,name=ticksUntilRefresh,proto3" json:"ticksUntilRefresh,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Description of the disruptive changes that are deferred until
+	// the next maintenance window of the cluster, empty if none.
+	Deferred      string `protobuf:"bytes,11,opt,name=deferred,proto3" json:"deferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Workflow) Reset() {
 	*x = Workflow{}
-	mi := &file_spec_manifest_proto_msgTypes[8]
+	mi := &file_spec_manifest_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -866,7 +1004,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[8]
+	mi := &file_spec_manifest_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1017,7 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{8}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Workflow) GetStatus() Workflow_Status {
@@ -910,6 +1048,13 @@ func (x *Workflow) GetTicksUntilRefresh() int32 {
 	return 0
 }
 
+func (x *Workflow) GetDeferred() string {
+	if x != nil {
+		return x.Deferred
+	}
+	return ""
+}
+
 // K8scluster represents a single kubernetes cluster specified in the manifest.
 type K8Scluster struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -923,13 +1068,20 @@ type K8Scluster struct {
 	Kubernetes string `protobuf:"bytes,4,opt,name=kubernetes,proto3" json:"kubernetes,omitempty"`
 	// General information about a proxy used to build a K8s cluster.
 	InstallationProxy *InstallationProxy `protobuf:"bytes,5,opt,name=installationProxy,proto3" json:"installationProxy,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Time windows within which disruptive changes to the cluster
+	// can be worked on. If empty, there are no restrictions.
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,6,rep,name=maintenanceWindows,proto3" json:"maintenanceWindows,omitempty"`
+	// IPv6 network range for the VPN. If set, the cluster is dual-stack.
+	NetworkIPv6 string `protobuf:"bytes,7,opt,name=networkIPv6,proto3" json:"networkIPv6,omitempty"`
+	// Webhooks called on the lifecycle events of the nodes.
+	NodeHooks     *NodeHooks `protobuf:"bytes,8,opt,name=nodeHooks,proto3" json:"nodeHooks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *K8Scluster) Reset() {
 	*x = K8Scluster{}
-	mi := &file_spec_manifest_proto_msgTypes[9]
+	mi := &file_spec_manifest_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +1093,7 @@ func (x *K8Scluster) String() string {
 func (*K8Scluster) ProtoMessage() {}
 
 func (x *K8Scluster) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[9]
+	mi := &file_spec_manifest_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1106,7 @@ func (x *K8Scluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use K8Scluster.ProtoReflect.Descriptor instead.
 func (*K8Scluster) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{9}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *K8Scluster) GetClusterInfo() *ClusterInfo {
@@ -992,6 +1144,137 @@ func (x *K8Scluster) GetInstallationProxy() *InstallationProxy {
 	return nil
 }
 
+func (x *K8Scluster) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+func (x *K8Scluster) GetNetworkIPv6() string {
+	if x != nil {
+		return x.NetworkIPv6
+	}
+	return ""
+}
+
+func (x *K8Scluster) GetNodeHooks() *NodeHooks {
+	if x != nil {
+		return x.NodeHooks
+	}
+	return nil
+}
+
+// NodeHooks are webhooks called on the lifecycle events of the nodes.
+type NodeHooks struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Called before a node is cordoned and drained.
+	PreDrain *NodeHook `protobuf:"bytes,1,opt,name=preDrain,proto3" json:"preDrain,omitempty"`
+	// Called after a node is joined into the cluster.
+	PostJoin      *NodeHook `protobuf:"bytes,2,opt,name=postJoin,proto3" json:"postJoin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeHooks) Reset() {
+	*x = NodeHooks{}
+	mi := &file_spec_manifest_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHooks) ProtoMessage() {}
+
+func (x *NodeHooks) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHooks.ProtoReflect.Descriptor instead.
+func (*NodeHooks) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NodeHooks) GetPreDrain() *NodeHook {
+	if x != nil {
+		return x.PreDrain
+	}
+	return nil
+}
+
+func (x *NodeHooks) GetPostJoin() *NodeHook {
+	if x != nil {
+		return x.PostJoin
+	}
+	return nil
+}
+
+// NodeHook is a single HTTP webhook.
+type NodeHook struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// URL to which the event is posted.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Timeout of a single call, i.e. 30s.
+	Timeout       string `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeHook) Reset() {
+	*x = NodeHook{}
+	mi := &file_spec_manifest_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeHook) ProtoMessage() {}
+
+func (x *NodeHook) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeHook.ProtoReflect.Descriptor instead.
+func (*NodeHook) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NodeHook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NodeHook) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
 // LBcluster represents a single load balancer cluster specified in the
 // manifest.
 type LBcluster struct {
@@ -1011,13 +1294,18 @@ type LBcluster struct {
 	// which always add new infrastructure before making any further changes.
 	// To recognize which of them is actually used this field was added.
 	UsedApiEndpoint bool `protobuf:"varint,5,opt,name=usedApiEndpoint,proto3" json:"usedApiEndpoint,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Set if the loadbalancer is designated for the Kubernetes Services
+	// of type LoadBalancer of the targeted kubernetes cluster.
+	ServiceLoadBalancer *ServiceLoadBalancer `protobuf:"bytes,6,opt,name=serviceLoadBalancer,proto3" json:"serviceLoadBalancer,omitempty"`
+	// Floating IP shared by the nodes of the loadbalancer via VRRP, if set.
+	VirtualIP     *VirtualIP `protobuf:"bytes,7,opt,name=virtualIP,proto3" json:"virtualIP,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LBcluster) Reset() {
 	*x = LBcluster{}
-	mi := &file_spec_manifest_proto_msgTypes[10]
+	mi := &file_spec_manifest_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1029,7 +1317,7 @@ func (x *LBcluster) String() string {
 func (*LBcluster) ProtoMessage() {}
 
 func (x *LBcluster) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[10]
+	mi := &file_spec_manifest_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,7 +1330,7 @@ func (x *LBcluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LBcluster.ProtoReflect.Descriptor instead.
 func (*LBcluster) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{10}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LBcluster) GetClusterInfo() *ClusterInfo {
@@ -1080,34 +1368,199 @@ func (x *LBcluster) GetUsedApiEndpoint() bool {
 	return false
 }
 
-// ClusterInfo holds general information about the clusters.
-type ClusterInfo struct {
+func (x *LBcluster) GetServiceLoadBalancer() *ServiceLoadBalancer {
+	if x != nil {
+		return x.ServiceLoadBalancer
+	}
+	return nil
+}
+
+func (x *LBcluster) GetVirtualIP() *VirtualIP {
+	if x != nil {
+		return x.VirtualIP
+	}
+	return nil
+}
+
+// VirtualIP is a floating IP address held by one of the nodes of the
+// loadbalancer at a time, failing over to another node via VRRP.
+type VirtualIP struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name of the cluster.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Random hash of the cluster.
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	// Array of node pools this cluster is made of.
-	NodePools     []*NodePool `protobuf:"bytes,5,rep,name=nodePools,proto3" json:"nodePools,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The floating IPv4 address.
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	// Network interface on the nodes to which the address is assigned.
+	// If empty, the interface of the default route is used.
+	Interface string `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	// Virtual router ID of the VRRP instance, unique within the network.
+	VirtualRouterId int32 `protobuf:"varint,3,opt,name=virtualRouterId,proto3" json:"virtualRouterId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ClusterInfo) Reset() {
-	*x = ClusterInfo{}
-	mi := &file_spec_manifest_proto_msgTypes[11]
+func (x *VirtualIP) Reset() {
+	*x = VirtualIP{}
+	mi := &file_spec_manifest_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClusterInfo) String() string {
+func (x *VirtualIP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterInfo) ProtoMessage() {}
+func (*VirtualIP) ProtoMessage() {}
+
+func (x *VirtualIP) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualIP.ProtoReflect.Descriptor instead.
+func (*VirtualIP) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VirtualIP) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *VirtualIP) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *VirtualIP) GetVirtualRouterId() int32 {
+	if x != nil {
+		return x.VirtualRouterId
+	}
+	return 0
+}
+
+// ServiceLoadBalancer holds the ports allocated on the loadbalancer for the
+// Kubernetes Services of type LoadBalancer. For each allocated port a role
+// is generated, forwarding the traffic to the node port of the service.
+type ServiceLoadBalancer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Range of the ports [minPort, maxPort] from which the ports are allocated.
+	MinPort int32 `protobuf:"varint,1,opt,name=minPort,proto3" json:"minPort,omitempty"`
+	MaxPort int32 `protobuf:"varint,2,opt,name=maxPort,proto3" json:"maxPort,omitempty"`
+	// Nodepools of the kubernetes cluster targeted by the generated roles.
+	TargetPools []string `protobuf:"bytes,3,rep,name=targetPools,proto3" json:"targetPools,omitempty"`
+	// Services for which the ports were allocated, only
+	// modified via the UpdateServiceLoadBalancer RPC.
+	Services []*ServiceLoadBalancer_Service `protobuf:"bytes,4,rep,name=services,proto3" json:"services,omitempty"`
+	// Token authenticating the service controller deployed into the
+	// kubernetes cluster for the UpdateServiceLoadBalancer RPC.
+	Token         string `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLoadBalancer) Reset() {
+	*x = ServiceLoadBalancer{}
+	mi := &file_spec_manifest_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLoadBalancer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLoadBalancer) ProtoMessage() {}
+
+func (x *ServiceLoadBalancer) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLoadBalancer.ProtoReflect.Descriptor instead.
+func (*ServiceLoadBalancer) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ServiceLoadBalancer) GetMinPort() int32 {
+	if x != nil {
+		return x.MinPort
+	}
+	return 0
+}
+
+func (x *ServiceLoadBalancer) GetMaxPort() int32 {
+	if x != nil {
+		return x.MaxPort
+	}
+	return 0
+}
+
+func (x *ServiceLoadBalancer) GetTargetPools() []string {
+	if x != nil {
+		return x.TargetPools
+	}
+	return nil
+}
+
+func (x *ServiceLoadBalancer) GetServices() []*ServiceLoadBalancer_Service {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *ServiceLoadBalancer) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// ClusterInfo holds general information about the clusters.
+type ClusterInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the cluster.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Random hash of the cluster.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Array of node pools this cluster is made of.
+	NodePools     []*NodePool `protobuf:"bytes,5,rep,name=nodePools,proto3" json:"nodePools,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterInfo) Reset() {
+	*x = ClusterInfo{}
+	mi := &file_spec_manifest_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterInfo) ProtoMessage() {}
 
 func (x *ClusterInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[11]
+	mi := &file_spec_manifest_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1573,7 @@ func (x *ClusterInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterInfo.ProtoReflect.Descriptor instead.
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{11}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ClusterInfo) GetName() string {
@@ -1163,7 +1616,7 @@ type InstallationProxy struct {
 
 func (x *InstallationProxy) Reset() {
 	*x = InstallationProxy{}
-	mi := &file_spec_manifest_proto_msgTypes[12]
+	mi := &file_spec_manifest_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1628,7 @@ func (x *InstallationProxy) String() string {
 func (*InstallationProxy) ProtoMessage() {}
 
 func (x *InstallationProxy) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[12]
+	mi := &file_spec_manifest_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1641,7 @@ func (x *InstallationProxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallationProxy.ProtoReflect.Descriptor instead.
 func (*InstallationProxy) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{12}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *InstallationProxy) GetMode() string {
@@ -1212,12 +1665,85 @@ func (x *InstallationProxy) GetNoProxy() string {
 	return ""
 }
 
+// MaintenanceWindow is a weekly recurring time window.
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days of the week on which the window opens, empty for every day.
+	Days []string `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	// Time of the day at which the window opens, in the HH:MM format.
+	Start string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// Duration for which the window stays open.
+	Duration string `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// IANA time zone in which the start is interpreted, UTC if empty.
+	Timezone      string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_spec_manifest_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MaintenanceWindow) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 // Role represents a single loadbalancer role from the manifest.
 type Role struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Name of the role.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Protocol that load balancer uses to forward traffic. ["tcp", "udp"]
+	// Protocol that load balancer uses to forward traffic. ["tcp", "udp", "http"]
 	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	// Port that load balancer will forward from.
 	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
@@ -1228,14 +1754,20 @@ type Role struct {
 	// Targeted nodes in Kubernetes clusters.
 	TargetPools []string `protobuf:"bytes,7,rep,name=targetPools,proto3" json:"targetPools,omitempty"`
 	// Additional settings for the role.
-	Settings      *Role_Settings `protobuf:"bytes,8,opt,name=settings,proto3" json:"settings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Settings *Role_Settings `protobuf:"bytes,8,opt,name=settings,proto3" json:"settings,omitempty"`
+	// Routes of the http role, requests not matching any of the
+	// routes are forwarded to the target pools on the target port.
+	Routes []*Role_Route `protobuf:"bytes,9,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Source ranges in the CIDR notation from which the role is reachable,
+	// reachable from anywhere if empty. Enforced by the firewall and envoy.
+	AllowedSourceRanges []string `protobuf:"bytes,10,rep,name=allowed_source_ranges,json=allowedSourceRanges,proto3" json:"allowed_source_ranges,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Role) Reset() {
 	*x = Role{}
-	mi := &file_spec_manifest_proto_msgTypes[13]
+	mi := &file_spec_manifest_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1247,7 +1779,7 @@ func (x *Role) String() string {
 func (*Role) ProtoMessage() {}
 
 func (x *Role) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[13]
+	mi := &file_spec_manifest_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +1792,7 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Role.ProtoReflect.Descriptor instead.
 func (*Role) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{13}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Role) GetName() string {
@@ -1312,6 +1844,20 @@ func (x *Role) GetSettings() *Role_Settings {
 	return nil
 }
 
+func (x *Role) GetRoutes() []*Role_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Role) GetAllowedSourceRanges() []string {
+	if x != nil {
+		return x.AllowedSourceRanges
+	}
+	return nil
+}
+
 type TaskEvent struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1339,7 +1885,7 @@ type TaskEvent struct {
 
 func (x *TaskEvent) Reset() {
 	*x = TaskEvent{}
-	mi := &file_spec_manifest_proto_msgTypes[14]
+	mi := &file_spec_manifest_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1351,7 +1897,7 @@ func (x *TaskEvent) String() string {
 func (*TaskEvent) ProtoMessage() {}
 
 func (x *TaskEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[14]
+	mi := &file_spec_manifest_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1364,7 +1910,7 @@ func (x *TaskEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
 func (*TaskEvent) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{14}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TaskEvent) GetId() string {
@@ -1438,7 +1984,7 @@ type Unreachable struct {
 
 func (x *Unreachable) Reset() {
 	*x = Unreachable{}
-	mi := &file_spec_manifest_proto_msgTypes[15]
+	mi := &file_spec_manifest_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1996,7 @@ func (x *Unreachable) String() string {
 func (*Unreachable) ProtoMessage() {}
 
 func (x *Unreachable) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[15]
+	mi := &file_spec_manifest_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +2009,7 @@ func (x *Unreachable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Unreachable.ProtoReflect.Descriptor instead.
 func (*Unreachable) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{15}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Unreachable) GetKubernetes() *Unreachable_UnreachableNodePools {
@@ -1499,7 +2045,7 @@ type Create struct {
 
 func (x *Create) Reset() {
 	*x = Create{}
-	mi := &file_spec_manifest_proto_msgTypes[16]
+	mi := &file_spec_manifest_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +2057,7 @@ func (x *Create) String() string {
 func (*Create) ProtoMessage() {}
 
 func (x *Create) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[16]
+	mi := &file_spec_manifest_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +2070,7 @@ func (x *Create) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Create.ProtoReflect.Descriptor instead.
 func (*Create) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{16}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Create) GetK8S() *K8Scluster {
@@ -1560,6 +2106,7 @@ type Update struct {
 	//	*Update_TfDeleteLoadBalancerNodes
 	//	*Update_TfMoveNodePoolToAutoscaled
 	//	*Update_TfMoveNodePoolFromAutoscaled
+	//	*Update_TfReplaceDnsRecords
 	//	*Update_TfReplaceRoleExternalSettings
 	//	*Update_AnsReplaceProxy
 	//	*Update_AnsReplaceTargetPools
@@ -1578,6 +2125,7 @@ type Update struct {
 	//	*Update_MovedNodePoolFromAutoscaled_
 	//	*Update_ReplacedRoleInternalSettings_
 	//	*Update_ReplacedRoleExternalSettings_
+	//	*Update_ReplacedDnsRecords_
 	//	*Update_DeleteLoadBalancer_
 	//	*Update_DeletedK8SNodes_
 	//	*Update_DeletedLoadBalancerNodes_
@@ -1593,7 +2141,7 @@ type Update struct {
 
 func (x *Update) Reset() {
 	*x = Update{}
-	mi := &file_spec_manifest_proto_msgTypes[17]
+	mi := &file_spec_manifest_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1605,7 +2153,7 @@ func (x *Update) String() string {
 func (*Update) ProtoMessage() {}
 
 func (x *Update) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[17]
+	mi := &file_spec_manifest_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,7 +2166,7 @@ func (x *Update) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update.ProtoReflect.Descriptor instead.
 func (*Update) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Update) GetState() *Update_State {
@@ -1716,6 +2264,15 @@ func (x *Update) GetTfMoveNodePoolFromAutoscaled() *Update_TerraformerMoveNodePo
 	return nil
 }
 
+func (x *Update) GetTfReplaceDnsRecords() *Update_TerraformerReplaceDnsRecords {
+	if x != nil {
+		if x, ok := x.Delta.(*Update_TfReplaceDnsRecords); ok {
+			return x.TfReplaceDnsRecords
+		}
+	}
+	return nil
+}
+
 func (x *Update) GetTfReplaceRoleExternalSettings() *Update_TerraformerReplaceRoleExternalSettings {
 	if x != nil {
 		if x, ok := x.Delta.(*Update_TfReplaceRoleExternalSettings); ok {
@@ -1878,6 +2435,15 @@ func (x *Update) GetReplacedRoleExternalSettings() *Update_ReplacedRoleExternalS
 	return nil
 }
 
+func (x *Update) GetReplacedDnsRecords() *Update_ReplacedDnsRecords {
+	if x != nil {
+		if x, ok := x.Delta.(*Update_ReplacedDnsRecords_); ok {
+			return x.ReplacedDnsRecords
+		}
+	}
+	return nil
+}
+
 func (x *Update) GetDeleteLoadBalancer() *Update_DeleteLoadBalancer {
 	if x != nil {
 		if x, ok := x.Delta.(*Update_DeleteLoadBalancer_); ok {
@@ -1991,6 +2557,10 @@ type Update_TfMoveNodePoolFromAutoscaled struct {
 	TfMoveNodePoolFromAutoscaled *Update_TerraformerMoveNodePoolFromAutoscaled `protobuf:"bytes,10,opt,name=tfMoveNodePoolFromAutoscaled,proto3,oneof"`
 }
 
+type Update_TfReplaceDnsRecords struct {
+	TfReplaceDnsRecords *Update_TerraformerReplaceDnsRecords `protobuf:"bytes,11,opt,name=tfReplaceDnsRecords,proto3,oneof"`
+}
+
 type Update_TfReplaceRoleExternalSettings struct {
 	TfReplaceRoleExternalSettings *Update_TerraformerReplaceRoleExternalSettings `protobuf:"bytes,12,opt,name=tfReplaceRoleExternalSettings,proto3,oneof"`
 }
@@ -2066,6 +2636,10 @@ type Update_ReplacedRoleExternalSettings_ struct {
 	ReplacedRoleExternalSettings *Update_ReplacedRoleExternalSettings `protobuf:"bytes,32,opt,name=replacedRoleExternalSettings,proto3,oneof"`
 }
 
+type Update_ReplacedDnsRecords_ struct {
+	ReplacedDnsRecords *Update_ReplacedDnsRecords `protobuf:"bytes,33,opt,name=replacedDnsRecords,proto3,oneof"`
+}
+
 type Update_DeleteLoadBalancer_ struct {
 	// Deletions.
 	DeleteLoadBalancer *Update_DeleteLoadBalancer `protobuf:"bytes,34,opt,name=deleteLoadBalancer,proto3,oneof"`
@@ -2118,6 +2692,8 @@ func (*Update_TfMoveNodePoolToAutoscaled) isUpdate_Delta() {}
 
 func (*Update_TfMoveNodePoolFromAutoscaled) isUpdate_Delta() {}
 
+func (*Update_TfReplaceDnsRecords) isUpdate_Delta() {}
+
 func (*Update_TfReplaceRoleExternalSettings) isUpdate_Delta() {}
 
 func (*Update_AnsReplaceProxy) isUpdate_Delta() {}
@@ -2154,6 +2730,8 @@ func (*Update_ReplacedRoleInternalSettings_) isUpdate_Delta() {}
 
 func (*Update_ReplacedRoleExternalSettings_) isUpdate_Delta() {}
 
+func (*Update_ReplacedDnsRecords_) isUpdate_Delta() {}
+
 func (*Update_DeleteLoadBalancer_) isUpdate_Delta() {}
 
 func (*Update_DeletedK8SNodes_) isUpdate_Delta() {}
@@ -2189,7 +2767,7 @@ type Delete struct {
 
 func (x *Delete) Reset() {
 	*x = Delete{}
-	mi := &file_spec_manifest_proto_msgTypes[18]
+	mi := &file_spec_manifest_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2201,7 +2779,7 @@ func (x *Delete) String() string {
 func (*Delete) ProtoMessage() {}
 
 func (x *Delete) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[18]
+	mi := &file_spec_manifest_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2214,7 +2792,7 @@ func (x *Delete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Delete.ProtoReflect.Descriptor instead.
 func (*Delete) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{18}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Delete) GetK8S() *K8Scluster {
@@ -2245,7 +2823,7 @@ type Task struct {
 
 func (x *Task) Reset() {
 	*x = Task{}
-	mi := &file_spec_manifest_proto_msgTypes[19]
+	mi := &file_spec_manifest_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2257,7 +2835,7 @@ func (x *Task) String() string {
 func (*Task) ProtoMessage() {}
 
 func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[19]
+	mi := &file_spec_manifest_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,7 +2848,7 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Task.ProtoReflect.Descriptor instead.
 func (*Task) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{19}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *Task) GetDo() isTask_Do {
@@ -2342,7 +2920,7 @@ type Work struct {
 
 func (x *Work) Reset() {
 	*x = Work{}
-	mi := &file_spec_manifest_proto_msgTypes[20]
+	mi := &file_spec_manifest_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2354,7 +2932,7 @@ func (x *Work) String() string {
 func (*Work) ProtoMessage() {}
 
 func (x *Work) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[20]
+	mi := &file_spec_manifest_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,7 +2945,7 @@ func (x *Work) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Work.ProtoReflect.Descriptor instead.
 func (*Work) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{20}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Work) GetTask() *Task {
@@ -2396,14 +2974,17 @@ type TaskResult struct {
 	//	*TaskResult_None_
 	//	*TaskResult_Update
 	//	*TaskResult_Clear
-	Result        isTaskResult_Result `protobuf_oneof:"Result"`
+	Result isTaskResult_Result `protobuf_oneof:"Result"`
+	// Non-fatal diagnostics gathered while processing the task,
+	// reported regardless of whether the task succeeded or not.
+	Warnings      []string `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TaskResult) Reset() {
 	*x = TaskResult{}
-	mi := &file_spec_manifest_proto_msgTypes[21]
+	mi := &file_spec_manifest_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2415,7 +2996,7 @@ func (x *TaskResult) String() string {
 func (*TaskResult) ProtoMessage() {}
 
 func (x *TaskResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[21]
+	mi := &file_spec_manifest_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2428,7 +3009,7 @@ func (x *TaskResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult.ProtoReflect.Descriptor instead.
 func (*TaskResult) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TaskResult) GetError() *TaskResult_Error {
@@ -2472,6 +3053,13 @@ func (x *TaskResult) GetClear() *TaskResult_ClearState {
 	return nil
 }
 
+func (x *TaskResult) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 type isTaskResult_Result interface {
 	isTaskResult_Result()
 }
@@ -2494,20 +3082,171 @@ func (*TaskResult_Update) isTaskResult_Result() {}
 
 func (*TaskResult_Clear) isTaskResult_Result() {}
 
-type Role_Settings struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProxyProtocol  bool                   `protobuf:"varint,1,opt,name=proxyProtocol,proto3" json:"proxyProtocol,omitempty"`
-	StickySessions bool                   `protobuf:"varint,2,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
-	// required port for the envoy admin interface,
-	// on change will issue restart of the envoy proxy.
-	EnvoyAdminPort int32 `protobuf:"varint,3,opt,name=envoy_admin_port,json=envoyAdminPort,proto3" json:"envoy_admin_port,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *Role_Settings) Reset() {
+type ServiceLoadBalancer_Port struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the port of the service.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Protocol of the port. ["tcp", "udp"]
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Port allocated on the loadbalancer.
+	Port int32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// Node port of the service to which the traffic is forwarded.
+	NodePort      int32 `protobuf:"varint,4,opt,name=nodePort,proto3" json:"nodePort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLoadBalancer_Port) Reset() {
+	*x = ServiceLoadBalancer_Port{}
+	mi := &file_spec_manifest_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLoadBalancer_Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLoadBalancer_Port) ProtoMessage() {}
+
+func (x *ServiceLoadBalancer_Port) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLoadBalancer_Port.ProtoReflect.Descriptor instead.
+func (*ServiceLoadBalancer_Port) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ServiceLoadBalancer_Port) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceLoadBalancer_Port) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServiceLoadBalancer_Port) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServiceLoadBalancer_Port) GetNodePort() int32 {
+	if x != nil {
+		return x.NodePort
+	}
+	return 0
+}
+
+type ServiceLoadBalancer_Service struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Namespace     string                      `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ports         []*ServiceLoadBalancer_Port `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceLoadBalancer_Service) Reset() {
+	*x = ServiceLoadBalancer_Service{}
+	mi := &file_spec_manifest_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceLoadBalancer_Service) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceLoadBalancer_Service) ProtoMessage() {}
+
+func (x *ServiceLoadBalancer_Service) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceLoadBalancer_Service.ProtoReflect.Descriptor instead.
+func (*ServiceLoadBalancer_Service) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *ServiceLoadBalancer_Service) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceLoadBalancer_Service) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceLoadBalancer_Service) GetPorts() []*ServiceLoadBalancer_Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type Role_Settings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyProtocol bool                   `protobuf:"varint,1,opt,name=proxyProtocol,proto3" json:"proxyProtocol,omitempty"`
+	// Hashes the source ip of the connections to choose the target node,
+	// also set for the RING_HASH and MAGLEV algorithms.
+	StickySessions bool `protobuf:"varint,2,opt,name=stickySessions,proto3" json:"stickySessions,omitempty"`
+	// required port for the envoy admin interface,
+	// on change will issue restart of the envoy proxy.
+	EnvoyAdminPort int32 `protobuf:"varint,3,opt,name=envoy_admin_port,json=envoyAdminPort,proto3" json:"envoy_admin_port,omitempty"`
+	// Active health checking of the target nodes, disabled if not set.
+	HealthCheck *Role_HealthCheck `protobuf:"bytes,4,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// Ejection of the target nodes based on the observed failures, disabled if not set.
+	OutlierDetection *Role_OutlierDetection `protobuf:"bytes,5,opt,name=outlier_detection,json=outlierDetection,proto3" json:"outlier_detection,omitempty"`
+	// TLS termination on the loadbalancer nodes, disabled if not set.
+	Tls *Role_Tls `protobuf:"bytes,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Load balancing algorithm choosing the target nodes. If sticky
+	// sessions are enabled, ROUND_ROBIN is treated as RING_HASH.
+	Algorithm Role_Algorithm `protobuf:"varint,7,opt,name=algorithm,proto3,enum=spec.Role_Algorithm" json:"algorithm,omitempty"`
+	// Weights of the nodes of the target pools, by the name of the target pool.
+	// Nodes of the target pools not present have a weight of 1, nodes of the
+	// target pools with a weight of 0 do not receive any traffic.
+	Weights map[string]uint32 `protobuf:"bytes,8,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Maximum number of concurrent connections accepted by the role, unlimited if zero.
+	MaxConnections uint32 `protobuf:"varint,9,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// Limit of the rate of the new connections accepted by the role, unlimited if not set.
+	RateLimit     *Role_RateLimit `protobuf:"bytes,10,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role_Settings) Reset() {
 	*x = Role_Settings{}
-	mi := &file_spec_manifest_proto_msgTypes[24]
+	mi := &file_spec_manifest_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2519,7 +3258,7 @@ func (x *Role_Settings) String() string {
 func (*Role_Settings) ProtoMessage() {}
 
 func (x *Role_Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[24]
+	mi := &file_spec_manifest_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,7 +3271,7 @@ func (x *Role_Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Role_Settings.ProtoReflect.Descriptor instead.
 func (*Role_Settings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{13, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *Role_Settings) GetProxyProtocol() bool {
@@ -2556,6 +3295,426 @@ func (x *Role_Settings) GetEnvoyAdminPort() int32 {
 	return 0
 }
 
+func (x *Role_Settings) GetHealthCheck() *Role_HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+func (x *Role_Settings) GetOutlierDetection() *Role_OutlierDetection {
+	if x != nil {
+		return x.OutlierDetection
+	}
+	return nil
+}
+
+func (x *Role_Settings) GetTls() *Role_Tls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *Role_Settings) GetAlgorithm() Role_Algorithm {
+	if x != nil {
+		return x.Algorithm
+	}
+	return Role_ROUND_ROBIN
+}
+
+func (x *Role_Settings) GetWeights() map[string]uint32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Role_Settings) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *Role_Settings) GetRateLimit() *Role_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+type Role_RateLimit struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionsPerSecond uint32                 `protobuf:"varint,1,opt,name=connections_per_second,json=connectionsPerSecond,proto3" json:"connections_per_second,omitempty"`
+	// Number of connections that can be accepted at once, above the rate.
+	Burst         uint32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role_RateLimit) Reset() {
+	*x = Role_RateLimit{}
+	mi := &file_spec_manifest_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role_RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role_RateLimit) ProtoMessage() {}
+
+func (x *Role_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role_RateLimit.ProtoReflect.Descriptor instead.
+func (*Role_RateLimit) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *Role_RateLimit) GetConnectionsPerSecond() uint32 {
+	if x != nil {
+		return x.ConnectionsPerSecond
+	}
+	return 0
+}
+
+func (x *Role_RateLimit) GetBurst() uint32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+type Role_Tls struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Email used for the ACME account.
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// ACME directory of the certificate authority.
+	Directory string `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	// PEM encoded certificate chain, empty until obtained.
+	Certificate string `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// PEM encoded private key of the certificate.
+	PrivateKey string `protobuf:"bytes,4,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// PEM encoded key of the ACME account, reused for renewals.
+	AccountKey    string `protobuf:"bytes,5,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role_Tls) Reset() {
+	*x = Role_Tls{}
+	mi := &file_spec_manifest_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role_Tls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role_Tls) ProtoMessage() {}
+
+func (x *Role_Tls) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role_Tls.ProtoReflect.Descriptor instead.
+func (*Role_Tls) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *Role_Tls) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Role_Tls) GetDirectory() string {
+	if x != nil {
+		return x.Directory
+	}
+	return ""
+}
+
+func (x *Role_Tls) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Role_Tls) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *Role_Tls) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
+	}
+	return ""
+}
+
+type Role_HealthCheck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Protocol of the health check. ["tcp", "http"]
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Path requested by the http health check.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Port on the target nodes to which the health checks are sent,
+	// if zero the target port of the role is used.
+	Port               int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	IntervalMs         uint32 `protobuf:"varint,4,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	TimeoutMs          uint32 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	HealthyThreshold   uint32 `protobuf:"varint,6,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	UnhealthyThreshold uint32 `protobuf:"varint,7,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Role_HealthCheck) Reset() {
+	*x = Role_HealthCheck{}
+	mi := &file_spec_manifest_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role_HealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role_HealthCheck) ProtoMessage() {}
+
+func (x *Role_HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role_HealthCheck.ProtoReflect.Descriptor instead.
+func (*Role_HealthCheck) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (x *Role_HealthCheck) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Role_HealthCheck) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Role_HealthCheck) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Role_HealthCheck) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *Role_HealthCheck) GetTimeoutMs() uint32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+func (x *Role_HealthCheck) GetHealthyThreshold() uint32 {
+	if x != nil {
+		return x.HealthyThreshold
+	}
+	return 0
+}
+
+func (x *Role_HealthCheck) GetUnhealthyThreshold() uint32 {
+	if x != nil {
+		return x.UnhealthyThreshold
+	}
+	return 0
+}
+
+type Role_OutlierDetection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of consecutive failed connections after which the node is ejected.
+	ConsecutiveFailures uint32 `protobuf:"varint,1,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	IntervalMs          uint32 `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	BaseEjectionTimeMs  uint32 `protobuf:"varint,3,opt,name=base_ejection_time_ms,json=baseEjectionTimeMs,proto3" json:"base_ejection_time_ms,omitempty"`
+	MaxEjectionPercent  uint32 `protobuf:"varint,4,opt,name=max_ejection_percent,json=maxEjectionPercent,proto3" json:"max_ejection_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Role_OutlierDetection) Reset() {
+	*x = Role_OutlierDetection{}
+	mi := &file_spec_manifest_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role_OutlierDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role_OutlierDetection) ProtoMessage() {}
+
+func (x *Role_OutlierDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role_OutlierDetection.ProtoReflect.Descriptor instead.
+func (*Role_OutlierDetection) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 4}
+}
+
+func (x *Role_OutlierDetection) GetConsecutiveFailures() uint32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *Role_OutlierDetection) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+func (x *Role_OutlierDetection) GetBaseEjectionTimeMs() uint32 {
+	if x != nil {
+		return x.BaseEjectionTimeMs
+	}
+	return 0
+}
+
+func (x *Role_OutlierDetection) GetMaxEjectionPercent() uint32 {
+	if x != nil {
+		return x.MaxEjectionPercent
+	}
+	return 0
+}
+
+type Role_Route struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Host of the requests matched by the route, any host if empty.
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	// Path prefix of the requests matched by the route.
+	PathPrefix string `protobuf:"bytes,2,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// Targeted nodes in Kubernetes clusters.
+	TargetPools []string `protobuf:"bytes,3,rep,name=target_pools,json=targetPools,proto3" json:"target_pools,omitempty"`
+	// Port that load balancer will forward the matched requests to.
+	TargetPort    int32 `protobuf:"varint,4,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Role_Route) Reset() {
+	*x = Role_Route{}
+	mi := &file_spec_manifest_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Role_Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role_Route) ProtoMessage() {}
+
+func (x *Role_Route) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role_Route.ProtoReflect.Descriptor instead.
+func (*Role_Route) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{19, 5}
+}
+
+func (x *Role_Route) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Role_Route) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
+
+func (x *Role_Route) GetTargetPools() []string {
+	if x != nil {
+		return x.TargetPools
+	}
+	return nil
+}
+
+func (x *Role_Route) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
 type Unreachable_ListOfNodeEndpoints struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Endpoints     []string               `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
@@ -2565,7 +3724,7 @@ type Unreachable_ListOfNodeEndpoints struct {
 
 func (x *Unreachable_ListOfNodeEndpoints) Reset() {
 	*x = Unreachable_ListOfNodeEndpoints{}
-	mi := &file_spec_manifest_proto_msgTypes[25]
+	mi := &file_spec_manifest_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2577,7 +3736,7 @@ func (x *Unreachable_ListOfNodeEndpoints) String() string {
 func (*Unreachable_ListOfNodeEndpoints) ProtoMessage() {}
 
 func (x *Unreachable_ListOfNodeEndpoints) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[25]
+	mi := &file_spec_manifest_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2590,7 +3749,7 @@ func (x *Unreachable_ListOfNodeEndpoints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Unreachable_ListOfNodeEndpoints.ProtoReflect.Descriptor instead.
 func (*Unreachable_ListOfNodeEndpoints) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{15, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 0}
 }
 
 func (x *Unreachable_ListOfNodeEndpoints) GetEndpoints() []string {
@@ -2609,7 +3768,7 @@ type Unreachable_UnreachableNodePools struct {
 
 func (x *Unreachable_UnreachableNodePools) Reset() {
 	*x = Unreachable_UnreachableNodePools{}
-	mi := &file_spec_manifest_proto_msgTypes[26]
+	mi := &file_spec_manifest_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2621,7 +3780,7 @@ func (x *Unreachable_UnreachableNodePools) String() string {
 func (*Unreachable_UnreachableNodePools) ProtoMessage() {}
 
 func (x *Unreachable_UnreachableNodePools) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[26]
+	mi := &file_spec_manifest_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,7 +3793,7 @@ func (x *Unreachable_UnreachableNodePools) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Unreachable_UnreachableNodePools.ProtoReflect.Descriptor instead.
 func (*Unreachable_UnreachableNodePools) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{15, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *Unreachable_UnreachableNodePools) GetNodepools() map[string]*Unreachable_ListOfNodeEndpoints {
@@ -2658,7 +3817,7 @@ type Update_State struct {
 
 func (x *Update_State) Reset() {
 	*x = Update_State{}
-	mi := &file_spec_manifest_proto_msgTypes[29]
+	mi := &file_spec_manifest_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2670,7 +3829,7 @@ func (x *Update_State) String() string {
 func (*Update_State) ProtoMessage() {}
 
 func (x *Update_State) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[29]
+	mi := &file_spec_manifest_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2683,7 +3842,7 @@ func (x *Update_State) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_State.ProtoReflect.Descriptor instead.
 func (*Update_State) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *Update_State) GetK8S() *K8Scluster {
@@ -2708,7 +3867,7 @@ type Update_None struct {
 
 func (x *Update_None) Reset() {
 	*x = Update_None{}
-	mi := &file_spec_manifest_proto_msgTypes[30]
+	mi := &file_spec_manifest_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2720,7 +3879,7 @@ func (x *Update_None) String() string {
 func (*Update_None) ProtoMessage() {}
 
 func (x *Update_None) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[30]
+	mi := &file_spec_manifest_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,7 +3892,7 @@ func (x *Update_None) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_None.ProtoReflect.Descriptor instead.
 func (*Update_None) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 1}
 }
 
 // TerraformerMoveNodePoolToAutoscaled is a message that once
@@ -2756,7 +3915,7 @@ type Update_TerraformerMoveNodePoolToAutoscaled struct {
 
 func (x *Update_TerraformerMoveNodePoolToAutoscaled) Reset() {
 	*x = Update_TerraformerMoveNodePoolToAutoscaled{}
-	mi := &file_spec_manifest_proto_msgTypes[31]
+	mi := &file_spec_manifest_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2768,7 +3927,7 @@ func (x *Update_TerraformerMoveNodePoolToAutoscaled) String() string {
 func (*Update_TerraformerMoveNodePoolToAutoscaled) ProtoMessage() {}
 
 func (x *Update_TerraformerMoveNodePoolToAutoscaled) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[31]
+	mi := &file_spec_manifest_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2781,7 +3940,7 @@ func (x *Update_TerraformerMoveNodePoolToAutoscaled) ProtoReflect() protoreflect
 
 // Deprecated: Use Update_TerraformerMoveNodePoolToAutoscaled.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerMoveNodePoolToAutoscaled) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 2}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 2}
 }
 
 func (x *Update_TerraformerMoveNodePoolToAutoscaled) GetNodepool() string {
@@ -2807,7 +3966,7 @@ type Update_MovedNodePoolToAutoscaled struct {
 
 func (x *Update_MovedNodePoolToAutoscaled) Reset() {
 	*x = Update_MovedNodePoolToAutoscaled{}
-	mi := &file_spec_manifest_proto_msgTypes[32]
+	mi := &file_spec_manifest_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2819,7 +3978,7 @@ func (x *Update_MovedNodePoolToAutoscaled) String() string {
 func (*Update_MovedNodePoolToAutoscaled) ProtoMessage() {}
 
 func (x *Update_MovedNodePoolToAutoscaled) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[32]
+	mi := &file_spec_manifest_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2832,7 +3991,7 @@ func (x *Update_MovedNodePoolToAutoscaled) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_MovedNodePoolToAutoscaled.ProtoReflect.Descriptor instead.
 func (*Update_MovedNodePoolToAutoscaled) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 3}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 3}
 }
 
 func (x *Update_MovedNodePoolToAutoscaled) GetNodepool() string {
@@ -2853,7 +4012,7 @@ type Update_TerraformerMoveNodePoolFromAutoscaled struct {
 
 func (x *Update_TerraformerMoveNodePoolFromAutoscaled) Reset() {
 	*x = Update_TerraformerMoveNodePoolFromAutoscaled{}
-	mi := &file_spec_manifest_proto_msgTypes[33]
+	mi := &file_spec_manifest_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2865,7 +4024,7 @@ func (x *Update_TerraformerMoveNodePoolFromAutoscaled) String() string {
 func (*Update_TerraformerMoveNodePoolFromAutoscaled) ProtoMessage() {}
 
 func (x *Update_TerraformerMoveNodePoolFromAutoscaled) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[33]
+	mi := &file_spec_manifest_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2878,7 +4037,7 @@ func (x *Update_TerraformerMoveNodePoolFromAutoscaled) ProtoReflect() protorefle
 
 // Deprecated: Use Update_TerraformerMoveNodePoolFromAutoscaled.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerMoveNodePoolFromAutoscaled) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 4}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 4}
 }
 
 func (x *Update_TerraformerMoveNodePoolFromAutoscaled) GetNodepool() string {
@@ -2898,7 +4057,7 @@ type Update_MovedNodePoolFromAutoscaled struct {
 
 func (x *Update_MovedNodePoolFromAutoscaled) Reset() {
 	*x = Update_MovedNodePoolFromAutoscaled{}
-	mi := &file_spec_manifest_proto_msgTypes[34]
+	mi := &file_spec_manifest_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2910,7 +4069,7 @@ func (x *Update_MovedNodePoolFromAutoscaled) String() string {
 func (*Update_MovedNodePoolFromAutoscaled) ProtoMessage() {}
 
 func (x *Update_MovedNodePoolFromAutoscaled) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[34]
+	mi := &file_spec_manifest_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2923,7 +4082,7 @@ func (x *Update_MovedNodePoolFromAutoscaled) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_MovedNodePoolFromAutoscaled.ProtoReflect.Descriptor instead.
 func (*Update_MovedNodePoolFromAutoscaled) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 5}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 5}
 }
 
 func (x *Update_MovedNodePoolFromAutoscaled) GetNodepool() string {
@@ -2958,7 +4117,7 @@ type Update_TerraformerAddLoadBalancer struct {
 
 func (x *Update_TerraformerAddLoadBalancer) Reset() {
 	*x = Update_TerraformerAddLoadBalancer{}
-	mi := &file_spec_manifest_proto_msgTypes[35]
+	mi := &file_spec_manifest_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2970,7 +4129,7 @@ func (x *Update_TerraformerAddLoadBalancer) String() string {
 func (*Update_TerraformerAddLoadBalancer) ProtoMessage() {}
 
 func (x *Update_TerraformerAddLoadBalancer) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[35]
+	mi := &file_spec_manifest_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2983,7 +4142,7 @@ func (x *Update_TerraformerAddLoadBalancer) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_TerraformerAddLoadBalancer.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddLoadBalancer) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 6}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 6}
 }
 
 func (x *Update_TerraformerAddLoadBalancer) GetHandle() *LBcluster {
@@ -3002,7 +4161,7 @@ type Update_AddedLoadBalancer struct {
 
 func (x *Update_AddedLoadBalancer) Reset() {
 	*x = Update_AddedLoadBalancer{}
-	mi := &file_spec_manifest_proto_msgTypes[36]
+	mi := &file_spec_manifest_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3014,7 +4173,7 @@ func (x *Update_AddedLoadBalancer) String() string {
 func (*Update_AddedLoadBalancer) ProtoMessage() {}
 
 func (x *Update_AddedLoadBalancer) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[36]
+	mi := &file_spec_manifest_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3027,7 +4186,7 @@ func (x *Update_AddedLoadBalancer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_AddedLoadBalancer.ProtoReflect.Descriptor instead.
 func (*Update_AddedLoadBalancer) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 7}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 7}
 }
 
 func (x *Update_AddedLoadBalancer) GetHandle() string {
@@ -3069,7 +4228,7 @@ type Update_TerraformerDeleteLoadBalancerNodes struct {
 
 func (x *Update_TerraformerDeleteLoadBalancerNodes) Reset() {
 	*x = Update_TerraformerDeleteLoadBalancerNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[37]
+	mi := &file_spec_manifest_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3081,7 +4240,7 @@ func (x *Update_TerraformerDeleteLoadBalancerNodes) String() string {
 func (*Update_TerraformerDeleteLoadBalancerNodes) ProtoMessage() {}
 
 func (x *Update_TerraformerDeleteLoadBalancerNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[37]
+	mi := &file_spec_manifest_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3094,7 +4253,7 @@ func (x *Update_TerraformerDeleteLoadBalancerNodes) ProtoReflect() protoreflect.
 
 // Deprecated: Use Update_TerraformerDeleteLoadBalancerNodes.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerDeleteLoadBalancerNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 8}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 8}
 }
 
 func (x *Update_TerraformerDeleteLoadBalancerNodes) GetHandle() string {
@@ -3151,7 +4310,7 @@ type Update_DeletedLoadBalancerNodes struct {
 
 func (x *Update_DeletedLoadBalancerNodes) Reset() {
 	*x = Update_DeletedLoadBalancerNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[38]
+	mi := &file_spec_manifest_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3163,7 +4322,7 @@ func (x *Update_DeletedLoadBalancerNodes) String() string {
 func (*Update_DeletedLoadBalancerNodes) ProtoMessage() {}
 
 func (x *Update_DeletedLoadBalancerNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[38]
+	mi := &file_spec_manifest_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3176,7 +4335,7 @@ func (x *Update_DeletedLoadBalancerNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_DeletedLoadBalancerNodes.ProtoReflect.Descriptor instead.
 func (*Update_DeletedLoadBalancerNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 9}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 9}
 }
 
 func (x *Update_DeletedLoadBalancerNodes) GetUnreachable() *Unreachable {
@@ -3257,7 +4416,7 @@ type Update_TerraformerAddLoadBalancerNodes struct {
 
 func (x *Update_TerraformerAddLoadBalancerNodes) Reset() {
 	*x = Update_TerraformerAddLoadBalancerNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[39]
+	mi := &file_spec_manifest_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3269,7 +4428,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes) String() string {
 func (*Update_TerraformerAddLoadBalancerNodes) ProtoMessage() {}
 
 func (x *Update_TerraformerAddLoadBalancerNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[39]
+	mi := &file_spec_manifest_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3282,7 +4441,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use Update_TerraformerAddLoadBalancerNodes.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddLoadBalancerNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 10}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 10}
 }
 
 func (x *Update_TerraformerAddLoadBalancerNodes) GetHandle() string {
@@ -3346,7 +4505,7 @@ type Update_AddedLoadBalancerNodes struct {
 
 func (x *Update_AddedLoadBalancerNodes) Reset() {
 	*x = Update_AddedLoadBalancerNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[40]
+	mi := &file_spec_manifest_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3358,7 +4517,7 @@ func (x *Update_AddedLoadBalancerNodes) String() string {
 func (*Update_AddedLoadBalancerNodes) ProtoMessage() {}
 
 func (x *Update_AddedLoadBalancerNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[40]
+	mi := &file_spec_manifest_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3371,7 +4530,7 @@ func (x *Update_AddedLoadBalancerNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_AddedLoadBalancerNodes.ProtoReflect.Descriptor instead.
 func (*Update_AddedLoadBalancerNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 11}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 11}
 }
 
 func (x *Update_AddedLoadBalancerNodes) GetHandle() string {
@@ -3414,7 +4573,7 @@ type Update_DeleteLoadBalancerRoles struct {
 
 func (x *Update_DeleteLoadBalancerRoles) Reset() {
 	*x = Update_DeleteLoadBalancerRoles{}
-	mi := &file_spec_manifest_proto_msgTypes[41]
+	mi := &file_spec_manifest_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3426,7 +4585,7 @@ func (x *Update_DeleteLoadBalancerRoles) String() string {
 func (*Update_DeleteLoadBalancerRoles) ProtoMessage() {}
 
 func (x *Update_DeleteLoadBalancerRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[41]
+	mi := &file_spec_manifest_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3439,7 +4598,7 @@ func (x *Update_DeleteLoadBalancerRoles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_DeleteLoadBalancerRoles.ProtoReflect.Descriptor instead.
 func (*Update_DeleteLoadBalancerRoles) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 12}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 12}
 }
 
 func (x *Update_DeleteLoadBalancerRoles) GetHandle() string {
@@ -3474,7 +4633,7 @@ type Update_TerraformerAddLoadBalancerRoles struct {
 
 func (x *Update_TerraformerAddLoadBalancerRoles) Reset() {
 	*x = Update_TerraformerAddLoadBalancerRoles{}
-	mi := &file_spec_manifest_proto_msgTypes[42]
+	mi := &file_spec_manifest_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3486,7 +4645,7 @@ func (x *Update_TerraformerAddLoadBalancerRoles) String() string {
 func (*Update_TerraformerAddLoadBalancerRoles) ProtoMessage() {}
 
 func (x *Update_TerraformerAddLoadBalancerRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[42]
+	mi := &file_spec_manifest_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3499,7 +4658,7 @@ func (x *Update_TerraformerAddLoadBalancerRoles) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use Update_TerraformerAddLoadBalancerRoles.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddLoadBalancerRoles) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 13}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 13}
 }
 
 func (x *Update_TerraformerAddLoadBalancerRoles) GetHandle() string {
@@ -3526,7 +4685,7 @@ type Update_AddedLoadBalancerRoles struct {
 
 func (x *Update_AddedLoadBalancerRoles) Reset() {
 	*x = Update_AddedLoadBalancerRoles{}
-	mi := &file_spec_manifest_proto_msgTypes[43]
+	mi := &file_spec_manifest_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3538,7 +4697,7 @@ func (x *Update_AddedLoadBalancerRoles) String() string {
 func (*Update_AddedLoadBalancerRoles) ProtoMessage() {}
 
 func (x *Update_AddedLoadBalancerRoles) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[43]
+	mi := &file_spec_manifest_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3551,7 +4710,7 @@ func (x *Update_AddedLoadBalancerRoles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_AddedLoadBalancerRoles.ProtoReflect.Descriptor instead.
 func (*Update_AddedLoadBalancerRoles) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 14}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 14}
 }
 
 func (x *Update_AddedLoadBalancerRoles) GetHandle() string {
@@ -3594,7 +4753,7 @@ type Update_TerraformerReplaceDns struct {
 
 func (x *Update_TerraformerReplaceDns) Reset() {
 	*x = Update_TerraformerReplaceDns{}
-	mi := &file_spec_manifest_proto_msgTypes[44]
+	mi := &file_spec_manifest_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3606,7 +4765,7 @@ func (x *Update_TerraformerReplaceDns) String() string {
 func (*Update_TerraformerReplaceDns) ProtoMessage() {}
 
 func (x *Update_TerraformerReplaceDns) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[44]
+	mi := &file_spec_manifest_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3619,7 +4778,7 @@ func (x *Update_TerraformerReplaceDns) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_TerraformerReplaceDns.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerReplaceDns) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 15}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 15}
 }
 
 func (x *Update_TerraformerReplaceDns) GetHandle() string {
@@ -3653,7 +4812,7 @@ type Update_ReplacedDns struct {
 
 func (x *Update_ReplacedDns) Reset() {
 	*x = Update_ReplacedDns{}
-	mi := &file_spec_manifest_proto_msgTypes[45]
+	mi := &file_spec_manifest_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3665,7 +4824,7 @@ func (x *Update_ReplacedDns) String() string {
 func (*Update_ReplacedDns) ProtoMessage() {}
 
 func (x *Update_ReplacedDns) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[45]
+	mi := &file_spec_manifest_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3678,7 +4837,7 @@ func (x *Update_ReplacedDns) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_ReplacedDns.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedDns) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 16}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 16}
 }
 
 func (x *Update_ReplacedDns) GetHandle() string {
@@ -3695,6 +4854,110 @@ func (x *Update_ReplacedDns) GetOldApiEndpoint() string {
 	return ""
 }
 
+// TerraformerReplaceDnsRecords is a message that once processed by the
+// Terraformer service and a result is send back to the Manager, it will
+// consume the message and further stages will only have a [ReplacedDnsRecords]
+// message to process.
+//
+// Only the records of the existing [Dns] are updated to point to the
+// reachable nodes of the loadbalancer, the [Dns] itself is not replaced.
+type Update_TerraformerReplaceDnsRecords struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Handle string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	// Public endpoints of the nodes to leave out of the DNS records.
+	UnreachableEndpoints []string `protobuf:"bytes,2,rep,name=unreachableEndpoints,proto3" json:"unreachableEndpoints,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Update_TerraformerReplaceDnsRecords) Reset() {
+	*x = Update_TerraformerReplaceDnsRecords{}
+	mi := &file_spec_manifest_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Update_TerraformerReplaceDnsRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Update_TerraformerReplaceDnsRecords) ProtoMessage() {}
+
+func (x *Update_TerraformerReplaceDnsRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Update_TerraformerReplaceDnsRecords.ProtoReflect.Descriptor instead.
+func (*Update_TerraformerReplaceDnsRecords) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 17}
+}
+
+func (x *Update_TerraformerReplaceDnsRecords) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
+func (x *Update_TerraformerReplaceDnsRecords) GetUnreachableEndpoints() []string {
+	if x != nil {
+		return x.UnreachableEndpoints
+	}
+	return nil
+}
+
+type Update_ReplacedDnsRecords struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Update_ReplacedDnsRecords) Reset() {
+	*x = Update_ReplacedDnsRecords{}
+	mi := &file_spec_manifest_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Update_ReplacedDnsRecords) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Update_ReplacedDnsRecords) ProtoMessage() {}
+
+func (x *Update_ReplacedDnsRecords) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_manifest_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Update_ReplacedDnsRecords.ProtoReflect.Descriptor instead.
+func (*Update_ReplacedDnsRecords) Descriptor() ([]byte, []int) {
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 18}
+}
+
+func (x *Update_ReplacedDnsRecords) GetHandle() string {
+	if x != nil {
+		return x.Handle
+	}
+	return ""
+}
+
 // DeleteLoadBalancer works with the [State] that is part of the [Update]
 // message, thus no "message consuming" needs to happen.
 type Update_DeleteLoadBalancer struct {
@@ -3710,7 +4973,7 @@ type Update_DeleteLoadBalancer struct {
 
 func (x *Update_DeleteLoadBalancer) Reset() {
 	*x = Update_DeleteLoadBalancer{}
-	mi := &file_spec_manifest_proto_msgTypes[46]
+	mi := &file_spec_manifest_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3722,7 +4985,7 @@ func (x *Update_DeleteLoadBalancer) String() string {
 func (*Update_DeleteLoadBalancer) ProtoMessage() {}
 
 func (x *Update_DeleteLoadBalancer) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[46]
+	mi := &file_spec_manifest_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3735,7 +4998,7 @@ func (x *Update_DeleteLoadBalancer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_DeleteLoadBalancer.ProtoReflect.Descriptor instead.
 func (*Update_DeleteLoadBalancer) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 17}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 19}
 }
 
 func (x *Update_DeleteLoadBalancer) GetHandle() string {
@@ -3765,7 +5028,7 @@ type Update_ApiEndpoint struct {
 
 func (x *Update_ApiEndpoint) Reset() {
 	*x = Update_ApiEndpoint{}
-	mi := &file_spec_manifest_proto_msgTypes[47]
+	mi := &file_spec_manifest_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3777,7 +5040,7 @@ func (x *Update_ApiEndpoint) String() string {
 func (*Update_ApiEndpoint) ProtoMessage() {}
 
 func (x *Update_ApiEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[47]
+	mi := &file_spec_manifest_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3790,7 +5053,7 @@ func (x *Update_ApiEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_ApiEndpoint.ProtoReflect.Descriptor instead.
 func (*Update_ApiEndpoint) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 18}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 20}
 }
 
 func (x *Update_ApiEndpoint) GetState() ApiEndpointChangeState {
@@ -3826,7 +5089,7 @@ type Update_K8SOnlyApiEndpoint struct {
 
 func (x *Update_K8SOnlyApiEndpoint) Reset() {
 	*x = Update_K8SOnlyApiEndpoint{}
-	mi := &file_spec_manifest_proto_msgTypes[48]
+	mi := &file_spec_manifest_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3838,7 +5101,7 @@ func (x *Update_K8SOnlyApiEndpoint) String() string {
 func (*Update_K8SOnlyApiEndpoint) ProtoMessage() {}
 
 func (x *Update_K8SOnlyApiEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[48]
+	mi := &file_spec_manifest_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3851,7 +5114,7 @@ func (x *Update_K8SOnlyApiEndpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_K8SOnlyApiEndpoint.ProtoReflect.Descriptor instead.
 func (*Update_K8SOnlyApiEndpoint) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 19}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 21}
 }
 
 func (x *Update_K8SOnlyApiEndpoint) GetNodepool() string {
@@ -3879,7 +5142,7 @@ type Update_ApiPortOnCluster struct {
 
 func (x *Update_ApiPortOnCluster) Reset() {
 	*x = Update_ApiPortOnCluster{}
-	mi := &file_spec_manifest_proto_msgTypes[49]
+	mi := &file_spec_manifest_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3891,7 +5154,7 @@ func (x *Update_ApiPortOnCluster) String() string {
 func (*Update_ApiPortOnCluster) ProtoMessage() {}
 
 func (x *Update_ApiPortOnCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[49]
+	mi := &file_spec_manifest_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3904,7 +5167,7 @@ func (x *Update_ApiPortOnCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_ApiPortOnCluster.ProtoReflect.Descriptor instead.
 func (*Update_ApiPortOnCluster) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 20}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 22}
 }
 
 func (x *Update_ApiPortOnCluster) GetOpen() bool {
@@ -3931,7 +5194,7 @@ type Update_AnsiblerReplaceProxySettings struct {
 
 func (x *Update_AnsiblerReplaceProxySettings) Reset() {
 	*x = Update_AnsiblerReplaceProxySettings{}
-	mi := &file_spec_manifest_proto_msgTypes[50]
+	mi := &file_spec_manifest_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3943,7 +5206,7 @@ func (x *Update_AnsiblerReplaceProxySettings) String() string {
 func (*Update_AnsiblerReplaceProxySettings) ProtoMessage() {}
 
 func (x *Update_AnsiblerReplaceProxySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[50]
+	mi := &file_spec_manifest_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3956,7 +5219,7 @@ func (x *Update_AnsiblerReplaceProxySettings) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use Update_AnsiblerReplaceProxySettings.ProtoReflect.Descriptor instead.
 func (*Update_AnsiblerReplaceProxySettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 21}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 23}
 }
 
 func (x *Update_AnsiblerReplaceProxySettings) GetProxy() *InstallationProxy {
@@ -3974,7 +5237,7 @@ type Update_ReplacedProxySettings struct {
 
 func (x *Update_ReplacedProxySettings) Reset() {
 	*x = Update_ReplacedProxySettings{}
-	mi := &file_spec_manifest_proto_msgTypes[51]
+	mi := &file_spec_manifest_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3986,7 +5249,7 @@ func (x *Update_ReplacedProxySettings) String() string {
 func (*Update_ReplacedProxySettings) ProtoMessage() {}
 
 func (x *Update_ReplacedProxySettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[51]
+	mi := &file_spec_manifest_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3999,7 +5262,7 @@ func (x *Update_ReplacedProxySettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_ReplacedProxySettings.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedProxySettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 22}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 24}
 }
 
 type Update_TerraformerReplaceRoleExternalSettings struct {
@@ -4007,16 +5270,17 @@ type Update_TerraformerReplaceRoleExternalSettings struct {
 	Handle string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
 	Role   string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 	// updated settings.
-	RoleType      RoleType `protobuf:"varint,3,opt,name=roleType,proto3,enum=spec.RoleType" json:"roleType,omitempty"`
-	Protocol      string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Port          int32    `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RoleType            RoleType `protobuf:"varint,3,opt,name=roleType,proto3,enum=spec.RoleType" json:"roleType,omitempty"`
+	Protocol            string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port                int32    `protobuf:"varint,5,opt,name=port,proto3" json:"port,omitempty"`
+	AllowedSourceRanges []string `protobuf:"bytes,6,rep,name=allowed_source_ranges,json=allowedSourceRanges,proto3" json:"allowed_source_ranges,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Update_TerraformerReplaceRoleExternalSettings) Reset() {
 	*x = Update_TerraformerReplaceRoleExternalSettings{}
-	mi := &file_spec_manifest_proto_msgTypes[52]
+	mi := &file_spec_manifest_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4028,7 +5292,7 @@ func (x *Update_TerraformerReplaceRoleExternalSettings) String() string {
 func (*Update_TerraformerReplaceRoleExternalSettings) ProtoMessage() {}
 
 func (x *Update_TerraformerReplaceRoleExternalSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[52]
+	mi := &file_spec_manifest_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4041,7 +5305,7 @@ func (x *Update_TerraformerReplaceRoleExternalSettings) ProtoReflect() protorefl
 
 // Deprecated: Use Update_TerraformerReplaceRoleExternalSettings.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerReplaceRoleExternalSettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 23}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 25}
 }
 
 func (x *Update_TerraformerReplaceRoleExternalSettings) GetHandle() string {
@@ -4079,6 +5343,13 @@ func (x *Update_TerraformerReplaceRoleExternalSettings) GetPort() int32 {
 	return 0
 }
 
+func (x *Update_TerraformerReplaceRoleExternalSettings) GetAllowedSourceRanges() []string {
+	if x != nil {
+		return x.AllowedSourceRanges
+	}
+	return nil
+}
+
 type Update_ReplacedRoleExternalSettings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Handle        string                 `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
@@ -4089,7 +5360,7 @@ type Update_ReplacedRoleExternalSettings struct {
 
 func (x *Update_ReplacedRoleExternalSettings) Reset() {
 	*x = Update_ReplacedRoleExternalSettings{}
-	mi := &file_spec_manifest_proto_msgTypes[53]
+	mi := &file_spec_manifest_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4101,7 +5372,7 @@ func (x *Update_ReplacedRoleExternalSettings) String() string {
 func (*Update_ReplacedRoleExternalSettings) ProtoMessage() {}
 
 func (x *Update_ReplacedRoleExternalSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[53]
+	mi := &file_spec_manifest_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4114,7 +5385,7 @@ func (x *Update_ReplacedRoleExternalSettings) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use Update_ReplacedRoleExternalSettings.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedRoleExternalSettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 24}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 26}
 }
 
 func (x *Update_ReplacedRoleExternalSettings) GetHandle() string {
@@ -4144,7 +5415,7 @@ type Update_AnsiblerReplaceRoleInternalSettings struct {
 
 func (x *Update_AnsiblerReplaceRoleInternalSettings) Reset() {
 	*x = Update_AnsiblerReplaceRoleInternalSettings{}
-	mi := &file_spec_manifest_proto_msgTypes[54]
+	mi := &file_spec_manifest_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4156,7 +5427,7 @@ func (x *Update_AnsiblerReplaceRoleInternalSettings) String() string {
 func (*Update_AnsiblerReplaceRoleInternalSettings) ProtoMessage() {}
 
 func (x *Update_AnsiblerReplaceRoleInternalSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[54]
+	mi := &file_spec_manifest_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4169,7 +5440,7 @@ func (x *Update_AnsiblerReplaceRoleInternalSettings) ProtoReflect() protoreflect
 
 // Deprecated: Use Update_AnsiblerReplaceRoleInternalSettings.ProtoReflect.Descriptor instead.
 func (*Update_AnsiblerReplaceRoleInternalSettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 25}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 27}
 }
 
 func (x *Update_AnsiblerReplaceRoleInternalSettings) GetHandle() string {
@@ -4210,7 +5481,7 @@ type Update_ReplacedRoleInternalSettings struct {
 
 func (x *Update_ReplacedRoleInternalSettings) Reset() {
 	*x = Update_ReplacedRoleInternalSettings{}
-	mi := &file_spec_manifest_proto_msgTypes[55]
+	mi := &file_spec_manifest_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4222,7 +5493,7 @@ func (x *Update_ReplacedRoleInternalSettings) String() string {
 func (*Update_ReplacedRoleInternalSettings) ProtoMessage() {}
 
 func (x *Update_ReplacedRoleInternalSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[55]
+	mi := &file_spec_manifest_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,7 +5506,7 @@ func (x *Update_ReplacedRoleInternalSettings) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use Update_ReplacedRoleInternalSettings.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedRoleInternalSettings) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 26}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 28}
 }
 
 func (x *Update_ReplacedRoleInternalSettings) GetHandle() string {
@@ -4271,7 +5542,7 @@ type Update_AnsiblerReplaceTargetPools struct {
 
 func (x *Update_AnsiblerReplaceTargetPools) Reset() {
 	*x = Update_AnsiblerReplaceTargetPools{}
-	mi := &file_spec_manifest_proto_msgTypes[56]
+	mi := &file_spec_manifest_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4283,7 +5554,7 @@ func (x *Update_AnsiblerReplaceTargetPools) String() string {
 func (*Update_AnsiblerReplaceTargetPools) ProtoMessage() {}
 
 func (x *Update_AnsiblerReplaceTargetPools) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[56]
+	mi := &file_spec_manifest_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4296,7 +5567,7 @@ func (x *Update_AnsiblerReplaceTargetPools) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_AnsiblerReplaceTargetPools.ProtoReflect.Descriptor instead.
 func (*Update_AnsiblerReplaceTargetPools) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 27}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 29}
 }
 
 func (x *Update_AnsiblerReplaceTargetPools) GetHandle() string {
@@ -4324,7 +5595,7 @@ type Update_ReplacedTargetPools struct {
 
 func (x *Update_ReplacedTargetPools) Reset() {
 	*x = Update_ReplacedTargetPools{}
-	mi := &file_spec_manifest_proto_msgTypes[57]
+	mi := &file_spec_manifest_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4336,7 +5607,7 @@ func (x *Update_ReplacedTargetPools) String() string {
 func (*Update_ReplacedTargetPools) ProtoMessage() {}
 
 func (x *Update_ReplacedTargetPools) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[57]
+	mi := &file_spec_manifest_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4349,7 +5620,7 @@ func (x *Update_ReplacedTargetPools) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_ReplacedTargetPools.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedTargetPools) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 28}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 30}
 }
 
 func (x *Update_ReplacedTargetPools) GetHandle() string {
@@ -4377,7 +5648,7 @@ type Update_UpgradeVersion struct {
 
 func (x *Update_UpgradeVersion) Reset() {
 	*x = Update_UpgradeVersion{}
-	mi := &file_spec_manifest_proto_msgTypes[58]
+	mi := &file_spec_manifest_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4389,7 +5660,7 @@ func (x *Update_UpgradeVersion) String() string {
 func (*Update_UpgradeVersion) ProtoMessage() {}
 
 func (x *Update_UpgradeVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[58]
+	mi := &file_spec_manifest_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,7 +5673,7 @@ func (x *Update_UpgradeVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_UpgradeVersion.ProtoReflect.Descriptor instead.
 func (*Update_UpgradeVersion) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 29}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 31}
 }
 
 func (x *Update_UpgradeVersion) GetVersion() string {
@@ -4429,7 +5700,7 @@ type Update_KuberPatchNodes struct {
 
 func (x *Update_KuberPatchNodes) Reset() {
 	*x = Update_KuberPatchNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[59]
+	mi := &file_spec_manifest_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4441,7 +5712,7 @@ func (x *Update_KuberPatchNodes) String() string {
 func (*Update_KuberPatchNodes) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[59]
+	mi := &file_spec_manifest_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4454,7 +5725,7 @@ func (x *Update_KuberPatchNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_KuberPatchNodes.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32}
 }
 
 func (x *Update_KuberPatchNodes) GetAdd() *Update_KuberPatchNodes_AddBatch {
@@ -4479,7 +5750,7 @@ type Update_PatchedNodes struct {
 
 func (x *Update_PatchedNodes) Reset() {
 	*x = Update_PatchedNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[60]
+	mi := &file_spec_manifest_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4491,7 +5762,7 @@ func (x *Update_PatchedNodes) String() string {
 func (*Update_PatchedNodes) ProtoMessage() {}
 
 func (x *Update_PatchedNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[60]
+	mi := &file_spec_manifest_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4504,7 +5775,7 @@ func (x *Update_PatchedNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_PatchedNodes.ProtoReflect.Descriptor instead.
 func (*Update_PatchedNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 31}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 33}
 }
 
 // KuberDeleteK8sNodes is a message that is processed by the Kuber service
@@ -4542,7 +5813,7 @@ type Update_KuberDeleteK8SNodes struct {
 
 func (x *Update_KuberDeleteK8SNodes) Reset() {
 	*x = Update_KuberDeleteK8SNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[61]
+	mi := &file_spec_manifest_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4554,7 +5825,7 @@ func (x *Update_KuberDeleteK8SNodes) String() string {
 func (*Update_KuberDeleteK8SNodes) ProtoMessage() {}
 
 func (x *Update_KuberDeleteK8SNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[61]
+	mi := &file_spec_manifest_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4567,7 +5838,7 @@ func (x *Update_KuberDeleteK8SNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_KuberDeleteK8SNodes.ProtoReflect.Descriptor instead.
 func (*Update_KuberDeleteK8SNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 32}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 34}
 }
 
 func (x *Update_KuberDeleteK8SNodes) GetWithNodePool() bool {
@@ -4615,7 +5886,7 @@ type Update_DeletedK8SNodes struct {
 
 func (x *Update_DeletedK8SNodes) Reset() {
 	*x = Update_DeletedK8SNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[62]
+	mi := &file_spec_manifest_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4627,7 +5898,7 @@ func (x *Update_DeletedK8SNodes) String() string {
 func (*Update_DeletedK8SNodes) ProtoMessage() {}
 
 func (x *Update_DeletedK8SNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[62]
+	mi := &file_spec_manifest_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4640,7 +5911,7 @@ func (x *Update_DeletedK8SNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_DeletedK8SNodes.ProtoReflect.Descriptor instead.
 func (*Update_DeletedK8SNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 33}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 35}
 }
 
 func (x *Update_DeletedK8SNodes) GetUnreachable() *Unreachable {
@@ -4711,7 +5982,7 @@ type Update_TerraformerAddK8SNodes struct {
 
 func (x *Update_TerraformerAddK8SNodes) Reset() {
 	*x = Update_TerraformerAddK8SNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[63]
+	mi := &file_spec_manifest_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4723,7 +5994,7 @@ func (x *Update_TerraformerAddK8SNodes) String() string {
 func (*Update_TerraformerAddK8SNodes) ProtoMessage() {}
 
 func (x *Update_TerraformerAddK8SNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[63]
+	mi := &file_spec_manifest_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4736,7 +6007,7 @@ func (x *Update_TerraformerAddK8SNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_TerraformerAddK8SNodes.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddK8SNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 34}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 36}
 }
 
 func (x *Update_TerraformerAddK8SNodes) GetKind() isUpdate_TerraformerAddK8SNodes_Kind {
@@ -4791,7 +6062,7 @@ type Update_AddedK8SNodes struct {
 
 func (x *Update_AddedK8SNodes) Reset() {
 	*x = Update_AddedK8SNodes{}
-	mi := &file_spec_manifest_proto_msgTypes[64]
+	mi := &file_spec_manifest_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4803,7 +6074,7 @@ func (x *Update_AddedK8SNodes) String() string {
 func (*Update_AddedK8SNodes) ProtoMessage() {}
 
 func (x *Update_AddedK8SNodes) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[64]
+	mi := &file_spec_manifest_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4816,7 +6087,7 @@ func (x *Update_AddedK8SNodes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_AddedK8SNodes.ProtoReflect.Descriptor instead.
 func (*Update_AddedK8SNodes) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 35}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 37}
 }
 
 func (x *Update_AddedK8SNodes) GetNewNodePool() bool {
@@ -4851,7 +6122,7 @@ type Update_DeletedLoadBalancerNodes_WholeNodePool struct {
 
 func (x *Update_DeletedLoadBalancerNodes_WholeNodePool) Reset() {
 	*x = Update_DeletedLoadBalancerNodes_WholeNodePool{}
-	mi := &file_spec_manifest_proto_msgTypes[65]
+	mi := &file_spec_manifest_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4863,7 +6134,7 @@ func (x *Update_DeletedLoadBalancerNodes_WholeNodePool) String() string {
 func (*Update_DeletedLoadBalancerNodes_WholeNodePool) ProtoMessage() {}
 
 func (x *Update_DeletedLoadBalancerNodes_WholeNodePool) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[65]
+	mi := &file_spec_manifest_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4876,7 +6147,7 @@ func (x *Update_DeletedLoadBalancerNodes_WholeNodePool) ProtoReflect() protorefl
 
 // Deprecated: Use Update_DeletedLoadBalancerNodes_WholeNodePool.ProtoReflect.Descriptor instead.
 func (*Update_DeletedLoadBalancerNodes_WholeNodePool) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 9, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 9, 0}
 }
 
 func (x *Update_DeletedLoadBalancerNodes_WholeNodePool) GetNodepool() *NodePool {
@@ -4906,7 +6177,7 @@ type Update_DeletedLoadBalancerNodes_Partial struct {
 
 func (x *Update_DeletedLoadBalancerNodes_Partial) Reset() {
 	*x = Update_DeletedLoadBalancerNodes_Partial{}
-	mi := &file_spec_manifest_proto_msgTypes[66]
+	mi := &file_spec_manifest_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4918,7 +6189,7 @@ func (x *Update_DeletedLoadBalancerNodes_Partial) String() string {
 func (*Update_DeletedLoadBalancerNodes_Partial) ProtoMessage() {}
 
 func (x *Update_DeletedLoadBalancerNodes_Partial) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[66]
+	mi := &file_spec_manifest_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4931,7 +6202,7 @@ func (x *Update_DeletedLoadBalancerNodes_Partial) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use Update_DeletedLoadBalancerNodes_Partial.ProtoReflect.Descriptor instead.
 func (*Update_DeletedLoadBalancerNodes_Partial) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 9, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 9, 1}
 }
 
 func (x *Update_DeletedLoadBalancerNodes_Partial) GetNodepool() string {
@@ -4969,7 +6240,7 @@ type Update_TerraformerAddLoadBalancerNodes_Existing struct {
 
 func (x *Update_TerraformerAddLoadBalancerNodes_Existing) Reset() {
 	*x = Update_TerraformerAddLoadBalancerNodes_Existing{}
-	mi := &file_spec_manifest_proto_msgTypes[68]
+	mi := &file_spec_manifest_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4981,7 +6252,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes_Existing) String() string {
 func (*Update_TerraformerAddLoadBalancerNodes_Existing) ProtoMessage() {}
 
 func (x *Update_TerraformerAddLoadBalancerNodes_Existing) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[68]
+	mi := &file_spec_manifest_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4994,7 +6265,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes_Existing) ProtoReflect() protore
 
 // Deprecated: Use Update_TerraformerAddLoadBalancerNodes_Existing.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddLoadBalancerNodes_Existing) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 10, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 10, 0}
 }
 
 func (x *Update_TerraformerAddLoadBalancerNodes_Existing) GetNodepool() string {
@@ -5022,7 +6293,7 @@ type Update_TerraformerAddLoadBalancerNodes_New struct {
 
 func (x *Update_TerraformerAddLoadBalancerNodes_New) Reset() {
 	*x = Update_TerraformerAddLoadBalancerNodes_New{}
-	mi := &file_spec_manifest_proto_msgTypes[69]
+	mi := &file_spec_manifest_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5034,7 +6305,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes_New) String() string {
 func (*Update_TerraformerAddLoadBalancerNodes_New) ProtoMessage() {}
 
 func (x *Update_TerraformerAddLoadBalancerNodes_New) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[69]
+	mi := &file_spec_manifest_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5047,7 +6318,7 @@ func (x *Update_TerraformerAddLoadBalancerNodes_New) ProtoReflect() protoreflect
 
 // Deprecated: Use Update_TerraformerAddLoadBalancerNodes_New.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddLoadBalancerNodes_New) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 10, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 10, 1}
 }
 
 func (x *Update_TerraformerAddLoadBalancerNodes_New) GetNodepool() *NodePool {
@@ -5060,13 +6331,14 @@ func (x *Update_TerraformerAddLoadBalancerNodes_New) GetNodepool() *NodePool {
 type Update_AnsiblerReplaceTargetPools_TargetPools struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pools         []string               `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Routes        []*Role_Route          `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Update_AnsiblerReplaceTargetPools_TargetPools) Reset() {
 	*x = Update_AnsiblerReplaceTargetPools_TargetPools{}
-	mi := &file_spec_manifest_proto_msgTypes[70]
+	mi := &file_spec_manifest_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5078,7 +6350,7 @@ func (x *Update_AnsiblerReplaceTargetPools_TargetPools) String() string {
 func (*Update_AnsiblerReplaceTargetPools_TargetPools) ProtoMessage() {}
 
 func (x *Update_AnsiblerReplaceTargetPools_TargetPools) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[70]
+	mi := &file_spec_manifest_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5091,7 +6363,7 @@ func (x *Update_AnsiblerReplaceTargetPools_TargetPools) ProtoReflect() protorefl
 
 // Deprecated: Use Update_AnsiblerReplaceTargetPools_TargetPools.ProtoReflect.Descriptor instead.
 func (*Update_AnsiblerReplaceTargetPools_TargetPools) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 27, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 29, 0}
 }
 
 func (x *Update_AnsiblerReplaceTargetPools_TargetPools) GetPools() []string {
@@ -5101,16 +6373,24 @@ func (x *Update_AnsiblerReplaceTargetPools_TargetPools) GetPools() []string {
 	return nil
 }
 
+func (x *Update_AnsiblerReplaceTargetPools_TargetPools) GetRoutes() []*Role_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
 type Update_ReplacedTargetPools_TargetPools struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pools         []string               `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	Routes        []*Role_Route          `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Update_ReplacedTargetPools_TargetPools) Reset() {
 	*x = Update_ReplacedTargetPools_TargetPools{}
-	mi := &file_spec_manifest_proto_msgTypes[72]
+	mi := &file_spec_manifest_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5122,7 +6402,7 @@ func (x *Update_ReplacedTargetPools_TargetPools) String() string {
 func (*Update_ReplacedTargetPools_TargetPools) ProtoMessage() {}
 
 func (x *Update_ReplacedTargetPools_TargetPools) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[72]
+	mi := &file_spec_manifest_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5135,7 +6415,7 @@ func (x *Update_ReplacedTargetPools_TargetPools) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use Update_ReplacedTargetPools_TargetPools.ProtoReflect.Descriptor instead.
 func (*Update_ReplacedTargetPools_TargetPools) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 28, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 30, 0}
 }
 
 func (x *Update_ReplacedTargetPools_TargetPools) GetPools() []string {
@@ -5145,6 +6425,13 @@ func (x *Update_ReplacedTargetPools_TargetPools) GetPools() []string {
 	return nil
 }
 
+func (x *Update_ReplacedTargetPools_TargetPools) GetRoutes() []*Role_Route {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
 type Update_KuberPatchNodes_ListOfTaints struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Taints        []*Taint               `protobuf:"bytes,1,rep,name=taints,proto3" json:"taints,omitempty"`
@@ -5154,7 +6441,7 @@ type Update_KuberPatchNodes_ListOfTaints struct {
 
 func (x *Update_KuberPatchNodes_ListOfTaints) Reset() {
 	*x = Update_KuberPatchNodes_ListOfTaints{}
-	mi := &file_spec_manifest_proto_msgTypes[74]
+	mi := &file_spec_manifest_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5166,7 +6453,7 @@ func (x *Update_KuberPatchNodes_ListOfTaints) String() string {
 func (*Update_KuberPatchNodes_ListOfTaints) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_ListOfTaints) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[74]
+	mi := &file_spec_manifest_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5179,7 +6466,7 @@ func (x *Update_KuberPatchNodes_ListOfTaints) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use Update_KuberPatchNodes_ListOfTaints.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_ListOfTaints) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 0}
 }
 
 func (x *Update_KuberPatchNodes_ListOfTaints) GetTaints() []*Taint {
@@ -5198,7 +6485,7 @@ type Update_KuberPatchNodes_ListOfLabelKeys struct {
 
 func (x *Update_KuberPatchNodes_ListOfLabelKeys) Reset() {
 	*x = Update_KuberPatchNodes_ListOfLabelKeys{}
-	mi := &file_spec_manifest_proto_msgTypes[75]
+	mi := &file_spec_manifest_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5210,7 +6497,7 @@ func (x *Update_KuberPatchNodes_ListOfLabelKeys) String() string {
 func (*Update_KuberPatchNodes_ListOfLabelKeys) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_ListOfLabelKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[75]
+	mi := &file_spec_manifest_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5223,7 +6510,7 @@ func (x *Update_KuberPatchNodes_ListOfLabelKeys) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use Update_KuberPatchNodes_ListOfLabelKeys.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_ListOfLabelKeys) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 1}
 }
 
 func (x *Update_KuberPatchNodes_ListOfLabelKeys) GetLabels() []string {
@@ -5242,7 +6529,7 @@ type Update_KuberPatchNodes_ListOfAnnotationKeys struct {
 
 func (x *Update_KuberPatchNodes_ListOfAnnotationKeys) Reset() {
 	*x = Update_KuberPatchNodes_ListOfAnnotationKeys{}
-	mi := &file_spec_manifest_proto_msgTypes[76]
+	mi := &file_spec_manifest_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5254,7 +6541,7 @@ func (x *Update_KuberPatchNodes_ListOfAnnotationKeys) String() string {
 func (*Update_KuberPatchNodes_ListOfAnnotationKeys) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_ListOfAnnotationKeys) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[76]
+	mi := &file_spec_manifest_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5267,7 +6554,7 @@ func (x *Update_KuberPatchNodes_ListOfAnnotationKeys) ProtoReflect() protoreflec
 
 // Deprecated: Use Update_KuberPatchNodes_ListOfAnnotationKeys.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_ListOfAnnotationKeys) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 2}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 2}
 }
 
 func (x *Update_KuberPatchNodes_ListOfAnnotationKeys) GetAnnotations() []string {
@@ -5286,7 +6573,7 @@ type Update_KuberPatchNodes_MapOfLabels struct {
 
 func (x *Update_KuberPatchNodes_MapOfLabels) Reset() {
 	*x = Update_KuberPatchNodes_MapOfLabels{}
-	mi := &file_spec_manifest_proto_msgTypes[77]
+	mi := &file_spec_manifest_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5298,7 +6585,7 @@ func (x *Update_KuberPatchNodes_MapOfLabels) String() string {
 func (*Update_KuberPatchNodes_MapOfLabels) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_MapOfLabels) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[77]
+	mi := &file_spec_manifest_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5311,7 +6598,7 @@ func (x *Update_KuberPatchNodes_MapOfLabels) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_KuberPatchNodes_MapOfLabels.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_MapOfLabels) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 3}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 3}
 }
 
 func (x *Update_KuberPatchNodes_MapOfLabels) GetLabels() map[string]string {
@@ -5330,7 +6617,7 @@ type Update_KuberPatchNodes_MapOfAnnotations struct {
 
 func (x *Update_KuberPatchNodes_MapOfAnnotations) Reset() {
 	*x = Update_KuberPatchNodes_MapOfAnnotations{}
-	mi := &file_spec_manifest_proto_msgTypes[78]
+	mi := &file_spec_manifest_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5342,7 +6629,7 @@ func (x *Update_KuberPatchNodes_MapOfAnnotations) String() string {
 func (*Update_KuberPatchNodes_MapOfAnnotations) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_MapOfAnnotations) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[78]
+	mi := &file_spec_manifest_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5355,7 +6642,7 @@ func (x *Update_KuberPatchNodes_MapOfAnnotations) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use Update_KuberPatchNodes_MapOfAnnotations.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_MapOfAnnotations) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 4}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 4}
 }
 
 func (x *Update_KuberPatchNodes_MapOfAnnotations) GetAnnotations() map[string]string {
@@ -5376,7 +6663,7 @@ type Update_KuberPatchNodes_RemoveBatch struct {
 
 func (x *Update_KuberPatchNodes_RemoveBatch) Reset() {
 	*x = Update_KuberPatchNodes_RemoveBatch{}
-	mi := &file_spec_manifest_proto_msgTypes[79]
+	mi := &file_spec_manifest_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5388,7 +6675,7 @@ func (x *Update_KuberPatchNodes_RemoveBatch) String() string {
 func (*Update_KuberPatchNodes_RemoveBatch) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_RemoveBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[79]
+	mi := &file_spec_manifest_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5401,7 +6688,7 @@ func (x *Update_KuberPatchNodes_RemoveBatch) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_KuberPatchNodes_RemoveBatch.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_RemoveBatch) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 5}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 5}
 }
 
 func (x *Update_KuberPatchNodes_RemoveBatch) GetTaints() map[string]*Update_KuberPatchNodes_ListOfTaints {
@@ -5436,7 +6723,7 @@ type Update_KuberPatchNodes_AddBatch struct {
 
 func (x *Update_KuberPatchNodes_AddBatch) Reset() {
 	*x = Update_KuberPatchNodes_AddBatch{}
-	mi := &file_spec_manifest_proto_msgTypes[80]
+	mi := &file_spec_manifest_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5448,7 +6735,7 @@ func (x *Update_KuberPatchNodes_AddBatch) String() string {
 func (*Update_KuberPatchNodes_AddBatch) ProtoMessage() {}
 
 func (x *Update_KuberPatchNodes_AddBatch) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[80]
+	mi := &file_spec_manifest_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5461,7 +6748,7 @@ func (x *Update_KuberPatchNodes_AddBatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_KuberPatchNodes_AddBatch.ProtoReflect.Descriptor instead.
 func (*Update_KuberPatchNodes_AddBatch) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 30, 6}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 32, 6}
 }
 
 func (x *Update_KuberPatchNodes_AddBatch) GetTaints() map[string]*Update_KuberPatchNodes_ListOfTaints {
@@ -5496,7 +6783,7 @@ type Update_DeletedK8SNodes_WholeNodePool struct {
 
 func (x *Update_DeletedK8SNodes_WholeNodePool) Reset() {
 	*x = Update_DeletedK8SNodes_WholeNodePool{}
-	mi := &file_spec_manifest_proto_msgTypes[89]
+	mi := &file_spec_manifest_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5508,7 +6795,7 @@ func (x *Update_DeletedK8SNodes_WholeNodePool) String() string {
 func (*Update_DeletedK8SNodes_WholeNodePool) ProtoMessage() {}
 
 func (x *Update_DeletedK8SNodes_WholeNodePool) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[89]
+	mi := &file_spec_manifest_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5521,7 +6808,7 @@ func (x *Update_DeletedK8SNodes_WholeNodePool) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use Update_DeletedK8SNodes_WholeNodePool.ProtoReflect.Descriptor instead.
 func (*Update_DeletedK8SNodes_WholeNodePool) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 33, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 35, 0}
 }
 
 func (x *Update_DeletedK8SNodes_WholeNodePool) GetNodepool() *NodePool {
@@ -5551,7 +6838,7 @@ type Update_DeletedK8SNodes_Partial struct {
 
 func (x *Update_DeletedK8SNodes_Partial) Reset() {
 	*x = Update_DeletedK8SNodes_Partial{}
-	mi := &file_spec_manifest_proto_msgTypes[90]
+	mi := &file_spec_manifest_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5563,7 +6850,7 @@ func (x *Update_DeletedK8SNodes_Partial) String() string {
 func (*Update_DeletedK8SNodes_Partial) ProtoMessage() {}
 
 func (x *Update_DeletedK8SNodes_Partial) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[90]
+	mi := &file_spec_manifest_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5576,7 +6863,7 @@ func (x *Update_DeletedK8SNodes_Partial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Update_DeletedK8SNodes_Partial.ProtoReflect.Descriptor instead.
 func (*Update_DeletedK8SNodes_Partial) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 33, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 35, 1}
 }
 
 func (x *Update_DeletedK8SNodes_Partial) GetNodepool() string {
@@ -5614,7 +6901,7 @@ type Update_TerraformerAddK8SNodes_Existing struct {
 
 func (x *Update_TerraformerAddK8SNodes_Existing) Reset() {
 	*x = Update_TerraformerAddK8SNodes_Existing{}
-	mi := &file_spec_manifest_proto_msgTypes[92]
+	mi := &file_spec_manifest_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5626,7 +6913,7 @@ func (x *Update_TerraformerAddK8SNodes_Existing) String() string {
 func (*Update_TerraformerAddK8SNodes_Existing) ProtoMessage() {}
 
 func (x *Update_TerraformerAddK8SNodes_Existing) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[92]
+	mi := &file_spec_manifest_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5639,7 +6926,7 @@ func (x *Update_TerraformerAddK8SNodes_Existing) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use Update_TerraformerAddK8SNodes_Existing.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddK8SNodes_Existing) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 34, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 36, 0}
 }
 
 func (x *Update_TerraformerAddK8SNodes_Existing) GetNodepool() string {
@@ -5667,7 +6954,7 @@ type Update_TerraformerAddK8SNodes_New struct {
 
 func (x *Update_TerraformerAddK8SNodes_New) Reset() {
 	*x = Update_TerraformerAddK8SNodes_New{}
-	mi := &file_spec_manifest_proto_msgTypes[93]
+	mi := &file_spec_manifest_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5679,7 +6966,7 @@ func (x *Update_TerraformerAddK8SNodes_New) String() string {
 func (*Update_TerraformerAddK8SNodes_New) ProtoMessage() {}
 
 func (x *Update_TerraformerAddK8SNodes_New) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[93]
+	mi := &file_spec_manifest_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5692,7 +6979,7 @@ func (x *Update_TerraformerAddK8SNodes_New) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Update_TerraformerAddK8SNodes_New.ProtoReflect.Descriptor instead.
 func (*Update_TerraformerAddK8SNodes_New) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{17, 34, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{23, 36, 1}
 }
 
 func (x *Update_TerraformerAddK8SNodes_New) GetNodepool() *NodePool {
@@ -5712,7 +6999,7 @@ type TaskResult_Error struct {
 
 func (x *TaskResult_Error) Reset() {
 	*x = TaskResult_Error{}
-	mi := &file_spec_manifest_proto_msgTypes[94]
+	mi := &file_spec_manifest_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5724,7 +7011,7 @@ func (x *TaskResult_Error) String() string {
 func (*TaskResult_Error) ProtoMessage() {}
 
 func (x *TaskResult_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[94]
+	mi := &file_spec_manifest_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5737,7 +7024,7 @@ func (x *TaskResult_Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult_Error.ProtoReflect.Descriptor instead.
 func (*TaskResult_Error) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 0}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *TaskResult_Error) GetKind() TaskResult_Error_Kind {
@@ -5765,7 +7052,7 @@ type TaskResult_None struct {
 
 func (x *TaskResult_None) Reset() {
 	*x = TaskResult_None{}
-	mi := &file_spec_manifest_proto_msgTypes[95]
+	mi := &file_spec_manifest_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5777,7 +7064,7 @@ func (x *TaskResult_None) String() string {
 func (*TaskResult_None) ProtoMessage() {}
 
 func (x *TaskResult_None) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[95]
+	mi := &file_spec_manifest_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5790,7 +7077,7 @@ func (x *TaskResult_None) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult_None.ProtoReflect.Descriptor instead.
 func (*TaskResult_None) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 1}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27, 1}
 }
 
 // UpdateState specifies the current state should be updated
@@ -5816,7 +7103,7 @@ type TaskResult_UpdateState struct {
 
 func (x *TaskResult_UpdateState) Reset() {
 	*x = TaskResult_UpdateState{}
-	mi := &file_spec_manifest_proto_msgTypes[96]
+	mi := &file_spec_manifest_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5828,7 +7115,7 @@ func (x *TaskResult_UpdateState) String() string {
 func (*TaskResult_UpdateState) ProtoMessage() {}
 
 func (x *TaskResult_UpdateState) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[96]
+	mi := &file_spec_manifest_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5841,7 +7128,7 @@ func (x *TaskResult_UpdateState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult_UpdateState.ProtoReflect.Descriptor instead.
 func (*TaskResult_UpdateState) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 2}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27, 2}
 }
 
 func (x *TaskResult_UpdateState) GetK8S() *K8Scluster {
@@ -5874,7 +7161,7 @@ type TaskResult_ClearState struct {
 
 func (x *TaskResult_ClearState) Reset() {
 	*x = TaskResult_ClearState{}
-	mi := &file_spec_manifest_proto_msgTypes[97]
+	mi := &file_spec_manifest_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5886,7 +7173,7 @@ func (x *TaskResult_ClearState) String() string {
 func (*TaskResult_ClearState) ProtoMessage() {}
 
 func (x *TaskResult_ClearState) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_manifest_proto_msgTypes[97]
+	mi := &file_spec_manifest_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5899,7 +7186,7 @@ func (x *TaskResult_ClearState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskResult_ClearState.ProtoReflect.Descriptor instead.
 func (*TaskResult_ClearState) Descriptor() ([]byte, []int) {
-	return file_spec_manifest_proto_rawDescGZIP(), []int{21, 3}
+	return file_spec_manifest_proto_rawDescGZIP(), []int{27, 3}
 }
 
 func (x *TaskResult_ClearState) GetK8S() bool {
@@ -5945,12 +7232,17 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x18k8sNodePoolScaleUpFailed\x18\x01 \x03(\v2,.spec.Counters.K8sNodePoolScaleUpFailedEntryR\x18k8sNodePoolScaleUpFailed\x1aK\n" +
 	"\x1dK8sNodePoolScaleUpFailedEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xb7\x01\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xfb\x01\n" +
 	"\fClusterState\x12(\n" +
 	"\acurrent\x18\x01 \x01(\v2\x0e.spec.ClustersR\acurrent\x12$\n" +
 	"\x05state\x18\x04 \x01(\v2\x0e.spec.WorkflowR\x05state\x12+\n" +
 	"\binFlight\x18\x05 \x01(\v2\x0f.spec.TaskEventR\binFlight\x12*\n" +
-	"\bcounters\x18\x06 \x01(\v2\x0e.spec.CountersR\bcounters\"i\n" +
+	"\bcounters\x18\x06 \x01(\v2\x0e.spec.CountersR\bcounters\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06paused\x12*\n" +
+	"\brollback\x18\b \x01(\v2\x0e.spec.RollbackR\brollback\">\n" +
+	"\bRollback\x12\x16\n" +
+	"\x06taskId\x18\x01 \x01(\tR\x06taskId\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\fR\bchecksum\"i\n" +
 	"\bClusters\x12\"\n" +
 	"\x03k8s\x18\x01 \x01(\v2\x10.spec.K8sclusterR\x03k8s\x129\n" +
 	"\rloadBalancers\x18\x02 \x01(\v2\x13.spec.LoadBalancersR\rloadBalancers\"<\n" +
@@ -5963,18 +7255,21 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\x0e2\x15.spec.Workflow.StatusR\x06status\x12(\n" +
 	"\x0ftaskDescription\x18\x02 \x01(\tR\x0ftaskDescription\x12\x14\n" +
 	"\x05stage\x18\x03 \x01(\tR\x05stage\x128\n" +
-	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x82\x02\n" +
+	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xaa\x02\n" +
 	"\bWorkflow\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.spec.Workflow.StatusR\x06status\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x122\n" +
 	"\bprevious\x18\t \x03(\v2\x16.spec.FinishedWorkflowR\bprevious\x12,\n" +
 	"\x11ticksUntilRefresh\x18\n" +
-	" \x01(\x05R\x11ticksUntilRefresh\"C\n" +
+	" \x01(\x05R\x11ticksUntilRefresh\x12\x1a\n" +
+	"\bdeferred\x18\v \x01(\tR\bdeferred\"O\n" +
 	"\x06Status\x12\b\n" +
 	"\x04DONE\x10\x00\x12\t\n" +
 	"\x05ERROR\x10\x01\x12\x0f\n" +
 	"\vIN_PROGRESS\x10\x02\x12\x13\n" +
-	"\x0fWAIT_FOR_PICKUP\x10\x03\"\xe2\x01\n" +
+	"\x0fWAIT_FOR_PICKUP\x10\x03\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x04\"\xfc\x02\n" +
 	"\n" +
 	"K8scluster\x123\n" +
 	"\vclusterInfo\x18\x01 \x01(\v2\x11.spec.ClusterInfoR\vclusterInfo\x12\x18\n" +
@@ -5985,14 +7280,44 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\n" +
 	"kubernetes\x18\x04 \x01(\tR\n" +
 	"kubernetes\x12E\n" +
-	"\x11installationProxy\x18\x05 \x01(\v2\x17.spec.InstallationProxyR\x11installationProxy\"\xcb\x01\n" +
+	"\x11installationProxy\x18\x05 \x01(\v2\x17.spec.InstallationProxyR\x11installationProxy\x12G\n" +
+	"\x12maintenanceWindows\x18\x06 \x03(\v2\x17.spec.MaintenanceWindowR\x12maintenanceWindows\x12 \n" +
+	"\vnetworkIPv6\x18\a \x01(\tR\vnetworkIPv6\x12-\n" +
+	"\tnodeHooks\x18\b \x01(\v2\x0f.spec.NodeHooksR\tnodeHooks\"c\n" +
+	"\tNodeHooks\x12*\n" +
+	"\bpreDrain\x18\x01 \x01(\v2\x0e.spec.NodeHookR\bpreDrain\x12*\n" +
+	"\bpostJoin\x18\x02 \x01(\v2\x0e.spec.NodeHookR\bpostJoin\"6\n" +
+	"\bNodeHook\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x18\n" +
+	"\atimeout\x18\x02 \x01(\tR\atimeout\"\xc7\x02\n" +
 	"\tLBcluster\x123\n" +
 	"\vclusterInfo\x18\x01 \x01(\v2\x11.spec.ClusterInfoR\vclusterInfo\x12 \n" +
 	"\x05roles\x18\x02 \x03(\v2\n" +
 	".spec.RoleR\x05roles\x12\x1b\n" +
 	"\x03dns\x18\x03 \x01(\v2\t.spec.DNSR\x03dns\x12 \n" +
 	"\vtargetedK8s\x18\x04 \x01(\tR\vtargetedK8s\x12(\n" +
-	"\x0fusedApiEndpoint\x18\x05 \x01(\bR\x0fusedApiEndpoint\"c\n" +
+	"\x0fusedApiEndpoint\x18\x05 \x01(\bR\x0fusedApiEndpoint\x12K\n" +
+	"\x13serviceLoadBalancer\x18\x06 \x01(\v2\x19.spec.ServiceLoadBalancerR\x13serviceLoadBalancer\x12-\n" +
+	"\tvirtualIP\x18\a \x01(\v2\x0f.spec.VirtualIPR\tvirtualIP\"c\n" +
+	"\tVirtualIP\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x1c\n" +
+	"\tinterface\x18\x02 \x01(\tR\tinterface\x12(\n" +
+	"\x0fvirtualRouterId\x18\x03 \x01(\x05R\x0fvirtualRouterId\"\x9b\x03\n" +
+	"\x13ServiceLoadBalancer\x12\x18\n" +
+	"\aminPort\x18\x01 \x01(\x05R\aminPort\x12\x18\n" +
+	"\amaxPort\x18\x02 \x01(\x05R\amaxPort\x12 \n" +
+	"\vtargetPools\x18\x03 \x03(\tR\vtargetPools\x12=\n" +
+	"\bservices\x18\x04 \x03(\v2!.spec.ServiceLoadBalancer.ServiceR\bservices\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x1af\n" +
+	"\x04Port\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1a\n" +
+	"\bnodePort\x18\x04 \x01(\x05R\bnodePort\x1aq\n" +
+	"\aService\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x124\n" +
+	"\x05ports\x18\x03 \x03(\v2\x1e.spec.ServiceLoadBalancer.PortR\x05ports\"c\n" +
 	"\vClusterInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04hash\x18\x02 \x01(\tR\x04hash\x12,\n" +
@@ -6000,7 +7325,12 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x11InstallationProxy\x12\x12\n" +
 	"\x04mode\x18\x01 \x01(\tR\x04mode\x12\x1a\n" +
 	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x18\n" +
-	"\anoProxy\x18\x03 \x01(\tR\anoProxy\"\xee\x02\n" +
+	"\anoProxy\x18\x03 \x01(\tR\anoProxy\"u\n" +
+	"\x11MaintenanceWindow\x12\x12\n" +
+	"\x04days\x18\x01 \x03(\tR\x04days\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\tR\bduration\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\"\x91\x0e\n" +
 	"\x04Role\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x12\n" +
@@ -6010,11 +7340,68 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"targetPort\x12*\n" +
 	"\broleType\x18\x06 \x01(\x0e2\x0e.spec.RoleTypeR\broleType\x12 \n" +
 	"\vtargetPools\x18\a \x03(\tR\vtargetPools\x12/\n" +
-	"\bsettings\x18\b \x01(\v2\x13.spec.Role.SettingsR\bsettings\x1a\x82\x01\n" +
+	"\bsettings\x18\b \x01(\v2\x13.spec.Role.SettingsR\bsettings\x12(\n" +
+	"\x06routes\x18\t \x03(\v2\x10.spec.Role.RouteR\x06routes\x122\n" +
+	"\x15allowed_source_ranges\x18\n" +
+	" \x03(\tR\x13allowedSourceRanges\x1a\xb3\x04\n" +
 	"\bSettings\x12$\n" +
 	"\rproxyProtocol\x18\x01 \x01(\bR\rproxyProtocol\x12&\n" +
 	"\x0estickySessions\x18\x02 \x01(\bR\x0estickySessions\x12(\n" +
-	"\x10envoy_admin_port\x18\x03 \x01(\x05R\x0eenvoyAdminPort\"\xd5\x02\n" +
+	"\x10envoy_admin_port\x18\x03 \x01(\x05R\x0eenvoyAdminPort\x129\n" +
+	"\fhealth_check\x18\x04 \x01(\v2\x16.spec.Role.HealthCheckR\vhealthCheck\x12H\n" +
+	"\x11outlier_detection\x18\x05 \x01(\v2\x1b.spec.Role.OutlierDetectionR\x10outlierDetection\x12 \n" +
+	"\x03tls\x18\x06 \x01(\v2\x0e.spec.Role.TlsR\x03tls\x122\n" +
+	"\talgorithm\x18\a \x01(\x0e2\x14.spec.Role.AlgorithmR\talgorithm\x12:\n" +
+	"\aweights\x18\b \x03(\v2 .spec.Role.Settings.WeightsEntryR\aweights\x12'\n" +
+	"\x0fmax_connections\x18\t \x01(\rR\x0emaxConnections\x123\n" +
+	"\n" +
+	"rate_limit\x18\n" +
+	" \x01(\v2\x14.spec.Role.RateLimitR\trateLimit\x1a:\n" +
+	"\fWeightsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\x1aW\n" +
+	"\tRateLimit\x124\n" +
+	"\x16connections_per_second\x18\x01 \x01(\rR\x14connectionsPerSecond\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\rR\x05burst\x1a\x9d\x01\n" +
+	"\x03Tls\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1c\n" +
+	"\tdirectory\x18\x02 \x01(\tR\tdirectory\x12 \n" +
+	"\vcertificate\x18\x03 \x01(\tR\vcertificate\x12\x1f\n" +
+	"\vprivate_key\x18\x04 \x01(\tR\n" +
+	"privateKey\x12\x1f\n" +
+	"\vaccount_key\x18\x05 \x01(\tR\n" +
+	"accountKey\x1a\xef\x01\n" +
+	"\vHealthCheck\x12\x1a\n" +
+	"\bprotocol\x18\x01 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vinterval_ms\x18\x04 \x01(\rR\n" +
+	"intervalMs\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x05 \x01(\rR\ttimeoutMs\x12+\n" +
+	"\x11healthy_threshold\x18\x06 \x01(\rR\x10healthyThreshold\x12/\n" +
+	"\x13unhealthy_threshold\x18\a \x01(\rR\x12unhealthyThreshold\x1a\xcb\x01\n" +
+	"\x10OutlierDetection\x121\n" +
+	"\x14consecutive_failures\x18\x01 \x01(\rR\x13consecutiveFailures\x12\x1f\n" +
+	"\vinterval_ms\x18\x02 \x01(\rR\n" +
+	"intervalMs\x121\n" +
+	"\x15base_ejection_time_ms\x18\x03 \x01(\rR\x12baseEjectionTimeMs\x120\n" +
+	"\x14max_ejection_percent\x18\x04 \x01(\rR\x12maxEjectionPercent\x1a\x80\x01\n" +
+	"\x05Route\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x1f\n" +
+	"\vpath_prefix\x18\x02 \x01(\tR\n" +
+	"pathPrefix\x12!\n" +
+	"\ftarget_pools\x18\x03 \x03(\tR\vtargetPools\x12\x1f\n" +
+	"\vtarget_port\x18\x04 \x01(\x05R\n" +
+	"targetPort\"V\n" +
+	"\tAlgorithm\x12\x0f\n" +
+	"\vROUND_ROBIN\x10\x00\x12\x11\n" +
+	"\rLEAST_REQUEST\x10\x01\x12\r\n" +
+	"\tRING_HASH\x10\x02\x12\n" +
+	"\n" +
+	"\x06MAGLEV\x10\x03\x12\n" +
+	"\n" +
+	"\x06RANDOM\x10\x04\"\xd5\x02\n" +
 	"\tTaskEvent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12!\n" +
@@ -6043,7 +7430,7 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2&.spec.Unreachable.UnreachableNodePoolsR\x05value:\x028\x01\"c\n" +
 	"\x06Create\x12\"\n" +
 	"\x03k8s\x18\x01 \x01(\v2\x10.spec.K8sclusterR\x03k8s\x125\n" +
-	"\rloadBalancers\x18\x02 \x03(\v2\x0f.spec.LBclusterR\rloadBalancers\"\xa0N\n" +
+	"\rloadBalancers\x18\x02 \x03(\v2\x0f.spec.LBclusterR\rloadBalancers\"\xf4Q\n" +
 	"\x06Update\x12(\n" +
 	"\x05state\x18\x01 \x01(\v2\x12.spec.Update.StateR\x05state\x12'\n" +
 	"\x04none\x18\x02 \x01(\v2\x11.spec.Update.NoneH\x00R\x04none\x12W\n" +
@@ -6055,7 +7442,8 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x19tfDeleteLoadBalancerNodes\x18\b \x01(\v2/.spec.Update.TerraformerDeleteLoadBalancerNodesH\x00R\x19tfDeleteLoadBalancerNodes\x12r\n" +
 	"\x1atfMoveNodePoolToAutoscaled\x18\t \x01(\v20.spec.Update.TerraformerMoveNodePoolToAutoscaledH\x00R\x1atfMoveNodePoolToAutoscaled\x12x\n" +
 	"\x1ctfMoveNodePoolFromAutoscaled\x18\n" +
-	" \x01(\v22.spec.Update.TerraformerMoveNodePoolFromAutoscaledH\x00R\x1ctfMoveNodePoolFromAutoscaled\x12{\n" +
+	" \x01(\v22.spec.Update.TerraformerMoveNodePoolFromAutoscaledH\x00R\x1ctfMoveNodePoolFromAutoscaled\x12]\n" +
+	"\x13tfReplaceDnsRecords\x18\v \x01(\v2).spec.Update.TerraformerReplaceDnsRecordsH\x00R\x13tfReplaceDnsRecords\x12{\n" +
 	"\x1dtfReplaceRoleExternalSettings\x18\f \x01(\v23.spec.Update.TerraformerReplaceRoleExternalSettingsH\x00R\x1dtfReplaceRoleExternalSettings\x12U\n" +
 	"\x0fansReplaceProxy\x18\x0e \x01(\v2).spec.Update.AnsiblerReplaceProxySettingsH\x00R\x0fansReplaceProxy\x12_\n" +
 	"\x15ansReplaceTargetPools\x18\x0f \x01(\v2'.spec.Update.AnsiblerReplaceTargetPoolsH\x00R\x15ansReplaceTargetPools\x12z\n" +
@@ -6074,6 +7462,7 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x1bmovedNodePoolFromAutoscaled\x18\x1e \x01(\v2(.spec.Update.MovedNodePoolFromAutoscaledH\x00R\x1bmovedNodePoolFromAutoscaled\x12o\n" +
 	"\x1creplacedRoleInternalSettings\x18\x1f \x01(\v2).spec.Update.ReplacedRoleInternalSettingsH\x00R\x1creplacedRoleInternalSettings\x12o\n" +
 	"\x1creplacedRoleExternalSettings\x18  \x01(\v2).spec.Update.ReplacedRoleExternalSettingsH\x00R\x1creplacedRoleExternalSettings\x12Q\n" +
+	"\x12replacedDnsRecords\x18! \x01(\v2\x1f.spec.Update.ReplacedDnsRecordsH\x00R\x12replacedDnsRecords\x12Q\n" +
 	"\x12deleteLoadBalancer\x18\" \x01(\v2\x1f.spec.Update.DeleteLoadBalancerH\x00R\x12deleteLoadBalancer\x12H\n" +
 	"\x0fdeletedK8sNodes\x18# \x01(\v2\x1c.spec.Update.DeletedK8sNodesH\x00R\x0fdeletedK8sNodes\x12c\n" +
 	"\x18deletedLoadBalancerNodes\x18$ \x01(\v2%.spec.Update.DeletedLoadBalancerNodesH\x00R\x18deletedLoadBalancerNodes\x12`\n" +
@@ -6158,7 +7547,12 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\vReplacedDns\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12+\n" +
 	"\x0eoldApiEndpoint\x18\x02 \x01(\tH\x00R\x0eoldApiEndpoint\x88\x01\x01B\x11\n" +
-	"\x0f_oldApiEndpoint\x1av\n" +
+	"\x0f_oldApiEndpoint\x1aj\n" +
+	"\x1cTerraformerReplaceDnsRecords\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x122\n" +
+	"\x14unreachableEndpoints\x18\x02 \x03(\tR\x14unreachableEndpoints\x1a,\n" +
+	"\x12ReplacedDnsRecords\x12\x16\n" +
+	"\x06handle\x18\x01 \x01(\tR\x06handle\x1av\n" +
 	"\x12DeleteLoadBalancer\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x128\n" +
 	"\vunreachable\x18\x02 \x01(\v2\x11.spec.UnreachableH\x00R\vunreachable\x88\x01\x01B\x0e\n" +
@@ -6174,13 +7568,14 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x04open\x18\x01 \x01(\bR\x04open\x1aM\n" +
 	"\x1cAnsiblerReplaceProxySettings\x12-\n" +
 	"\x05proxy\x18\x01 \x01(\v2\x17.spec.InstallationProxyR\x05proxy\x1a\x17\n" +
-	"\x15ReplacedProxySettings\x1a\xb0\x01\n" +
+	"\x15ReplacedProxySettings\x1a\xe4\x01\n" +
 	"&TerraformerReplaceRoleExternalSettings\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x12*\n" +
 	"\broleType\x18\x03 \x01(\x0e2\x0e.spec.RoleTypeR\broleType\x12\x1a\n" +
 	"\bprotocol\x18\x04 \x01(\tR\bprotocol\x12\x12\n" +
-	"\x04port\x18\x05 \x01(\x05R\x04port\x1aJ\n" +
+	"\x04port\x18\x05 \x01(\x05R\x04port\x122\n" +
+	"\x15allowed_source_ranges\x18\x06 \x03(\tR\x13allowedSourceRanges\x1aJ\n" +
 	"\x1cReplacedRoleExternalSettings\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x12\n" +
 	"\x04role\x18\x02 \x01(\tR\x04role\x1a\xa2\x01\n" +
@@ -6193,21 +7588,23 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\bsettings\x18\x04 \x01(\v2\x13.spec.Role.SettingsR\bsettings\x1aJ\n" +
 	"\x1cReplacedRoleInternalSettings\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role\x1a\x92\x02\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x1a\xbc\x02\n" +
 	"\x1aAnsiblerReplaceTargetPools\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12H\n" +
-	"\x05roles\x18\x02 \x03(\v22.spec.Update.AnsiblerReplaceTargetPools.RolesEntryR\x05roles\x1a#\n" +
+	"\x05roles\x18\x02 \x03(\v22.spec.Update.AnsiblerReplaceTargetPools.RolesEntryR\x05roles\x1aM\n" +
 	"\vTargetPools\x12\x14\n" +
-	"\x05pools\x18\x01 \x03(\tR\x05pools\x1am\n" +
+	"\x05pools\x18\x01 \x03(\tR\x05pools\x12(\n" +
+	"\x06routes\x18\x02 \x03(\v2\x10.spec.Role.RouteR\x06routes\x1am\n" +
 	"\n" +
 	"RolesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12I\n" +
-	"\x05value\x18\x02 \x01(\v23.spec.Update.AnsiblerReplaceTargetPools.TargetPoolsR\x05value:\x028\x01\x1a\xfd\x01\n" +
+	"\x05value\x18\x02 \x01(\v23.spec.Update.AnsiblerReplaceTargetPools.TargetPoolsR\x05value:\x028\x01\x1a\xa7\x02\n" +
 	"\x13ReplacedTargetPools\x12\x16\n" +
 	"\x06handle\x18\x01 \x01(\tR\x06handle\x12A\n" +
-	"\x05roles\x18\x02 \x03(\v2+.spec.Update.ReplacedTargetPools.RolesEntryR\x05roles\x1a#\n" +
+	"\x05roles\x18\x02 \x03(\v2+.spec.Update.ReplacedTargetPools.RolesEntryR\x05roles\x1aM\n" +
 	"\vTargetPools\x12\x14\n" +
-	"\x05pools\x18\x01 \x03(\tR\x05pools\x1af\n" +
+	"\x05pools\x18\x01 \x03(\tR\x05pools\x12(\n" +
+	"\x06routes\x18\x02 \x03(\v2\x10.spec.Role.RouteR\x06routes\x1af\n" +
 	"\n" +
 	"RolesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12B\n" +
@@ -6308,13 +7705,14 @@ const file_spec_manifest_proto_rawDesc = "" +
 	"\x04Work\x12\x1e\n" +
 	"\x04task\x18\x01 \x01(\v2\n" +
 	".spec.TaskR\x04task\x12,\n" +
-	"\x06passes\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\x06passes\"\xdd\x04\n" +
+	"\x06passes\x18\x02 \x03(\v2\x14.google.protobuf.AnyR\x06passes\"\xf9\x04\n" +
 	"\n" +
 	"TaskResult\x121\n" +
 	"\x05error\x18\x01 \x01(\v2\x16.spec.TaskResult.ErrorH\x01R\x05error\x88\x01\x01\x12+\n" +
 	"\x04none\x18\x02 \x01(\v2\x15.spec.TaskResult.NoneH\x00R\x04none\x126\n" +
 	"\x06update\x18\x03 \x01(\v2\x1c.spec.TaskResult.UpdateStateH\x00R\x06update\x123\n" +
-	"\x05clear\x18\x04 \x01(\v2\x1b.spec.TaskResult.ClearStateH\x00R\x05clear\x1az\n" +
+	"\x05clear\x18\x04 \x01(\v2\x1b.spec.TaskResult.ClearStateH\x00R\x05clear\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x03(\tR\bwarnings\x1az\n" +
 	"\x05Error\x12/\n" +
 	"\x04kind\x18\x01 \x01(\x0e2\x1b.spec.TaskResult.Error.KindR\x04kind\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x1e\n" +
@@ -6364,271 +7762,308 @@ func file_spec_manifest_proto_rawDescGZIP() []byte {
 	return file_spec_manifest_proto_rawDescData
 }
 
-var file_spec_manifest_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_spec_manifest_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
+var file_spec_manifest_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_spec_manifest_proto_msgTypes = make([]protoimpl.MessageInfo, 114)
 var file_spec_manifest_proto_goTypes = []any{
 	(RoleType)(0),                            // 0: spec.RoleType
 	(Event)(0),                               // 1: spec.Event
 	(ApiEndpointChangeState)(0),              // 2: spec.ApiEndpointChangeState
 	(Manifest_State)(0),                      // 3: spec.Manifest.State
 	(Workflow_Status)(0),                     // 4: spec.Workflow.Status
-	(TaskResult_Error_Kind)(0),               // 5: spec.TaskResult.Error.Kind
-	(*Config)(nil),                           // 6: spec.Config
-	(*Manifest)(nil),                         // 7: spec.Manifest
-	(*Counters)(nil),                         // 8: spec.Counters
-	(*ClusterState)(nil),                     // 9: spec.ClusterState
-	(*Clusters)(nil),                         // 10: spec.Clusters
-	(*LoadBalancers)(nil),                    // 11: spec.LoadBalancers
-	(*KubernetesContext)(nil),                // 12: spec.KubernetesContext
-	(*FinishedWorkflow)(nil),                 // 13: spec.FinishedWorkflow
-	(*Workflow)(nil),                         // 14: spec.Workflow
-	(*K8Scluster)(nil),                       // 15: spec.K8scluster
-	(*LBcluster)(nil),                        // 16: spec.LBcluster
-	(*ClusterInfo)(nil),                      // 17: spec.ClusterInfo
-	(*InstallationProxy)(nil),                // 18: spec.InstallationProxy
-	(*Role)(nil),                             // 19: spec.Role
-	(*TaskEvent)(nil),                        // 20: spec.TaskEvent
-	(*Unreachable)(nil),                      // 21: spec.Unreachable
-	(*Create)(nil),                           // 22: spec.Create
-	(*Update)(nil),                           // 23: spec.Update
-	(*Delete)(nil),                           // 24: spec.Delete
-	(*Task)(nil),                             // 25: spec.Task
-	(*Work)(nil),                             // 26: spec.Work
-	(*TaskResult)(nil),                       // 27: spec.TaskResult
-	nil,                                      // 28: spec.Config.ClustersEntry
-	nil,                                      // 29: spec.Counters.K8sNodePoolScaleUpFailedEntry
-	(*Role_Settings)(nil),                    // 30: spec.Role.Settings
-	(*Unreachable_ListOfNodeEndpoints)(nil),  // 31: spec.Unreachable.ListOfNodeEndpoints
-	(*Unreachable_UnreachableNodePools)(nil), // 32: spec.Unreachable.UnreachableNodePools
-	nil,                                      // 33: spec.Unreachable.LoadbalancersEntry
-	nil,                                      // 34: spec.Unreachable.UnreachableNodePools.NodepoolsEntry
-	(*Update_State)(nil),                     // 35: spec.Update.State
-	(*Update_None)(nil),                      // 36: spec.Update.None
-	(*Update_TerraformerMoveNodePoolToAutoscaled)(nil),    // 37: spec.Update.TerraformerMoveNodePoolToAutoscaled
-	(*Update_MovedNodePoolToAutoscaled)(nil),              // 38: spec.Update.MovedNodePoolToAutoscaled
-	(*Update_TerraformerMoveNodePoolFromAutoscaled)(nil),  // 39: spec.Update.TerraformerMoveNodePoolFromAutoscaled
-	(*Update_MovedNodePoolFromAutoscaled)(nil),            // 40: spec.Update.MovedNodePoolFromAutoscaled
-	(*Update_TerraformerAddLoadBalancer)(nil),             // 41: spec.Update.TerraformerAddLoadBalancer
-	(*Update_AddedLoadBalancer)(nil),                      // 42: spec.Update.AddedLoadBalancer
-	(*Update_TerraformerDeleteLoadBalancerNodes)(nil),     // 43: spec.Update.TerraformerDeleteLoadBalancerNodes
-	(*Update_DeletedLoadBalancerNodes)(nil),               // 44: spec.Update.DeletedLoadBalancerNodes
-	(*Update_TerraformerAddLoadBalancerNodes)(nil),        // 45: spec.Update.TerraformerAddLoadBalancerNodes
-	(*Update_AddedLoadBalancerNodes)(nil),                 // 46: spec.Update.AddedLoadBalancerNodes
-	(*Update_DeleteLoadBalancerRoles)(nil),                // 47: spec.Update.DeleteLoadBalancerRoles
-	(*Update_TerraformerAddLoadBalancerRoles)(nil),        // 48: spec.Update.TerraformerAddLoadBalancerRoles
-	(*Update_AddedLoadBalancerRoles)(nil),                 // 49: spec.Update.AddedLoadBalancerRoles
-	(*Update_TerraformerReplaceDns)(nil),                  // 50: spec.Update.TerraformerReplaceDns
-	(*Update_ReplacedDns)(nil),                            // 51: spec.Update.ReplacedDns
-	(*Update_DeleteLoadBalancer)(nil),                     // 52: spec.Update.DeleteLoadBalancer
-	(*Update_ApiEndpoint)(nil),                            // 53: spec.Update.ApiEndpoint
-	(*Update_K8SOnlyApiEndpoint)(nil),                     // 54: spec.Update.K8sOnlyApiEndpoint
-	(*Update_ApiPortOnCluster)(nil),                       // 55: spec.Update.ApiPortOnCluster
-	(*Update_AnsiblerReplaceProxySettings)(nil),           // 56: spec.Update.AnsiblerReplaceProxySettings
-	(*Update_ReplacedProxySettings)(nil),                  // 57: spec.Update.ReplacedProxySettings
-	(*Update_TerraformerReplaceRoleExternalSettings)(nil), // 58: spec.Update.TerraformerReplaceRoleExternalSettings
-	(*Update_ReplacedRoleExternalSettings)(nil),           // 59: spec.Update.ReplacedRoleExternalSettings
-	(*Update_AnsiblerReplaceRoleInternalSettings)(nil),    // 60: spec.Update.AnsiblerReplaceRoleInternalSettings
-	(*Update_ReplacedRoleInternalSettings)(nil),           // 61: spec.Update.ReplacedRoleInternalSettings
-	(*Update_AnsiblerReplaceTargetPools)(nil),             // 62: spec.Update.AnsiblerReplaceTargetPools
-	(*Update_ReplacedTargetPools)(nil),                    // 63: spec.Update.ReplacedTargetPools
-	(*Update_UpgradeVersion)(nil),                         // 64: spec.Update.UpgradeVersion
-	(*Update_KuberPatchNodes)(nil),                        // 65: spec.Update.KuberPatchNodes
-	(*Update_PatchedNodes)(nil),                           // 66: spec.Update.PatchedNodes
-	(*Update_KuberDeleteK8SNodes)(nil),                    // 67: spec.Update.KuberDeleteK8sNodes
-	(*Update_DeletedK8SNodes)(nil),                        // 68: spec.Update.DeletedK8sNodes
-	(*Update_TerraformerAddK8SNodes)(nil),                 // 69: spec.Update.TerraformerAddK8sNodes
-	(*Update_AddedK8SNodes)(nil),                          // 70: spec.Update.AddedK8sNodes
-	(*Update_DeletedLoadBalancerNodes_WholeNodePool)(nil), // 71: spec.Update.DeletedLoadBalancerNodes.WholeNodePool
-	(*Update_DeletedLoadBalancerNodes_Partial)(nil),       // 72: spec.Update.DeletedLoadBalancerNodes.Partial
-	nil, // 73: spec.Update.DeletedLoadBalancerNodes.Partial.StaticNodeKeysEntry
-	(*Update_TerraformerAddLoadBalancerNodes_Existing)(nil), // 74: spec.Update.TerraformerAddLoadBalancerNodes.Existing
-	(*Update_TerraformerAddLoadBalancerNodes_New)(nil),      // 75: spec.Update.TerraformerAddLoadBalancerNodes.New
-	(*Update_AnsiblerReplaceTargetPools_TargetPools)(nil),   // 76: spec.Update.AnsiblerReplaceTargetPools.TargetPools
-	nil, // 77: spec.Update.AnsiblerReplaceTargetPools.RolesEntry
-	(*Update_ReplacedTargetPools_TargetPools)(nil), // 78: spec.Update.ReplacedTargetPools.TargetPools
-	nil, // 79: spec.Update.ReplacedTargetPools.RolesEntry
-	(*Update_KuberPatchNodes_ListOfTaints)(nil),         // 80: spec.Update.KuberPatchNodes.ListOfTaints
-	(*Update_KuberPatchNodes_ListOfLabelKeys)(nil),      // 81: spec.Update.KuberPatchNodes.ListOfLabelKeys
-	(*Update_KuberPatchNodes_ListOfAnnotationKeys)(nil), // 82: spec.Update.KuberPatchNodes.ListOfAnnotationKeys
-	(*Update_KuberPatchNodes_MapOfLabels)(nil),          // 83: spec.Update.KuberPatchNodes.MapOfLabels
-	(*Update_KuberPatchNodes_MapOfAnnotations)(nil),     // 84: spec.Update.KuberPatchNodes.MapOfAnnotations
-	(*Update_KuberPatchNodes_RemoveBatch)(nil),          // 85: spec.Update.KuberPatchNodes.RemoveBatch
-	(*Update_KuberPatchNodes_AddBatch)(nil),             // 86: spec.Update.KuberPatchNodes.AddBatch
-	nil,                                                 // 87: spec.Update.KuberPatchNodes.MapOfLabels.LabelsEntry
-	nil,                                                 // 88: spec.Update.KuberPatchNodes.MapOfAnnotations.AnnotationsEntry
-	nil,                                                 // 89: spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry
-	nil,                                                 // 90: spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry
-	nil,                                                 // 91: spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry
-	nil,                                                 // 92: spec.Update.KuberPatchNodes.AddBatch.TaintsEntry
-	nil,                                                 // 93: spec.Update.KuberPatchNodes.AddBatch.LabelsEntry
-	nil,                                                 // 94: spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry
-	(*Update_DeletedK8SNodes_WholeNodePool)(nil), // 95: spec.Update.DeletedK8sNodes.WholeNodePool
-	(*Update_DeletedK8SNodes_Partial)(nil),       // 96: spec.Update.DeletedK8sNodes.Partial
-	nil,                                          // 97: spec.Update.DeletedK8sNodes.Partial.StaticNodeKeysEntry
-	(*Update_TerraformerAddK8SNodes_Existing)(nil), // 98: spec.Update.TerraformerAddK8sNodes.Existing
-	(*Update_TerraformerAddK8SNodes_New)(nil),      // 99: spec.Update.TerraformerAddK8sNodes.New
-	(*TaskResult_Error)(nil),                       // 100: spec.TaskResult.Error
-	(*TaskResult_None)(nil),                        // 101: spec.TaskResult.None
-	(*TaskResult_UpdateState)(nil),                 // 102: spec.TaskResult.UpdateState
-	(*TaskResult_ClearState)(nil),                  // 103: spec.TaskResult.ClearState
-	(*timestamppb.Timestamp)(nil),                  // 104: google.protobuf.Timestamp
-	(*DNS)(nil),                                    // 105: spec.DNS
-	(*NodePool)(nil),                               // 106: spec.NodePool
-	(*Stage)(nil),                                  // 107: spec.Stage
-	(*anypb.Any)(nil),                              // 108: google.protobuf.Any
-	(*AutoscalerConf)(nil),                         // 109: spec.AutoscalerConf
-	(*Node)(nil),                                   // 110: spec.Node
-	(*Taint)(nil),                                  // 111: spec.Taint
+	(Role_Algorithm)(0),                      // 5: spec.Role.Algorithm
+	(TaskResult_Error_Kind)(0),               // 6: spec.TaskResult.Error.Kind
+	(*Config)(nil),                           // 7: spec.Config
+	(*Manifest)(nil),                         // 8: spec.Manifest
+	(*Counters)(nil),                         // 9: spec.Counters
+	(*ClusterState)(nil),                     // 10: spec.ClusterState
+	(*Rollback)(nil),                         // 11: spec.Rollback
+	(*Clusters)(nil),                         // 12: spec.Clusters
+	(*LoadBalancers)(nil),                    // 13: spec.LoadBalancers
+	(*KubernetesContext)(nil),                // 14: spec.KubernetesContext
+	(*FinishedWorkflow)(nil),                 // 15: spec.FinishedWorkflow
+	(*Workflow)(nil),                         // 16: spec.Workflow
+	(*K8Scluster)(nil),                       // 17: spec.K8scluster
+	(*NodeHooks)(nil),                        // 18: spec.NodeHooks
+	(*NodeHook)(nil),                         // 19: spec.NodeHook
+	(*LBcluster)(nil),                        // 20: spec.LBcluster
+	(*VirtualIP)(nil),                        // 21: spec.VirtualIP
+	(*ServiceLoadBalancer)(nil),              // 22: spec.ServiceLoadBalancer
+	(*ClusterInfo)(nil),                      // 23: spec.ClusterInfo
+	(*InstallationProxy)(nil),                // 24: spec.InstallationProxy
+	(*MaintenanceWindow)(nil),                // 25: spec.MaintenanceWindow
+	(*Role)(nil),                             // 26: spec.Role
+	(*TaskEvent)(nil),                        // 27: spec.TaskEvent
+	(*Unreachable)(nil),                      // 28: spec.Unreachable
+	(*Create)(nil),                           // 29: spec.Create
+	(*Update)(nil),                           // 30: spec.Update
+	(*Delete)(nil),                           // 31: spec.Delete
+	(*Task)(nil),                             // 32: spec.Task
+	(*Work)(nil),                             // 33: spec.Work
+	(*TaskResult)(nil),                       // 34: spec.TaskResult
+	nil,                                      // 35: spec.Config.ClustersEntry
+	nil,                                      // 36: spec.Counters.K8sNodePoolScaleUpFailedEntry
+	(*ServiceLoadBalancer_Port)(nil),         // 37: spec.ServiceLoadBalancer.Port
+	(*ServiceLoadBalancer_Service)(nil),      // 38: spec.ServiceLoadBalancer.Service
+	(*Role_Settings)(nil),                    // 39: spec.Role.Settings
+	(*Role_RateLimit)(nil),                   // 40: spec.Role.RateLimit
+	(*Role_Tls)(nil),                         // 41: spec.Role.Tls
+	(*Role_HealthCheck)(nil),                 // 42: spec.Role.HealthCheck
+	(*Role_OutlierDetection)(nil),            // 43: spec.Role.OutlierDetection
+	(*Role_Route)(nil),                       // 44: spec.Role.Route
+	nil,                                      // 45: spec.Role.Settings.WeightsEntry
+	(*Unreachable_ListOfNodeEndpoints)(nil),  // 46: spec.Unreachable.ListOfNodeEndpoints
+	(*Unreachable_UnreachableNodePools)(nil), // 47: spec.Unreachable.UnreachableNodePools
+	nil,                                      // 48: spec.Unreachable.LoadbalancersEntry
+	nil,                                      // 49: spec.Unreachable.UnreachableNodePools.NodepoolsEntry
+	(*Update_State)(nil),                     // 50: spec.Update.State
+	(*Update_None)(nil),                      // 51: spec.Update.None
+	(*Update_TerraformerMoveNodePoolToAutoscaled)(nil),    // 52: spec.Update.TerraformerMoveNodePoolToAutoscaled
+	(*Update_MovedNodePoolToAutoscaled)(nil),              // 53: spec.Update.MovedNodePoolToAutoscaled
+	(*Update_TerraformerMoveNodePoolFromAutoscaled)(nil),  // 54: spec.Update.TerraformerMoveNodePoolFromAutoscaled
+	(*Update_MovedNodePoolFromAutoscaled)(nil),            // 55: spec.Update.MovedNodePoolFromAutoscaled
+	(*Update_TerraformerAddLoadBalancer)(nil),             // 56: spec.Update.TerraformerAddLoadBalancer
+	(*Update_AddedLoadBalancer)(nil),                      // 57: spec.Update.AddedLoadBalancer
+	(*Update_TerraformerDeleteLoadBalancerNodes)(nil),     // 58: spec.Update.TerraformerDeleteLoadBalancerNodes
+	(*Update_DeletedLoadBalancerNodes)(nil),               // 59: spec.Update.DeletedLoadBalancerNodes
+	(*Update_TerraformerAddLoadBalancerNodes)(nil),        // 60: spec.Update.TerraformerAddLoadBalancerNodes
+	(*Update_AddedLoadBalancerNodes)(nil),                 // 61: spec.Update.AddedLoadBalancerNodes
+	(*Update_DeleteLoadBalancerRoles)(nil),                // 62: spec.Update.DeleteLoadBalancerRoles
+	(*Update_TerraformerAddLoadBalancerRoles)(nil),        // 63: spec.Update.TerraformerAddLoadBalancerRoles
+	(*Update_AddedLoadBalancerRoles)(nil),                 // 64: spec.Update.AddedLoadBalancerRoles
+	(*Update_TerraformerReplaceDns)(nil),                  // 65: spec.Update.TerraformerReplaceDns
+	(*Update_ReplacedDns)(nil),                            // 66: spec.Update.ReplacedDns
+	(*Update_TerraformerReplaceDnsRecords)(nil),           // 67: spec.Update.TerraformerReplaceDnsRecords
+	(*Update_ReplacedDnsRecords)(nil),                     // 68: spec.Update.ReplacedDnsRecords
+	(*Update_DeleteLoadBalancer)(nil),                     // 69: spec.Update.DeleteLoadBalancer
+	(*Update_ApiEndpoint)(nil),                            // 70: spec.Update.ApiEndpoint
+	(*Update_K8SOnlyApiEndpoint)(nil),                     // 71: spec.Update.K8sOnlyApiEndpoint
+	(*Update_ApiPortOnCluster)(nil),                       // 72: spec.Update.ApiPortOnCluster
+	(*Update_AnsiblerReplaceProxySettings)(nil),           // 73: spec.Update.AnsiblerReplaceProxySettings
+	(*Update_ReplacedProxySettings)(nil),                  // 74: spec.Update.ReplacedProxySettings
+	(*Update_TerraformerReplaceRoleExternalSettings)(nil), // 75: spec.Update.TerraformerReplaceRoleExternalSettings
+	(*Update_ReplacedRoleExternalSettings)(nil),           // 76: spec.Update.ReplacedRoleExternalSettings
+	(*Update_AnsiblerReplaceRoleInternalSettings)(nil),    // 77: spec.Update.AnsiblerReplaceRoleInternalSettings
+	(*Update_ReplacedRoleInternalSettings)(nil),           // 78: spec.Update.ReplacedRoleInternalSettings
+	(*Update_AnsiblerReplaceTargetPools)(nil),             // 79: spec.Update.AnsiblerReplaceTargetPools
+	(*Update_ReplacedTargetPools)(nil),                    // 80: spec.Update.ReplacedTargetPools
+	(*Update_UpgradeVersion)(nil),                         // 81: spec.Update.UpgradeVersion
+	(*Update_KuberPatchNodes)(nil),                        // 82: spec.Update.KuberPatchNodes
+	(*Update_PatchedNodes)(nil),                           // 83: spec.Update.PatchedNodes
+	(*Update_KuberDeleteK8SNodes)(nil),                    // 84: spec.Update.KuberDeleteK8sNodes
+	(*Update_DeletedK8SNodes)(nil),                        // 85: spec.Update.DeletedK8sNodes
+	(*Update_TerraformerAddK8SNodes)(nil),                 // 86: spec.Update.TerraformerAddK8sNodes
+	(*Update_AddedK8SNodes)(nil),                          // 87: spec.Update.AddedK8sNodes
+	(*Update_DeletedLoadBalancerNodes_WholeNodePool)(nil), // 88: spec.Update.DeletedLoadBalancerNodes.WholeNodePool
+	(*Update_DeletedLoadBalancerNodes_Partial)(nil),       // 89: spec.Update.DeletedLoadBalancerNodes.Partial
+	nil, // 90: spec.Update.DeletedLoadBalancerNodes.Partial.StaticNodeKeysEntry
+	(*Update_TerraformerAddLoadBalancerNodes_Existing)(nil), // 91: spec.Update.TerraformerAddLoadBalancerNodes.Existing
+	(*Update_TerraformerAddLoadBalancerNodes_New)(nil),      // 92: spec.Update.TerraformerAddLoadBalancerNodes.New
+	(*Update_AnsiblerReplaceTargetPools_TargetPools)(nil),   // 93: spec.Update.AnsiblerReplaceTargetPools.TargetPools
+	nil, // 94: spec.Update.AnsiblerReplaceTargetPools.RolesEntry
+	(*Update_ReplacedTargetPools_TargetPools)(nil), // 95: spec.Update.ReplacedTargetPools.TargetPools
+	nil, // 96: spec.Update.ReplacedTargetPools.RolesEntry
+	(*Update_KuberPatchNodes_ListOfTaints)(nil),         // 97: spec.Update.KuberPatchNodes.ListOfTaints
+	(*Update_KuberPatchNodes_ListOfLabelKeys)(nil),      // 98: spec.Update.KuberPatchNodes.ListOfLabelKeys
+	(*Update_KuberPatchNodes_ListOfAnnotationKeys)(nil), // 99: spec.Update.KuberPatchNodes.ListOfAnnotationKeys
+	(*Update_KuberPatchNodes_MapOfLabels)(nil),          // 100: spec.Update.KuberPatchNodes.MapOfLabels
+	(*Update_KuberPatchNodes_MapOfAnnotations)(nil),     // 101: spec.Update.KuberPatchNodes.MapOfAnnotations
+	(*Update_KuberPatchNodes_RemoveBatch)(nil),          // 102: spec.Update.KuberPatchNodes.RemoveBatch
+	(*Update_KuberPatchNodes_AddBatch)(nil),             // 103: spec.Update.KuberPatchNodes.AddBatch
+	nil,                                                 // 104: spec.Update.KuberPatchNodes.MapOfLabels.LabelsEntry
+	nil,                                                 // 105: spec.Update.KuberPatchNodes.MapOfAnnotations.AnnotationsEntry
+	nil,                                                 // 106: spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry
+	nil,                                                 // 107: spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry
+	nil,                                                 // 108: spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry
+	nil,                                                 // 109: spec.Update.KuberPatchNodes.AddBatch.TaintsEntry
+	nil,                                                 // 110: spec.Update.KuberPatchNodes.AddBatch.LabelsEntry
+	nil,                                                 // 111: spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry
+	(*Update_DeletedK8SNodes_WholeNodePool)(nil), // 112: spec.Update.DeletedK8sNodes.WholeNodePool
+	(*Update_DeletedK8SNodes_Partial)(nil),       // 113: spec.Update.DeletedK8sNodes.Partial
+	nil,                                          // 114: spec.Update.DeletedK8sNodes.Partial.StaticNodeKeysEntry
+	(*Update_TerraformerAddK8SNodes_Existing)(nil), // 115: spec.Update.TerraformerAddK8sNodes.Existing
+	(*Update_TerraformerAddK8SNodes_New)(nil),      // 116: spec.Update.TerraformerAddK8sNodes.New
+	(*TaskResult_Error)(nil),                       // 117: spec.TaskResult.Error
+	(*TaskResult_None)(nil),                        // 118: spec.TaskResult.None
+	(*TaskResult_UpdateState)(nil),                 // 119: spec.TaskResult.UpdateState
+	(*TaskResult_ClearState)(nil),                  // 120: spec.TaskResult.ClearState
+	(*timestamppb.Timestamp)(nil),                  // 121: google.protobuf.Timestamp
+	(*DNS)(nil),                                    // 122: spec.DNS
+	(*NodePool)(nil),                               // 123: spec.NodePool
+	(*Stage)(nil),                                  // 124: spec.Stage
+	(*anypb.Any)(nil),                              // 125: google.protobuf.Any
+	(*AutoscalerConf)(nil),                         // 126: spec.AutoscalerConf
+	(*Node)(nil),                                   // 127: spec.Node
+	(*Taint)(nil),                                  // 128: spec.Taint
 }
 var file_spec_manifest_proto_depIdxs = []int32{
-	12,  // 0: spec.Config.k8sCtx:type_name -> spec.KubernetesContext
-	7,   // 1: spec.Config.manifest:type_name -> spec.Manifest
-	28,  // 2: spec.Config.clusters:type_name -> spec.Config.ClustersEntry
+	14,  // 0: spec.Config.k8sCtx:type_name -> spec.KubernetesContext
+	8,   // 1: spec.Config.manifest:type_name -> spec.Manifest
+	35,  // 2: spec.Config.clusters:type_name -> spec.Config.ClustersEntry
 	3,   // 3: spec.Manifest.state:type_name -> spec.Manifest.State
-	104, // 4: spec.Manifest.stateTimestamp:type_name -> google.protobuf.Timestamp
-	29,  // 5: spec.Counters.k8sNodePoolScaleUpFailed:type_name -> spec.Counters.K8sNodePoolScaleUpFailedEntry
-	10,  // 6: spec.ClusterState.current:type_name -> spec.Clusters
-	14,  // 7: spec.ClusterState.state:type_name -> spec.Workflow
-	20,  // 8: spec.ClusterState.inFlight:type_name -> spec.TaskEvent
-	8,   // 9: spec.ClusterState.counters:type_name -> spec.Counters
-	15,  // 10: spec.Clusters.k8s:type_name -> spec.K8scluster
-	11,  // 11: spec.Clusters.loadBalancers:type_name -> spec.LoadBalancers
-	16,  // 12: spec.LoadBalancers.clusters:type_name -> spec.LBcluster
-	4,   // 13: spec.FinishedWorkflow.status:type_name -> spec.Workflow.Status
-	104, // 14: spec.FinishedWorkflow.timestamp:type_name -> google.protobuf.Timestamp
-	4,   // 15: spec.Workflow.status:type_name -> spec.Workflow.Status
-	13,  // 16: spec.Workflow.previous:type_name -> spec.FinishedWorkflow
-	17,  // 17: spec.K8scluster.clusterInfo:type_name -> spec.ClusterInfo
-	18,  // 18: spec.K8scluster.installationProxy:type_name -> spec.InstallationProxy
-	17,  // 19: spec.LBcluster.clusterInfo:type_name -> spec.ClusterInfo
-	19,  // 20: spec.LBcluster.roles:type_name -> spec.Role
-	105, // 21: spec.LBcluster.dns:type_name -> spec.DNS
-	106, // 22: spec.ClusterInfo.nodePools:type_name -> spec.NodePool
-	0,   // 23: spec.Role.roleType:type_name -> spec.RoleType
-	30,  // 24: spec.Role.settings:type_name -> spec.Role.Settings
-	104, // 25: spec.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
-	1,   // 26: spec.TaskEvent.event:type_name -> spec.Event
-	25,  // 27: spec.TaskEvent.task:type_name -> spec.Task
-	107, // 28: spec.TaskEvent.pipeline:type_name -> spec.Stage
-	20,  // 29: spec.TaskEvent.lowerPriority:type_name -> spec.TaskEvent
-	32,  // 30: spec.Unreachable.kubernetes:type_name -> spec.Unreachable.UnreachableNodePools
-	33,  // 31: spec.Unreachable.loadbalancers:type_name -> spec.Unreachable.LoadbalancersEntry
-	15,  // 32: spec.Create.k8s:type_name -> spec.K8scluster
-	16,  // 33: spec.Create.loadBalancers:type_name -> spec.LBcluster
-	35,  // 34: spec.Update.state:type_name -> spec.Update.State
-	36,  // 35: spec.Update.none:type_name -> spec.Update.None
-	41,  // 36: spec.Update.tfAddLoadBalancer:type_name -> spec.Update.TerraformerAddLoadBalancer
-	45,  // 37: spec.Update.tfAddLoadBalancerNodes:type_name -> spec.Update.TerraformerAddLoadBalancerNodes
-	50,  // 38: spec.Update.tfReplaceDns:type_name -> spec.Update.TerraformerReplaceDns
-	69,  // 39: spec.Update.tfAddK8sNodes:type_name -> spec.Update.TerraformerAddK8sNodes
-	48,  // 40: spec.Update.tfAddLoadBalancerRoles:type_name -> spec.Update.TerraformerAddLoadBalancerRoles
-	43,  // 41: spec.Update.tfDeleteLoadBalancerNodes:type_name -> spec.Update.TerraformerDeleteLoadBalancerNodes
-	37,  // 42: spec.Update.tfMoveNodePoolToAutoscaled:type_name -> spec.Update.TerraformerMoveNodePoolToAutoscaled
-	39,  // 43: spec.Update.tfMoveNodePoolFromAutoscaled:type_name -> spec.Update.TerraformerMoveNodePoolFromAutoscaled
-	58,  // 44: spec.Update.tfReplaceRoleExternalSettings:type_name -> spec.Update.TerraformerReplaceRoleExternalSettings
-	56,  // 45: spec.Update.ansReplaceProxy:type_name -> spec.Update.AnsiblerReplaceProxySettings
-	62,  // 46: spec.Update.ansReplaceTargetPools:type_name -> spec.Update.AnsiblerReplaceTargetPools
-	60,  // 47: spec.Update.ansReplaceRoleInternalSettings:type_name -> spec.Update.AnsiblerReplaceRoleInternalSettings
-	65,  // 48: spec.Update.kpatchNodes:type_name -> spec.Update.KuberPatchNodes
-	67,  // 49: spec.Update.kDeleteNodes:type_name -> spec.Update.KuberDeleteK8sNodes
-	42,  // 50: spec.Update.addedLoadBalancer:type_name -> spec.Update.AddedLoadBalancer
-	46,  // 51: spec.Update.addedLoadBalancerNodes:type_name -> spec.Update.AddedLoadBalancerNodes
-	51,  // 52: spec.Update.replacedDns:type_name -> spec.Update.ReplacedDns
-	70,  // 53: spec.Update.addedK8sNodes:type_name -> spec.Update.AddedK8sNodes
-	57,  // 54: spec.Update.replacedProxy:type_name -> spec.Update.ReplacedProxySettings
-	66,  // 55: spec.Update.patchedNodes:type_name -> spec.Update.PatchedNodes
-	49,  // 56: spec.Update.addedLoadBalancerRoles:type_name -> spec.Update.AddedLoadBalancerRoles
-	63,  // 57: spec.Update.replacedTargetPools:type_name -> spec.Update.ReplacedTargetPools
-	38,  // 58: spec.Update.movedNodePoolToAutoscaled:type_name -> spec.Update.MovedNodePoolToAutoscaled
-	40,  // 59: spec.Update.movedNodePoolFromAutoscaled:type_name -> spec.Update.MovedNodePoolFromAutoscaled
-	61,  // 60: spec.Update.replacedRoleInternalSettings:type_name -> spec.Update.ReplacedRoleInternalSettings
-	59,  // 61: spec.Update.replacedRoleExternalSettings:type_name -> spec.Update.ReplacedRoleExternalSettings
-	52,  // 62: spec.Update.deleteLoadBalancer:type_name -> spec.Update.DeleteLoadBalancer
-	68,  // 63: spec.Update.deletedK8sNodes:type_name -> spec.Update.DeletedK8sNodes
-	44,  // 64: spec.Update.deletedLoadBalancerNodes:type_name -> spec.Update.DeletedLoadBalancerNodes
-	47,  // 65: spec.Update.deleteLoadBalancerRoles:type_name -> spec.Update.DeleteLoadBalancerRoles
-	53,  // 66: spec.Update.apiEndpoint:type_name -> spec.Update.ApiEndpoint
-	55,  // 67: spec.Update.clusterApiPort:type_name -> spec.Update.ApiPortOnCluster
-	54,  // 68: spec.Update.k8sApiEndpoint:type_name -> spec.Update.K8sOnlyApiEndpoint
-	64,  // 69: spec.Update.upgradeVersion:type_name -> spec.Update.UpgradeVersion
-	15,  // 70: spec.Delete.k8s:type_name -> spec.K8scluster
-	16,  // 71: spec.Delete.loadBalancers:type_name -> spec.LBcluster
-	22,  // 72: spec.Task.create:type_name -> spec.Create
-	23,  // 73: spec.Task.update:type_name -> spec.Update
-	24,  // 74: spec.Task.delete:type_name -> spec.Delete
-	25,  // 75: spec.Work.task:type_name -> spec.Task
-	108, // 76: spec.Work.passes:type_name -> google.protobuf.Any
-	100, // 77: spec.TaskResult.error:type_name -> spec.TaskResult.Error
-	101, // 78: spec.TaskResult.none:type_name -> spec.TaskResult.None
-	102, // 79: spec.TaskResult.update:type_name -> spec.TaskResult.UpdateState
-	103, // 80: spec.TaskResult.clear:type_name -> spec.TaskResult.ClearState
-	9,   // 81: spec.Config.ClustersEntry.value:type_name -> spec.ClusterState
-	34,  // 82: spec.Unreachable.UnreachableNodePools.nodepools:type_name -> spec.Unreachable.UnreachableNodePools.NodepoolsEntry
-	32,  // 83: spec.Unreachable.LoadbalancersEntry.value:type_name -> spec.Unreachable.UnreachableNodePools
-	31,  // 84: spec.Unreachable.UnreachableNodePools.NodepoolsEntry.value:type_name -> spec.Unreachable.ListOfNodeEndpoints
-	15,  // 85: spec.Update.State.k8s:type_name -> spec.K8scluster
-	16,  // 86: spec.Update.State.loadBalancers:type_name -> spec.LBcluster
-	109, // 87: spec.Update.TerraformerMoveNodePoolToAutoscaled.config:type_name -> spec.AutoscalerConf
-	109, // 88: spec.Update.MovedNodePoolFromAutoscaled.config:type_name -> spec.AutoscalerConf
-	16,  // 89: spec.Update.TerraformerAddLoadBalancer.handle:type_name -> spec.LBcluster
-	21,  // 90: spec.Update.TerraformerDeleteLoadBalancerNodes.unreachable:type_name -> spec.Unreachable
-	21,  // 91: spec.Update.DeletedLoadBalancerNodes.unreachable:type_name -> spec.Unreachable
-	71,  // 92: spec.Update.DeletedLoadBalancerNodes.whole:type_name -> spec.Update.DeletedLoadBalancerNodes.WholeNodePool
-	72,  // 93: spec.Update.DeletedLoadBalancerNodes.partial:type_name -> spec.Update.DeletedLoadBalancerNodes.Partial
-	74,  // 94: spec.Update.TerraformerAddLoadBalancerNodes.existing:type_name -> spec.Update.TerraformerAddLoadBalancerNodes.Existing
-	75,  // 95: spec.Update.TerraformerAddLoadBalancerNodes.new:type_name -> spec.Update.TerraformerAddLoadBalancerNodes.New
-	19,  // 96: spec.Update.TerraformerAddLoadBalancerRoles.roles:type_name -> spec.Role
-	105, // 97: spec.Update.TerraformerReplaceDns.dns:type_name -> spec.DNS
-	21,  // 98: spec.Update.DeleteLoadBalancer.unreachable:type_name -> spec.Unreachable
-	2,   // 99: spec.Update.ApiEndpoint.state:type_name -> spec.ApiEndpointChangeState
-	18,  // 100: spec.Update.AnsiblerReplaceProxySettings.proxy:type_name -> spec.InstallationProxy
-	0,   // 101: spec.Update.TerraformerReplaceRoleExternalSettings.roleType:type_name -> spec.RoleType
-	30,  // 102: spec.Update.AnsiblerReplaceRoleInternalSettings.settings:type_name -> spec.Role.Settings
-	77,  // 103: spec.Update.AnsiblerReplaceTargetPools.roles:type_name -> spec.Update.AnsiblerReplaceTargetPools.RolesEntry
-	79,  // 104: spec.Update.ReplacedTargetPools.roles:type_name -> spec.Update.ReplacedTargetPools.RolesEntry
-	86,  // 105: spec.Update.KuberPatchNodes.add:type_name -> spec.Update.KuberPatchNodes.AddBatch
-	85,  // 106: spec.Update.KuberPatchNodes.remove:type_name -> spec.Update.KuberPatchNodes.RemoveBatch
-	21,  // 107: spec.Update.KuberDeleteK8sNodes.unreachable:type_name -> spec.Unreachable
-	21,  // 108: spec.Update.DeletedK8sNodes.unreachable:type_name -> spec.Unreachable
-	95,  // 109: spec.Update.DeletedK8sNodes.whole:type_name -> spec.Update.DeletedK8sNodes.WholeNodePool
-	96,  // 110: spec.Update.DeletedK8sNodes.partial:type_name -> spec.Update.DeletedK8sNodes.Partial
-	98,  // 111: spec.Update.TerraformerAddK8sNodes.existing:type_name -> spec.Update.TerraformerAddK8sNodes.Existing
-	99,  // 112: spec.Update.TerraformerAddK8sNodes.new:type_name -> spec.Update.TerraformerAddK8sNodes.New
-	106, // 113: spec.Update.DeletedLoadBalancerNodes.WholeNodePool.nodepool:type_name -> spec.NodePool
-	110, // 114: spec.Update.DeletedLoadBalancerNodes.Partial.nodes:type_name -> spec.Node
-	73,  // 115: spec.Update.DeletedLoadBalancerNodes.Partial.staticNodeKeys:type_name -> spec.Update.DeletedLoadBalancerNodes.Partial.StaticNodeKeysEntry
-	110, // 116: spec.Update.TerraformerAddLoadBalancerNodes.Existing.nodes:type_name -> spec.Node
-	106, // 117: spec.Update.TerraformerAddLoadBalancerNodes.New.nodepool:type_name -> spec.NodePool
-	76,  // 118: spec.Update.AnsiblerReplaceTargetPools.RolesEntry.value:type_name -> spec.Update.AnsiblerReplaceTargetPools.TargetPools
-	78,  // 119: spec.Update.ReplacedTargetPools.RolesEntry.value:type_name -> spec.Update.ReplacedTargetPools.TargetPools
-	111, // 120: spec.Update.KuberPatchNodes.ListOfTaints.taints:type_name -> spec.Taint
-	87,  // 121: spec.Update.KuberPatchNodes.MapOfLabels.labels:type_name -> spec.Update.KuberPatchNodes.MapOfLabels.LabelsEntry
-	88,  // 122: spec.Update.KuberPatchNodes.MapOfAnnotations.annotations:type_name -> spec.Update.KuberPatchNodes.MapOfAnnotations.AnnotationsEntry
-	89,  // 123: spec.Update.KuberPatchNodes.RemoveBatch.taints:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry
-	90,  // 124: spec.Update.KuberPatchNodes.RemoveBatch.annotations:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry
-	91,  // 125: spec.Update.KuberPatchNodes.RemoveBatch.labels:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry
-	92,  // 126: spec.Update.KuberPatchNodes.AddBatch.taints:type_name -> spec.Update.KuberPatchNodes.AddBatch.TaintsEntry
-	93,  // 127: spec.Update.KuberPatchNodes.AddBatch.labels:type_name -> spec.Update.KuberPatchNodes.AddBatch.LabelsEntry
-	94,  // 128: spec.Update.KuberPatchNodes.AddBatch.annotations:type_name -> spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry
-	80,  // 129: spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfTaints
-	82,  // 130: spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfAnnotationKeys
-	81,  // 131: spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfLabelKeys
-	80,  // 132: spec.Update.KuberPatchNodes.AddBatch.TaintsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfTaints
-	83,  // 133: spec.Update.KuberPatchNodes.AddBatch.LabelsEntry.value:type_name -> spec.Update.KuberPatchNodes.MapOfLabels
-	84,  // 134: spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry.value:type_name -> spec.Update.KuberPatchNodes.MapOfAnnotations
-	106, // 135: spec.Update.DeletedK8sNodes.WholeNodePool.nodepool:type_name -> spec.NodePool
-	110, // 136: spec.Update.DeletedK8sNodes.Partial.nodes:type_name -> spec.Node
-	97,  // 137: spec.Update.DeletedK8sNodes.Partial.staticNodeKeys:type_name -> spec.Update.DeletedK8sNodes.Partial.StaticNodeKeysEntry
-	110, // 138: spec.Update.TerraformerAddK8sNodes.Existing.nodes:type_name -> spec.Node
-	106, // 139: spec.Update.TerraformerAddK8sNodes.New.nodepool:type_name -> spec.NodePool
-	5,   // 140: spec.TaskResult.Error.kind:type_name -> spec.TaskResult.Error.Kind
-	15,  // 141: spec.TaskResult.UpdateState.k8s:type_name -> spec.K8scluster
-	11,  // 142: spec.TaskResult.UpdateState.loadBalancers:type_name -> spec.LoadBalancers
-	143, // [143:143] is the sub-list for method output_type
-	143, // [143:143] is the sub-list for method input_type
-	143, // [143:143] is the sub-list for extension type_name
-	143, // [143:143] is the sub-list for extension extendee
-	0,   // [0:143] is the sub-list for field type_name
+	121, // 4: spec.Manifest.stateTimestamp:type_name -> google.protobuf.Timestamp
+	36,  // 5: spec.Counters.k8sNodePoolScaleUpFailed:type_name -> spec.Counters.K8sNodePoolScaleUpFailedEntry
+	12,  // 6: spec.ClusterState.current:type_name -> spec.Clusters
+	16,  // 7: spec.ClusterState.state:type_name -> spec.Workflow
+	27,  // 8: spec.ClusterState.inFlight:type_name -> spec.TaskEvent
+	9,   // 9: spec.ClusterState.counters:type_name -> spec.Counters
+	11,  // 10: spec.ClusterState.rollback:type_name -> spec.Rollback
+	17,  // 11: spec.Clusters.k8s:type_name -> spec.K8scluster
+	13,  // 12: spec.Clusters.loadBalancers:type_name -> spec.LoadBalancers
+	20,  // 13: spec.LoadBalancers.clusters:type_name -> spec.LBcluster
+	4,   // 14: spec.FinishedWorkflow.status:type_name -> spec.Workflow.Status
+	121, // 15: spec.FinishedWorkflow.timestamp:type_name -> google.protobuf.Timestamp
+	4,   // 16: spec.Workflow.status:type_name -> spec.Workflow.Status
+	15,  // 17: spec.Workflow.previous:type_name -> spec.FinishedWorkflow
+	23,  // 18: spec.K8scluster.clusterInfo:type_name -> spec.ClusterInfo
+	24,  // 19: spec.K8scluster.installationProxy:type_name -> spec.InstallationProxy
+	25,  // 20: spec.K8scluster.maintenanceWindows:type_name -> spec.MaintenanceWindow
+	18,  // 21: spec.K8scluster.nodeHooks:type_name -> spec.NodeHooks
+	19,  // 22: spec.NodeHooks.preDrain:type_name -> spec.NodeHook
+	19,  // 23: spec.NodeHooks.postJoin:type_name -> spec.NodeHook
+	23,  // 24: spec.LBcluster.clusterInfo:type_name -> spec.ClusterInfo
+	26,  // 25: spec.LBcluster.roles:type_name -> spec.Role
+	122, // 26: spec.LBcluster.dns:type_name -> spec.DNS
+	22,  // 27: spec.LBcluster.serviceLoadBalancer:type_name -> spec.ServiceLoadBalancer
+	21,  // 28: spec.LBcluster.virtualIP:type_name -> spec.VirtualIP
+	38,  // 29: spec.ServiceLoadBalancer.services:type_name -> spec.ServiceLoadBalancer.Service
+	123, // 30: spec.ClusterInfo.nodePools:type_name -> spec.NodePool
+	0,   // 31: spec.Role.roleType:type_name -> spec.RoleType
+	39,  // 32: spec.Role.settings:type_name -> spec.Role.Settings
+	44,  // 33: spec.Role.routes:type_name -> spec.Role.Route
+	121, // 34: spec.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
+	1,   // 35: spec.TaskEvent.event:type_name -> spec.Event
+	32,  // 36: spec.TaskEvent.task:type_name -> spec.Task
+	124, // 37: spec.TaskEvent.pipeline:type_name -> spec.Stage
+	27,  // 38: spec.TaskEvent.lowerPriority:type_name -> spec.TaskEvent
+	47,  // 39: spec.Unreachable.kubernetes:type_name -> spec.Unreachable.UnreachableNodePools
+	48,  // 40: spec.Unreachable.loadbalancers:type_name -> spec.Unreachable.LoadbalancersEntry
+	17,  // 41: spec.Create.k8s:type_name -> spec.K8scluster
+	20,  // 42: spec.Create.loadBalancers:type_name -> spec.LBcluster
+	50,  // 43: spec.Update.state:type_name -> spec.Update.State
+	51,  // 44: spec.Update.none:type_name -> spec.Update.None
+	56,  // 45: spec.Update.tfAddLoadBalancer:type_name -> spec.Update.TerraformerAddLoadBalancer
+	60,  // 46: spec.Update.tfAddLoadBalancerNodes:type_name -> spec.Update.TerraformerAddLoadBalancerNodes
+	65,  // 47: spec.Update.tfReplaceDns:type_name -> spec.Update.TerraformerReplaceDns
+	86,  // 48: spec.Update.tfAddK8sNodes:type_name -> spec.Update.TerraformerAddK8sNodes
+	63,  // 49: spec.Update.tfAddLoadBalancerRoles:type_name -> spec.Update.TerraformerAddLoadBalancerRoles
+	58,  // 50: spec.Update.tfDeleteLoadBalancerNodes:type_name -> spec.Update.TerraformerDeleteLoadBalancerNodes
+	52,  // 51: spec.Update.tfMoveNodePoolToAutoscaled:type_name -> spec.Update.TerraformerMoveNodePoolToAutoscaled
+	54,  // 52: spec.Update.tfMoveNodePoolFromAutoscaled:type_name -> spec.Update.TerraformerMoveNodePoolFromAutoscaled
+	67,  // 53: spec.Update.tfReplaceDnsRecords:type_name -> spec.Update.TerraformerReplaceDnsRecords
+	75,  // 54: spec.Update.tfReplaceRoleExternalSettings:type_name -> spec.Update.TerraformerReplaceRoleExternalSettings
+	73,  // 55: spec.Update.ansReplaceProxy:type_name -> spec.Update.AnsiblerReplaceProxySettings
+	79,  // 56: spec.Update.ansReplaceTargetPools:type_name -> spec.Update.AnsiblerReplaceTargetPools
+	77,  // 57: spec.Update.ansReplaceRoleInternalSettings:type_name -> spec.Update.AnsiblerReplaceRoleInternalSettings
+	82,  // 58: spec.Update.kpatchNodes:type_name -> spec.Update.KuberPatchNodes
+	84,  // 59: spec.Update.kDeleteNodes:type_name -> spec.Update.KuberDeleteK8sNodes
+	57,  // 60: spec.Update.addedLoadBalancer:type_name -> spec.Update.AddedLoadBalancer
+	61,  // 61: spec.Update.addedLoadBalancerNodes:type_name -> spec.Update.AddedLoadBalancerNodes
+	66,  // 62: spec.Update.replacedDns:type_name -> spec.Update.ReplacedDns
+	87,  // 63: spec.Update.addedK8sNodes:type_name -> spec.Update.AddedK8sNodes
+	74,  // 64: spec.Update.replacedProxy:type_name -> spec.Update.ReplacedProxySettings
+	83,  // 65: spec.Update.patchedNodes:type_name -> spec.Update.PatchedNodes
+	64,  // 66: spec.Update.addedLoadBalancerRoles:type_name -> spec.Update.AddedLoadBalancerRoles
+	80,  // 67: spec.Update.replacedTargetPools:type_name -> spec.Update.ReplacedTargetPools
+	53,  // 68: spec.Update.movedNodePoolToAutoscaled:type_name -> spec.Update.MovedNodePoolToAutoscaled
+	55,  // 69: spec.Update.movedNodePoolFromAutoscaled:type_name -> spec.Update.MovedNodePoolFromAutoscaled
+	78,  // 70: spec.Update.replacedRoleInternalSettings:type_name -> spec.Update.ReplacedRoleInternalSettings
+	76,  // 71: spec.Update.replacedRoleExternalSettings:type_name -> spec.Update.ReplacedRoleExternalSettings
+	68,  // 72: spec.Update.replacedDnsRecords:type_name -> spec.Update.ReplacedDnsRecords
+	69,  // 73: spec.Update.deleteLoadBalancer:type_name -> spec.Update.DeleteLoadBalancer
+	85,  // 74: spec.Update.deletedK8sNodes:type_name -> spec.Update.DeletedK8sNodes
+	59,  // 75: spec.Update.deletedLoadBalancerNodes:type_name -> spec.Update.DeletedLoadBalancerNodes
+	62,  // 76: spec.Update.deleteLoadBalancerRoles:type_name -> spec.Update.DeleteLoadBalancerRoles
+	70,  // 77: spec.Update.apiEndpoint:type_name -> spec.Update.ApiEndpoint
+	72,  // 78: spec.Update.clusterApiPort:type_name -> spec.Update.ApiPortOnCluster
+	71,  // 79: spec.Update.k8sApiEndpoint:type_name -> spec.Update.K8sOnlyApiEndpoint
+	81,  // 80: spec.Update.upgradeVersion:type_name -> spec.Update.UpgradeVersion
+	17,  // 81: spec.Delete.k8s:type_name -> spec.K8scluster
+	20,  // 82: spec.Delete.loadBalancers:type_name -> spec.LBcluster
+	29,  // 83: spec.Task.create:type_name -> spec.Create
+	30,  // 84: spec.Task.update:type_name -> spec.Update
+	31,  // 85: spec.Task.delete:type_name -> spec.Delete
+	32,  // 86: spec.Work.task:type_name -> spec.Task
+	125, // 87: spec.Work.passes:type_name -> google.protobuf.Any
+	117, // 88: spec.TaskResult.error:type_name -> spec.TaskResult.Error
+	118, // 89: spec.TaskResult.none:type_name -> spec.TaskResult.None
+	119, // 90: spec.TaskResult.update:type_name -> spec.TaskResult.UpdateState
+	120, // 91: spec.TaskResult.clear:type_name -> spec.TaskResult.ClearState
+	10,  // 92: spec.Config.ClustersEntry.value:type_name -> spec.ClusterState
+	37,  // 93: spec.ServiceLoadBalancer.Service.ports:type_name -> spec.ServiceLoadBalancer.Port
+	42,  // 94: spec.Role.Settings.health_check:type_name -> spec.Role.HealthCheck
+	43,  // 95: spec.Role.Settings.outlier_detection:type_name -> spec.Role.OutlierDetection
+	41,  // 96: spec.Role.Settings.tls:type_name -> spec.Role.Tls
+	5,   // 97: spec.Role.Settings.algorithm:type_name -> spec.Role.Algorithm
+	45,  // 98: spec.Role.Settings.weights:type_name -> spec.Role.Settings.WeightsEntry
+	40,  // 99: spec.Role.Settings.rate_limit:type_name -> spec.Role.RateLimit
+	49,  // 100: spec.Unreachable.UnreachableNodePools.nodepools:type_name -> spec.Unreachable.UnreachableNodePools.NodepoolsEntry
+	47,  // 101: spec.Unreachable.LoadbalancersEntry.value:type_name -> spec.Unreachable.UnreachableNodePools
+	46,  // 102: spec.Unreachable.UnreachableNodePools.NodepoolsEntry.value:type_name -> spec.Unreachable.ListOfNodeEndpoints
+	17,  // 103: spec.Update.State.k8s:type_name -> spec.K8scluster
+	20,  // 104: spec.Update.State.loadBalancers:type_name -> spec.LBcluster
+	126, // 105: spec.Update.TerraformerMoveNodePoolToAutoscaled.config:type_name -> spec.AutoscalerConf
+	126, // 106: spec.Update.MovedNodePoolFromAutoscaled.config:type_name -> spec.AutoscalerConf
+	20,  // 107: spec.Update.TerraformerAddLoadBalancer.handle:type_name -> spec.LBcluster
+	28,  // 108: spec.Update.TerraformerDeleteLoadBalancerNodes.unreachable:type_name -> spec.Unreachable
+	28,  // 109: spec.Update.DeletedLoadBalancerNodes.unreachable:type_name -> spec.Unreachable
+	88,  // 110: spec.Update.DeletedLoadBalancerNodes.whole:type_name -> spec.Update.DeletedLoadBalancerNodes.WholeNodePool
+	89,  // 111: spec.Update.DeletedLoadBalancerNodes.partial:type_name -> spec.Update.DeletedLoadBalancerNodes.Partial
+	91,  // 112: spec.Update.TerraformerAddLoadBalancerNodes.existing:type_name -> spec.Update.TerraformerAddLoadBalancerNodes.Existing
+	92,  // 113: spec.Update.TerraformerAddLoadBalancerNodes.new:type_name -> spec.Update.TerraformerAddLoadBalancerNodes.New
+	26,  // 114: spec.Update.TerraformerAddLoadBalancerRoles.roles:type_name -> spec.Role
+	122, // 115: spec.Update.TerraformerReplaceDns.dns:type_name -> spec.DNS
+	28,  // 116: spec.Update.DeleteLoadBalancer.unreachable:type_name -> spec.Unreachable
+	2,   // 117: spec.Update.ApiEndpoint.state:type_name -> spec.ApiEndpointChangeState
+	24,  // 118: spec.Update.AnsiblerReplaceProxySettings.proxy:type_name -> spec.InstallationProxy
+	0,   // 119: spec.Update.TerraformerReplaceRoleExternalSettings.roleType:type_name -> spec.RoleType
+	39,  // 120: spec.Update.AnsiblerReplaceRoleInternalSettings.settings:type_name -> spec.Role.Settings
+	94,  // 121: spec.Update.AnsiblerReplaceTargetPools.roles:type_name -> spec.Update.AnsiblerReplaceTargetPools.RolesEntry
+	96,  // 122: spec.Update.ReplacedTargetPools.roles:type_name -> spec.Update.ReplacedTargetPools.RolesEntry
+	103, // 123: spec.Update.KuberPatchNodes.add:type_name -> spec.Update.KuberPatchNodes.AddBatch
+	102, // 124: spec.Update.KuberPatchNodes.remove:type_name -> spec.Update.KuberPatchNodes.RemoveBatch
+	28,  // 125: spec.Update.KuberDeleteK8sNodes.unreachable:type_name -> spec.Unreachable
+	28,  // 126: spec.Update.DeletedK8sNodes.unreachable:type_name -> spec.Unreachable
+	112, // 127: spec.Update.DeletedK8sNodes.whole:type_name -> spec.Update.DeletedK8sNodes.WholeNodePool
+	113, // 128: spec.Update.DeletedK8sNodes.partial:type_name -> spec.Update.DeletedK8sNodes.Partial
+	115, // 129: spec.Update.TerraformerAddK8sNodes.existing:type_name -> spec.Update.TerraformerAddK8sNodes.Existing
+	116, // 130: spec.Update.TerraformerAddK8sNodes.new:type_name -> spec.Update.TerraformerAddK8sNodes.New
+	123, // 131: spec.Update.DeletedLoadBalancerNodes.WholeNodePool.nodepool:type_name -> spec.NodePool
+	127, // 132: spec.Update.DeletedLoadBalancerNodes.Partial.nodes:type_name -> spec.Node
+	90,  // 133: spec.Update.DeletedLoadBalancerNodes.Partial.staticNodeKeys:type_name -> spec.Update.DeletedLoadBalancerNodes.Partial.StaticNodeKeysEntry
+	127, // 134: spec.Update.TerraformerAddLoadBalancerNodes.Existing.nodes:type_name -> spec.Node
+	123, // 135: spec.Update.TerraformerAddLoadBalancerNodes.New.nodepool:type_name -> spec.NodePool
+	44,  // 136: spec.Update.AnsiblerReplaceTargetPools.TargetPools.routes:type_name -> spec.Role.Route
+	93,  // 137: spec.Update.AnsiblerReplaceTargetPools.RolesEntry.value:type_name -> spec.Update.AnsiblerReplaceTargetPools.TargetPools
+	44,  // 138: spec.Update.ReplacedTargetPools.TargetPools.routes:type_name -> spec.Role.Route
+	95,  // 139: spec.Update.ReplacedTargetPools.RolesEntry.value:type_name -> spec.Update.ReplacedTargetPools.TargetPools
+	128, // 140: spec.Update.KuberPatchNodes.ListOfTaints.taints:type_name -> spec.Taint
+	104, // 141: spec.Update.KuberPatchNodes.MapOfLabels.labels:type_name -> spec.Update.KuberPatchNodes.MapOfLabels.LabelsEntry
+	105, // 142: spec.Update.KuberPatchNodes.MapOfAnnotations.annotations:type_name -> spec.Update.KuberPatchNodes.MapOfAnnotations.AnnotationsEntry
+	106, // 143: spec.Update.KuberPatchNodes.RemoveBatch.taints:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry
+	107, // 144: spec.Update.KuberPatchNodes.RemoveBatch.annotations:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry
+	108, // 145: spec.Update.KuberPatchNodes.RemoveBatch.labels:type_name -> spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry
+	109, // 146: spec.Update.KuberPatchNodes.AddBatch.taints:type_name -> spec.Update.KuberPatchNodes.AddBatch.TaintsEntry
+	110, // 147: spec.Update.KuberPatchNodes.AddBatch.labels:type_name -> spec.Update.KuberPatchNodes.AddBatch.LabelsEntry
+	111, // 148: spec.Update.KuberPatchNodes.AddBatch.annotations:type_name -> spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry
+	97,  // 149: spec.Update.KuberPatchNodes.RemoveBatch.TaintsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfTaints
+	99,  // 150: spec.Update.KuberPatchNodes.RemoveBatch.AnnotationsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfAnnotationKeys
+	98,  // 151: spec.Update.KuberPatchNodes.RemoveBatch.LabelsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfLabelKeys
+	97,  // 152: spec.Update.KuberPatchNodes.AddBatch.TaintsEntry.value:type_name -> spec.Update.KuberPatchNodes.ListOfTaints
+	100, // 153: spec.Update.KuberPatchNodes.AddBatch.LabelsEntry.value:type_name -> spec.Update.KuberPatchNodes.MapOfLabels
+	101, // 154: spec.Update.KuberPatchNodes.AddBatch.AnnotationsEntry.value:type_name -> spec.Update.KuberPatchNodes.MapOfAnnotations
+	123, // 155: spec.Update.DeletedK8sNodes.WholeNodePool.nodepool:type_name -> spec.NodePool
+	127, // 156: spec.Update.DeletedK8sNodes.Partial.nodes:type_name -> spec.Node
+	114, // 157: spec.Update.DeletedK8sNodes.Partial.staticNodeKeys:type_name -> spec.Update.DeletedK8sNodes.Partial.StaticNodeKeysEntry
+	127, // 158: spec.Update.TerraformerAddK8sNodes.Existing.nodes:type_name -> spec.Node
+	123, // 159: spec.Update.TerraformerAddK8sNodes.New.nodepool:type_name -> spec.NodePool
+	6,   // 160: spec.TaskResult.Error.kind:type_name -> spec.TaskResult.Error.Kind
+	17,  // 161: spec.TaskResult.UpdateState.k8s:type_name -> spec.K8scluster
+	13,  // 162: spec.TaskResult.UpdateState.loadBalancers:type_name -> spec.LoadBalancers
+	163, // [163:163] is the sub-list for method output_type
+	163, // [163:163] is the sub-list for method input_type
+	163, // [163:163] is the sub-list for extension type_name
+	163, // [163:163] is the sub-list for extension extendee
+	0,   // [0:163] is the sub-list for field type_name
 }
 
 func init() { file_spec_manifest_proto_init() }
@@ -6639,8 +8074,8 @@ func file_spec_manifest_proto_init() {
 	file_spec_dns_proto_init()
 	file_spec_nodepool_proto_init()
 	file_spec_pass_proto_init()
-	file_spec_manifest_proto_msgTypes[14].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[17].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[20].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[23].OneofWrappers = []any{
 		(*Update_None_)(nil),
 		(*Update_TfAddLoadBalancer)(nil),
 		(*Update_TfAddLoadBalancerNodes)(nil),
@@ -6650,6 +8085,7 @@ func file_spec_manifest_proto_init() {
 		(*Update_TfDeleteLoadBalancerNodes)(nil),
 		(*Update_TfMoveNodePoolToAutoscaled)(nil),
 		(*Update_TfMoveNodePoolFromAutoscaled)(nil),
+		(*Update_TfReplaceDnsRecords)(nil),
 		(*Update_TfReplaceRoleExternalSettings)(nil),
 		(*Update_AnsReplaceProxy)(nil),
 		(*Update_AnsReplaceTargetPools)(nil),
@@ -6668,6 +8104,7 @@ func file_spec_manifest_proto_init() {
 		(*Update_MovedNodePoolFromAutoscaled_)(nil),
 		(*Update_ReplacedRoleInternalSettings_)(nil),
 		(*Update_ReplacedRoleExternalSettings_)(nil),
+		(*Update_ReplacedDnsRecords_)(nil),
 		(*Update_DeleteLoadBalancer_)(nil),
 		(*Update_DeletedK8SNodes_)(nil),
 		(*Update_DeletedLoadBalancerNodes_)(nil),
@@ -6677,46 +8114,46 @@ func file_spec_manifest_proto_init() {
 		(*Update_K8SApiEndpoint)(nil),
 		(*Update_UpgradeVersion_)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[19].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[25].OneofWrappers = []any{
 		(*Task_Create)(nil),
 		(*Task_Update)(nil),
 		(*Task_Delete)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[21].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[27].OneofWrappers = []any{
 		(*TaskResult_None_)(nil),
 		(*TaskResult_Update)(nil),
 		(*TaskResult_Clear)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[37].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[38].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[51].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[52].OneofWrappers = []any{
 		(*Update_DeletedLoadBalancerNodes_Whole)(nil),
 		(*Update_DeletedLoadBalancerNodes_Partial_)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[39].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[53].OneofWrappers = []any{
 		(*Update_TerraformerAddLoadBalancerNodes_Existing_)(nil),
 		(*Update_TerraformerAddLoadBalancerNodes_New_)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[44].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[45].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[46].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[61].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[62].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[58].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[59].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[62].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[77].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[78].OneofWrappers = []any{
 		(*Update_DeletedK8SNodes_Whole)(nil),
 		(*Update_DeletedK8SNodes_Partial_)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[63].OneofWrappers = []any{
+	file_spec_manifest_proto_msgTypes[79].OneofWrappers = []any{
 		(*Update_TerraformerAddK8SNodes_Existing_)(nil),
 		(*Update_TerraformerAddK8SNodes_New_)(nil),
 	}
-	file_spec_manifest_proto_msgTypes[96].OneofWrappers = []any{}
-	file_spec_manifest_proto_msgTypes[97].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[112].OneofWrappers = []any{}
+	file_spec_manifest_proto_msgTypes[113].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spec_manifest_proto_rawDesc), len(file_spec_manifest_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   98,
+			NumEnums:      7,
+			NumMessages:   114,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -205,7 +205,9 @@ type NodePool struct {
 	// User definded annotations.
 	Annotations map[string]string `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// SSH port for the nodes in this node pool. Default 0 means port 22.
-	SshPort       int32 `protobuf:"varint,9,opt,name=sshPort,proto3" json:"sshPort,omitempty"`
+	SshPort int32 `protobuf:"varint,9,opt,name=sshPort,proto3" json:"sshPort,omitempty"`
+	// Policy for draining the nodes of the node pool before their deletion.
+	DrainPolicy   *DrainPolicy `protobuf:"bytes,10,opt,name=drainPolicy,proto3" json:"drainPolicy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -314,6 +316,13 @@ func (x *NodePool) GetSshPort() int32 {
 	return 0
 }
 
+func (x *NodePool) GetDrainPolicy() *DrainPolicy {
+	if x != nil {
+		return x.DrainPolicy
+	}
+	return nil
+}
+
 type isNodePool_Type interface {
 	isNodePool_Type()
 }
@@ -330,6 +339,81 @@ func (*NodePool_DynamicNodePool) isNodePool_Type() {}
 
 func (*NodePool_StaticNodePool) isNodePool_Type() {}
 
+// DrainPolicy defines how the nodes of a node pool are drained.
+type DrainPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Timeout of the drain of a single node.
+	Timeout string `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Whether to fail the task instead of deleting the node
+	// once the timeout is reached.
+	FailOnTimeout bool `protobuf:"varint,2,opt,name=failOnTimeout,proto3" json:"failOnTimeout,omitempty"`
+	// Whether to proceed with the drain of a node with pods managed
+	// by DaemonSets, which are not evicted.
+	IgnoreDaemonSets bool `protobuf:"varint,3,opt,name=ignoreDaemonSets,proto3" json:"ignoreDaemonSets,omitempty"`
+	// Whether to evict pods using emptyDir volumes, deleting their data.
+	DeleteEmptyDirData bool `protobuf:"varint,4,opt,name=deleteEmptyDirData,proto3" json:"deleteEmptyDirData,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DrainPolicy) Reset() {
+	*x = DrainPolicy{}
+	mi := &file_spec_nodepool_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainPolicy) ProtoMessage() {}
+
+func (x *DrainPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_nodepool_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainPolicy.ProtoReflect.Descriptor instead.
+func (*DrainPolicy) Descriptor() ([]byte, []int) {
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DrainPolicy) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *DrainPolicy) GetFailOnTimeout() bool {
+	if x != nil {
+		return x.FailOnTimeout
+	}
+	return false
+}
+
+func (x *DrainPolicy) GetIgnoreDaemonSets() bool {
+	if x != nil {
+		return x.IgnoreDaemonSets
+	}
+	return false
+}
+
+func (x *DrainPolicy) GetDeleteEmptyDirData() bool {
+	if x != nil {
+		return x.DeleteEmptyDirData
+	}
+	return false
+}
+
 // Taint defines a custom defined taint for the node pools.
 type Taint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -345,7 +429,7 @@ type Taint struct {
 
 func (x *Taint) Reset() {
 	*x = Taint{}
-	mi := &file_spec_nodepool_proto_msgTypes[1]
+	mi := &file_spec_nodepool_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -357,7 +441,7 @@ func (x *Taint) String() string {
 func (*Taint) ProtoMessage() {}
 
 func (x *Taint) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[1]
+	mi := &file_spec_nodepool_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +454,7 @@ func (x *Taint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Taint.ProtoReflect.Descriptor instead.
 func (*Taint) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{1}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Taint) GetKey() string {
@@ -417,13 +501,16 @@ type Node struct {
 	// host-mapped UDP port differs from the in-VM ListenPort (shared-IP / NAT
 	// nodes). 0 means use the default WireGuard listen port (51820).
 	WireguardPort int32 `protobuf:"varint,8,opt,name=wireguardPort,proto3" json:"wireguardPort,omitempty"`
+	// Public IPv6 address of a dual-stack node. For nodes reachable
+	// only via IPv6 the address is stored in [public] instead.
+	PublicIPv6    string `protobuf:"bytes,9,opt,name=publicIPv6,proto3" json:"publicIPv6,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
-	mi := &file_spec_nodepool_proto_msgTypes[2]
+	mi := &file_spec_nodepool_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -435,7 +522,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[2]
+	mi := &file_spec_nodepool_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -448,7 +535,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{2}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Node) GetName() string {
@@ -507,6 +594,13 @@ func (x *Node) GetWireguardPort() int32 {
 	return 0
 }
 
+func (x *Node) GetPublicIPv6() string {
+	if x != nil {
+		return x.PublicIPv6
+	}
+	return ""
+}
+
 // DynamicNodePool represents dynamic node pool used in cluster.
 type DynamicNodePool struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -536,14 +630,16 @@ type DynamicNodePool struct {
 	// Network Name with public IPs (required for Openstack)
 	ExternalNetworkName string `protobuf:"bytes,15,opt,name=externalNetworkName,proto3" json:"externalNetworkName,omitempty"`
 	// Spot requests a discounted, pre-emptible (Spot) instance. Worker pools only. Currently supported on GCP, Verda, AWS, Azure, OCI.
-	Spot          bool `protobuf:"varint,16,opt,name=spot,proto3" json:"spot,omitempty"`
+	Spot bool `protobuf:"varint,16,opt,name=spot,proto3" json:"spot,omitempty"`
+	// Strategy for replacing the nodes of the nodepool on a rolling update. (optional)
+	RollingUpdate *RollingUpdateStrategy `protobuf:"bytes,17,opt,name=rollingUpdate,proto3" json:"rollingUpdate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DynamicNodePool) Reset() {
 	*x = DynamicNodePool{}
-	mi := &file_spec_nodepool_proto_msgTypes[3]
+	mi := &file_spec_nodepool_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +651,7 @@ func (x *DynamicNodePool) String() string {
 func (*DynamicNodePool) ProtoMessage() {}
 
 func (x *DynamicNodePool) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[3]
+	mi := &file_spec_nodepool_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +664,7 @@ func (x *DynamicNodePool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DynamicNodePool.ProtoReflect.Descriptor instead.
 func (*DynamicNodePool) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{3}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DynamicNodePool) GetServerType() string {
@@ -669,6 +765,70 @@ func (x *DynamicNodePool) GetSpot() bool {
 	return false
 }
 
+func (x *DynamicNodePool) GetRollingUpdate() *RollingUpdateStrategy {
+	if x != nil {
+		return x.RollingUpdate
+	}
+	return nil
+}
+
+// RollingUpdateStrategy specifies how many nodes of the nodepool are
+// replaced at once during a rolling update. Both values are either an
+// absolute number of nodes or a percentage of the nodepool count, e.g. "25%".
+type RollingUpdateStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Maximum number of nodes created above the count of the nodepool.
+	MaxSurge string `protobuf:"bytes,1,opt,name=maxSurge,proto3" json:"maxSurge,omitempty"`
+	// Maximum number of nodes of the nodepool that can be unavailable.
+	MaxUnavailable string `protobuf:"bytes,2,opt,name=maxUnavailable,proto3" json:"maxUnavailable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RollingUpdateStrategy) Reset() {
+	*x = RollingUpdateStrategy{}
+	mi := &file_spec_nodepool_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollingUpdateStrategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollingUpdateStrategy) ProtoMessage() {}
+
+func (x *RollingUpdateStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_nodepool_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollingUpdateStrategy.ProtoReflect.Descriptor instead.
+func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RollingUpdateStrategy) GetMaxSurge() string {
+	if x != nil {
+		return x.MaxSurge
+	}
+	return ""
+}
+
+func (x *RollingUpdateStrategy) GetMaxUnavailable() string {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return ""
+}
+
 // MachineSpec further specifies the requested server type.
 type MachineSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -686,7 +846,7 @@ type MachineSpec struct {
 
 func (x *MachineSpec) Reset() {
 	*x = MachineSpec{}
-	mi := &file_spec_nodepool_proto_msgTypes[4]
+	mi := &file_spec_nodepool_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -698,7 +858,7 @@ func (x *MachineSpec) String() string {
 func (*MachineSpec) ProtoMessage() {}
 
 func (x *MachineSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[4]
+	mi := &file_spec_nodepool_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,7 +871,7 @@ func (x *MachineSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MachineSpec.ProtoReflect.Descriptor instead.
 func (*MachineSpec) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{4}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MachineSpec) GetCpuCount() int32 {
@@ -759,7 +919,7 @@ type AutoscalerConf struct {
 
 func (x *AutoscalerConf) Reset() {
 	*x = AutoscalerConf{}
-	mi := &file_spec_nodepool_proto_msgTypes[5]
+	mi := &file_spec_nodepool_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -771,7 +931,7 @@ func (x *AutoscalerConf) String() string {
 func (*AutoscalerConf) ProtoMessage() {}
 
 func (x *AutoscalerConf) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[5]
+	mi := &file_spec_nodepool_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,7 +944,7 @@ func (x *AutoscalerConf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoscalerConf.ProtoReflect.Descriptor instead.
 func (*AutoscalerConf) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{5}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AutoscalerConf) GetMin() int32 {
@@ -819,7 +979,7 @@ type StaticNodePool struct {
 
 func (x *StaticNodePool) Reset() {
 	*x = StaticNodePool{}
-	mi := &file_spec_nodepool_proto_msgTypes[6]
+	mi := &file_spec_nodepool_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +991,7 @@ func (x *StaticNodePool) String() string {
 func (*StaticNodePool) ProtoMessage() {}
 
 func (x *StaticNodePool) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_nodepool_proto_msgTypes[6]
+	mi := &file_spec_nodepool_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1004,7 @@ func (x *StaticNodePool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StaticNodePool.ProtoReflect.Descriptor instead.
 func (*StaticNodePool) Descriptor() ([]byte, []int) {
-	return file_spec_nodepool_proto_rawDescGZIP(), []int{6}
+	return file_spec_nodepool_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StaticNodePool) GetNodeKeys() map[string]string {
@@ -858,7 +1018,7 @@ var File_spec_nodepool_proto protoreflect.FileDescriptor
 
 const file_spec_nodepool_proto_rawDesc = "" +
 	"\n" +
-	"\x13spec/nodepool.proto\x12\x04spec\x1a\x13spec/provider.proto\"\x9a\x04\n" +
+	"\x13spec/nodepool.proto\x12\x04spec\x1a\x13spec/provider.proto\"\xcf\x04\n" +
 	"\bNodePool\x12A\n" +
 	"\x0fdynamicNodePool\x18\x01 \x01(\v2\x15.spec.DynamicNodePoolH\x00R\x0fdynamicNodePool\x12>\n" +
 	"\x0estaticNodePool\x18\x02 \x01(\v2\x14.spec.StaticNodePoolH\x00R\x0estaticNodePool\x12\x12\n" +
@@ -869,18 +1029,25 @@ const file_spec_nodepool_proto_rawDesc = "" +
 	"\x06labels\x18\x06 \x03(\v2\x1a.spec.NodePool.LabelsEntryR\x06labels\x12#\n" +
 	"\x06taints\x18\a \x03(\v2\v.spec.TaintR\x06taints\x12A\n" +
 	"\vannotations\x18\b \x03(\v2\x1f.spec.NodePool.AnnotationsEntryR\vannotations\x12\x18\n" +
-	"\asshPort\x18\t \x01(\x05R\asshPort\x1a9\n" +
+	"\asshPort\x18\t \x01(\x05R\asshPort\x123\n" +
+	"\vdrainPolicy\x18\n" +
+	" \x01(\v2\x11.spec.DrainPolicyR\vdrainPolicy\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
 	"\x10AnnotationsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x06\n" +
-	"\x04Type\"G\n" +
+	"\x04Type\"\xa9\x01\n" +
+	"\vDrainPolicy\x12\x18\n" +
+	"\atimeout\x18\x01 \x01(\tR\atimeout\x12$\n" +
+	"\rfailOnTimeout\x18\x02 \x01(\bR\rfailOnTimeout\x12*\n" +
+	"\x10ignoreDaemonSets\x18\x03 \x01(\bR\x10ignoreDaemonSets\x12.\n" +
+	"\x12deleteEmptyDirData\x18\x04 \x01(\bR\x12deleteEmptyDirData\"G\n" +
 	"\x05Taint\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
-	"\x06effect\x18\x03 \x01(\tR\x06effect\"\xfe\x01\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\"\x9e\x02\n" +
 	"\x04Node\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aprivate\x18\x02 \x01(\tR\aprivate\x12\x16\n" +
@@ -889,7 +1056,10 @@ const file_spec_nodepool_proto_rawDesc = "" +
 	"\busername\x18\x05 \x01(\tR\busername\x12(\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x10.spec.NodeStatusR\x06status\x12\x18\n" +
 	"\asshPort\x18\a \x01(\x05R\asshPort\x12$\n" +
-	"\rwireguardPort\x18\b \x01(\x05R\rwireguardPort\"\xee\x03\n" +
+	"\rwireguardPort\x18\b \x01(\x05R\rwireguardPort\x12\x1e\n" +
+	"\n" +
+	"publicIPv6\x18\t \x01(\tR\n" +
+	"publicIPv6\"\xb1\x04\n" +
 	"\x0fDynamicNodePool\x12\x1e\n" +
 	"\n" +
 	"serverType\x18\x01 \x01(\tR\n" +
@@ -909,7 +1079,11 @@ const file_spec_nodepool_proto_rawDesc = "" +
 	"privateKey\x12\x12\n" +
 	"\x04cidr\x18\x0e \x01(\tR\x04cidr\x120\n" +
 	"\x13externalNetworkName\x18\x0f \x01(\tR\x13externalNetworkName\x12\x12\n" +
-	"\x04spot\x18\x10 \x01(\bR\x04spot\"\x8f\x01\n" +
+	"\x04spot\x18\x10 \x01(\bR\x04spot\x12A\n" +
+	"\rrollingUpdate\x18\x11 \x01(\v2\x1b.spec.RollingUpdateStrategyR\rrollingUpdate\"[\n" +
+	"\x15RollingUpdateStrategy\x12\x1a\n" +
+	"\bmaxSurge\x18\x01 \x01(\tR\bmaxSurge\x12&\n" +
+	"\x0emaxUnavailable\x18\x02 \x01(\tR\x0emaxUnavailable\"\x8f\x01\n" +
 	"\vMachineSpec\x12\x1a\n" +
 	"\bcpuCount\x18\x01 \x01(\x05R\bcpuCount\x12\x16\n" +
 	"\x06memory\x18\x02 \x01(\x05R\x06memory\x12&\n" +
@@ -956,41 +1130,45 @@ func file_spec_nodepool_proto_rawDescGZIP() []byte {
 }
 
 var file_spec_nodepool_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_spec_nodepool_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_spec_nodepool_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_spec_nodepool_proto_goTypes = []any{
-	(NodeType)(0),           // 0: spec.NodeType
-	(NodeStatus)(0),         // 1: spec.NodeStatus
-	(StaticNodepoolInfo)(0), // 2: spec.StaticNodepoolInfo
-	(*NodePool)(nil),        // 3: spec.NodePool
-	(*Taint)(nil),           // 4: spec.Taint
-	(*Node)(nil),            // 5: spec.Node
-	(*DynamicNodePool)(nil), // 6: spec.DynamicNodePool
-	(*MachineSpec)(nil),     // 7: spec.MachineSpec
-	(*AutoscalerConf)(nil),  // 8: spec.AutoscalerConf
-	(*StaticNodePool)(nil),  // 9: spec.StaticNodePool
-	nil,                     // 10: spec.NodePool.LabelsEntry
-	nil,                     // 11: spec.NodePool.AnnotationsEntry
-	nil,                     // 12: spec.StaticNodePool.NodeKeysEntry
-	(*Provider)(nil),        // 13: spec.Provider
+	(NodeType)(0),                 // 0: spec.NodeType
+	(NodeStatus)(0),               // 1: spec.NodeStatus
+	(StaticNodepoolInfo)(0),       // 2: spec.StaticNodepoolInfo
+	(*NodePool)(nil),              // 3: spec.NodePool
+	(*DrainPolicy)(nil),           // 4: spec.DrainPolicy
+	(*Taint)(nil),                 // 5: spec.Taint
+	(*Node)(nil),                  // 6: spec.Node
+	(*DynamicNodePool)(nil),       // 7: spec.DynamicNodePool
+	(*RollingUpdateStrategy)(nil), // 8: spec.RollingUpdateStrategy
+	(*MachineSpec)(nil),           // 9: spec.MachineSpec
+	(*AutoscalerConf)(nil),        // 10: spec.AutoscalerConf
+	(*StaticNodePool)(nil),        // 11: spec.StaticNodePool
+	nil,                           // 12: spec.NodePool.LabelsEntry
+	nil,                           // 13: spec.NodePool.AnnotationsEntry
+	nil,                           // 14: spec.StaticNodePool.NodeKeysEntry
+	(*Provider)(nil),              // 15: spec.Provider
 }
 var file_spec_nodepool_proto_depIdxs = []int32{
-	6,  // 0: spec.NodePool.dynamicNodePool:type_name -> spec.DynamicNodePool
-	9,  // 1: spec.NodePool.staticNodePool:type_name -> spec.StaticNodePool
-	5,  // 2: spec.NodePool.nodes:type_name -> spec.Node
-	10, // 3: spec.NodePool.labels:type_name -> spec.NodePool.LabelsEntry
-	4,  // 4: spec.NodePool.taints:type_name -> spec.Taint
-	11, // 5: spec.NodePool.annotations:type_name -> spec.NodePool.AnnotationsEntry
-	0,  // 6: spec.Node.nodeType:type_name -> spec.NodeType
-	1,  // 7: spec.Node.status:type_name -> spec.NodeStatus
-	13, // 8: spec.DynamicNodePool.provider:type_name -> spec.Provider
-	8,  // 9: spec.DynamicNodePool.autoscalerConfig:type_name -> spec.AutoscalerConf
-	7,  // 10: spec.DynamicNodePool.machineSpec:type_name -> spec.MachineSpec
-	12, // 11: spec.StaticNodePool.nodeKeys:type_name -> spec.StaticNodePool.NodeKeysEntry
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	7,  // 0: spec.NodePool.dynamicNodePool:type_name -> spec.DynamicNodePool
+	11, // 1: spec.NodePool.staticNodePool:type_name -> spec.StaticNodePool
+	6,  // 2: spec.NodePool.nodes:type_name -> spec.Node
+	12, // 3: spec.NodePool.labels:type_name -> spec.NodePool.LabelsEntry
+	5,  // 4: spec.NodePool.taints:type_name -> spec.Taint
+	13, // 5: spec.NodePool.annotations:type_name -> spec.NodePool.AnnotationsEntry
+	4,  // 6: spec.NodePool.drainPolicy:type_name -> spec.DrainPolicy
+	0,  // 7: spec.Node.nodeType:type_name -> spec.NodeType
+	1,  // 8: spec.Node.status:type_name -> spec.NodeStatus
+	15, // 9: spec.DynamicNodePool.provider:type_name -> spec.Provider
+	10, // 10: spec.DynamicNodePool.autoscalerConfig:type_name -> spec.AutoscalerConf
+	9,  // 11: spec.DynamicNodePool.machineSpec:type_name -> spec.MachineSpec
+	8,  // 12: spec.DynamicNodePool.rollingUpdate:type_name -> spec.RollingUpdateStrategy
+	14, // 13: spec.StaticNodePool.nodeKeys:type_name -> spec.StaticNodePool.NodeKeysEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_spec_nodepool_proto_init() }
@@ -1009,7 +1187,7 @@ func file_spec_nodepool_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spec_nodepool_proto_rawDesc), len(file_spec_nodepool_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -64,7 +64,7 @@ func (x TemplateRepository_Endpoint_Protocol) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TemplateRepository_Endpoint_Protocol.Descriptor instead.
 func (TemplateRepository_Endpoint_Protocol) EnumDescriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{12, 0, 0}
+	return file_spec_provider_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
 type GCPProvider struct {
@@ -719,6 +719,85 @@ func (x *OVHProvider) GetEndpoint() string {
 	return ""
 }
 
+// RFC2136Provider manages the DNS records of self-hosted zones, e.g. on BIND
+// or PowerDNS, via dynamic updates authenticated with a TSIG key.
+type RFC2136Provider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// server is the hostname or IP address of the DNS server.
+	Server        string `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Port          int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	KeyName       string `protobuf:"bytes,3,opt,name=keyName,proto3" json:"keyName,omitempty"`
+	KeyAlgorithm  string `protobuf:"bytes,4,opt,name=keyAlgorithm,proto3" json:"keyAlgorithm,omitempty"`
+	KeySecret     string `protobuf:"bytes,5,opt,name=keySecret,proto3" json:"keySecret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RFC2136Provider) Reset() {
+	*x = RFC2136Provider{}
+	mi := &file_spec_provider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RFC2136Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RFC2136Provider) ProtoMessage() {}
+
+func (x *RFC2136Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_spec_provider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RFC2136Provider.ProtoReflect.Descriptor instead.
+func (*RFC2136Provider) Descriptor() ([]byte, []int) {
+	return file_spec_provider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RFC2136Provider) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *RFC2136Provider) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *RFC2136Provider) GetKeyName() string {
+	if x != nil {
+		return x.KeyName
+	}
+	return ""
+}
+
+func (x *RFC2136Provider) GetKeyAlgorithm() string {
+	if x != nil {
+		return x.KeyAlgorithm
+	}
+	return ""
+}
+
+func (x *RFC2136Provider) GetKeySecret() string {
+	if x != nil {
+		return x.KeySecret
+	}
+	return ""
+}
+
 type Provider struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	SpecName          string                 `protobuf:"bytes,1,opt,name=specName,proto3" json:"specName,omitempty"`
@@ -738,6 +817,7 @@ type Provider struct {
 	//	*Provider_Cloudrift
 	//	*Provider_Verda
 	//	*Provider_Ovh
+	//	*Provider_Rfc2136
 	ProviderType  isProvider_ProviderType `protobuf_oneof:"ProviderType"`
 	Templates     *TemplateRepository     `protobuf:"bytes,13,opt,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -746,7 +826,7 @@ type Provider struct {
 
 func (x *Provider) Reset() {
 	*x = Provider{}
-	mi := &file_spec_provider_proto_msgTypes[11]
+	mi := &file_spec_provider_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +838,7 @@ func (x *Provider) String() string {
 func (*Provider) ProtoMessage() {}
 
 func (x *Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_provider_proto_msgTypes[11]
+	mi := &file_spec_provider_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +851,7 @@ func (x *Provider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Provider.ProtoReflect.Descriptor instead.
 func (*Provider) Descriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{11}
+	return file_spec_provider_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Provider) GetSpecName() string {
@@ -894,6 +974,15 @@ func (x *Provider) GetOvh() *OVHProvider {
 	return nil
 }
 
+func (x *Provider) GetRfc2136() *RFC2136Provider {
+	if x != nil {
+		if x, ok := x.ProviderType.(*Provider_Rfc2136); ok {
+			return x.Rfc2136
+		}
+	}
+	return nil
+}
+
 func (x *Provider) GetTemplates() *TemplateRepository {
 	if x != nil {
 		return x.Templates
@@ -949,6 +1038,10 @@ type Provider_Ovh struct {
 	Ovh *OVHProvider `protobuf:"bytes,16,opt,name=ovh,proto3,oneof"`
 }
 
+type Provider_Rfc2136 struct {
+	Rfc2136 *RFC2136Provider `protobuf:"bytes,17,opt,name=rfc2136,proto3,oneof"`
+}
+
 func (*Provider_Gcp) isProvider_ProviderType() {}
 
 func (*Provider_Hetzner) isProvider_ProviderType() {}
@@ -971,6 +1064,8 @@ func (*Provider_Verda) isProvider_ProviderType() {}
 
 func (*Provider_Ovh) isProvider_ProviderType() {}
 
+func (*Provider_Rfc2136) isProvider_ProviderType() {}
+
 type TemplateRepository struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Commit string                 `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -985,7 +1080,7 @@ type TemplateRepository struct {
 
 func (x *TemplateRepository) Reset() {
 	*x = TemplateRepository{}
-	mi := &file_spec_provider_proto_msgTypes[12]
+	mi := &file_spec_provider_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1092,7 @@ func (x *TemplateRepository) String() string {
 func (*TemplateRepository) ProtoMessage() {}
 
 func (x *TemplateRepository) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_provider_proto_msgTypes[12]
+	mi := &file_spec_provider_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1105,7 @@ func (x *TemplateRepository) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRepository.ProtoReflect.Descriptor instead.
 func (*TemplateRepository) Descriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{12}
+	return file_spec_provider_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TemplateRepository) GetCommit() string {
@@ -1058,7 +1153,7 @@ type TemplateRepository_Endpoint struct {
 
 func (x *TemplateRepository_Endpoint) Reset() {
 	*x = TemplateRepository_Endpoint{}
-	mi := &file_spec_provider_proto_msgTypes[13]
+	mi := &file_spec_provider_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1070,7 +1165,7 @@ func (x *TemplateRepository_Endpoint) String() string {
 func (*TemplateRepository_Endpoint) ProtoMessage() {}
 
 func (x *TemplateRepository_Endpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_provider_proto_msgTypes[13]
+	mi := &file_spec_provider_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1083,7 +1178,7 @@ func (x *TemplateRepository_Endpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRepository_Endpoint.ProtoReflect.Descriptor instead.
 func (*TemplateRepository_Endpoint) Descriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{12, 0}
+	return file_spec_provider_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *TemplateRepository_Endpoint) GetUrl() string {
@@ -1110,7 +1205,7 @@ type TemplateRepository_Auth struct {
 
 func (x *TemplateRepository_Auth) Reset() {
 	*x = TemplateRepository_Auth{}
-	mi := &file_spec_provider_proto_msgTypes[14]
+	mi := &file_spec_provider_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1122,7 +1217,7 @@ func (x *TemplateRepository_Auth) String() string {
 func (*TemplateRepository_Auth) ProtoMessage() {}
 
 func (x *TemplateRepository_Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_provider_proto_msgTypes[14]
+	mi := &file_spec_provider_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1135,7 +1230,7 @@ func (x *TemplateRepository_Auth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRepository_Auth.ProtoReflect.Descriptor instead.
 func (*TemplateRepository_Auth) Descriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{12, 1}
+	return file_spec_provider_proto_rawDescGZIP(), []int{13, 1}
 }
 
 func (x *TemplateRepository_Auth) GetUsername() string {
@@ -1165,7 +1260,7 @@ type TemplateRepository_TemplatePaths struct {
 
 func (x *TemplateRepository_TemplatePaths) Reset() {
 	*x = TemplateRepository_TemplatePaths{}
-	mi := &file_spec_provider_proto_msgTypes[15]
+	mi := &file_spec_provider_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1177,7 +1272,7 @@ func (x *TemplateRepository_TemplatePaths) String() string {
 func (*TemplateRepository_TemplatePaths) ProtoMessage() {}
 
 func (x *TemplateRepository_TemplatePaths) ProtoReflect() protoreflect.Message {
-	mi := &file_spec_provider_proto_msgTypes[15]
+	mi := &file_spec_provider_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1285,7 @@ func (x *TemplateRepository_TemplatePaths) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemplateRepository_TemplatePaths.ProtoReflect.Descriptor instead.
 func (*TemplateRepository_TemplatePaths) Descriptor() ([]byte, []int) {
-	return file_spec_provider_proto_rawDescGZIP(), []int{12, 2}
+	return file_spec_provider_proto_rawDescGZIP(), []int{13, 2}
 }
 
 func (x *TemplateRepository_TemplatePaths) GetTerraformer() string {
@@ -1281,7 +1376,13 @@ const file_spec_provider_proto_rawDesc = "" +
 	"\fclientSecret\x18\x02 \x01(\tR\fclientSecret\x12 \n" +
 	"\vserviceName\x18\x03 \x01(\tR\vserviceName\x12\x1f\n" +
 	"\bendpoint\x18\x04 \x01(\tH\x00R\bendpoint\x88\x01\x01B\v\n" +
-	"\t_endpoint\"\xa9\x05\n" +
+	"\t_endpoint\"\x99\x01\n" +
+	"\x0fRFC2136Provider\x12\x16\n" +
+	"\x06server\x18\x01 \x01(\tR\x06server\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x18\n" +
+	"\akeyName\x18\x03 \x01(\tR\akeyName\x12\"\n" +
+	"\fkeyAlgorithm\x18\x04 \x01(\tR\fkeyAlgorithm\x12\x1c\n" +
+	"\tkeySecret\x18\x05 \x01(\tR\tkeySecret\"\xdc\x05\n" +
 	"\bProvider\x12\x1a\n" +
 	"\bspecName\x18\x01 \x01(\tR\bspecName\x12,\n" +
 	"\x11cloudProviderName\x18\x02 \x01(\tR\x11cloudProviderName\x12%\n" +
@@ -1297,7 +1398,8 @@ const file_spec_provider_proto_rawDesc = "" +
 	"\bexoscale\x18\f \x01(\v2\x16.spec.ExoscaleProviderH\x00R\bexoscale\x127\n" +
 	"\tcloudrift\x18\x0e \x01(\v2\x17.spec.CloudRiftProviderH\x00R\tcloudrift\x12+\n" +
 	"\x05verda\x18\x0f \x01(\v2\x13.spec.VerdaProviderH\x00R\x05verda\x12%\n" +
-	"\x03ovh\x18\x10 \x01(\v2\x11.spec.OVHProviderH\x00R\x03ovh\x126\n" +
+	"\x03ovh\x18\x10 \x01(\v2\x11.spec.OVHProviderH\x00R\x03ovh\x121\n" +
+	"\arfc2136\x18\x11 \x01(\v2\x15.spec.RFC2136ProviderH\x00R\arfc2136\x126\n" +
 	"\ttemplates\x18\r \x01(\v2\x18.spec.TemplateRepositoryR\ttemplatesB\x0e\n" +
 	"\fProviderType\"\xa7\x05\n" +
 	"\x12TemplateRepository\x12\x16\n" +
@@ -1339,7 +1441,7 @@ func file_spec_provider_proto_rawDescGZIP() []byte {
 }
 
 var file_spec_provider_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_spec_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_spec_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_spec_provider_proto_goTypes = []any{
 	(TemplateRepository_Endpoint_Protocol)(0), // 0: spec.TemplateRepository.Endpoint.Protocol
 	(*GCPProvider)(nil),                       // 1: spec.GCPProvider
@@ -1353,11 +1455,12 @@ var file_spec_provider_proto_goTypes = []any{
 	(*CloudRiftProvider)(nil),                 // 9: spec.CloudRiftProvider
 	(*VerdaProvider)(nil),                     // 10: spec.VerdaProvider
 	(*OVHProvider)(nil),                       // 11: spec.OVHProvider
-	(*Provider)(nil),                          // 12: spec.Provider
-	(*TemplateRepository)(nil),                // 13: spec.TemplateRepository
-	(*TemplateRepository_Endpoint)(nil),       // 14: spec.TemplateRepository.Endpoint
-	(*TemplateRepository_Auth)(nil),           // 15: spec.TemplateRepository.Auth
-	(*TemplateRepository_TemplatePaths)(nil),  // 16: spec.TemplateRepository.TemplatePaths
+	(*RFC2136Provider)(nil),                   // 12: spec.RFC2136Provider
+	(*Provider)(nil),                          // 13: spec.Provider
+	(*TemplateRepository)(nil),                // 14: spec.TemplateRepository
+	(*TemplateRepository_Endpoint)(nil),       // 15: spec.TemplateRepository.Endpoint
+	(*TemplateRepository_Auth)(nil),           // 16: spec.TemplateRepository.Auth
+	(*TemplateRepository_TemplatePaths)(nil),  // 17: spec.TemplateRepository.TemplatePaths
 }
 var file_spec_provider_proto_depIdxs = []int32{
 	1,  // 0: spec.Provider.gcp:type_name -> spec.GCPProvider
@@ -1371,16 +1474,17 @@ var file_spec_provider_proto_depIdxs = []int32{
 	9,  // 8: spec.Provider.cloudrift:type_name -> spec.CloudRiftProvider
 	10, // 9: spec.Provider.verda:type_name -> spec.VerdaProvider
 	11, // 10: spec.Provider.ovh:type_name -> spec.OVHProvider
-	13, // 11: spec.Provider.templates:type_name -> spec.TemplateRepository
-	14, // 12: spec.TemplateRepository.endpoint:type_name -> spec.TemplateRepository.Endpoint
-	15, // 13: spec.TemplateRepository.auth:type_name -> spec.TemplateRepository.Auth
-	16, // 14: spec.TemplateRepository.paths:type_name -> spec.TemplateRepository.TemplatePaths
-	0,  // 15: spec.TemplateRepository.Endpoint.protocol:type_name -> spec.TemplateRepository.Endpoint.Protocol
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	12, // 11: spec.Provider.rfc2136:type_name -> spec.RFC2136Provider
+	14, // 12: spec.Provider.templates:type_name -> spec.TemplateRepository
+	15, // 13: spec.TemplateRepository.endpoint:type_name -> spec.TemplateRepository.Endpoint
+	16, // 14: spec.TemplateRepository.auth:type_name -> spec.TemplateRepository.Auth
+	17, // 15: spec.TemplateRepository.paths:type_name -> spec.TemplateRepository.TemplatePaths
+	0,  // 16: spec.TemplateRepository.Endpoint.protocol:type_name -> spec.TemplateRepository.Endpoint.Protocol
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_spec_provider_proto_init() }
@@ -1391,7 +1495,7 @@ func file_spec_provider_proto_init() {
 	file_spec_provider_proto_msgTypes[8].OneofWrappers = []any{}
 	file_spec_provider_proto_msgTypes[9].OneofWrappers = []any{}
 	file_spec_provider_proto_msgTypes[10].OneofWrappers = []any{}
-	file_spec_provider_proto_msgTypes[11].OneofWrappers = []any{
+	file_spec_provider_proto_msgTypes[12].OneofWrappers = []any{
 		(*Provider_Gcp)(nil),
 		(*Provider_Hetzner)(nil),
 		(*Provider_Oci)(nil),
@@ -1403,16 +1507,17 @@ func file_spec_provider_proto_init() {
 		(*Provider_Cloudrift)(nil),
 		(*Provider_Verda)(nil),
 		(*Provider_Ovh)(nil),
+		(*Provider_Rfc2136)(nil),
 	}
-	file_spec_provider_proto_msgTypes[12].OneofWrappers = []any{}
-	file_spec_provider_proto_msgTypes[14].OneofWrappers = []any{}
+	file_spec_provider_proto_msgTypes[13].OneofWrappers = []any{}
+	file_spec_provider_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spec_provider_proto_rawDesc), len(file_spec_provider_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   16,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -7,13 +7,21 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+// ServiceRolePrefix is the prefix of the names of the roles generated
+// for the ports allocated on the [ServiceLoadBalancer].
+const ServiceRolePrefix = "svc-"
+
 // ErrCloudflareAPIForbidden is returned when the response to the endpoint of cloudflare returns code 403,
 // which means that the endpoint cannot be reached with the current account-id/token pair.
 var ErrCloudflareAPIForbidden = errors.New("token/account-id pair with the cloudflare provider does not have acces for the necessary API")
@@ -41,6 +49,107 @@ func (c *LBcluster) HasApiRole() bool {
 	return false
 }
 
+// AllTargetPools returns the target pools of the role along with
+// the target pools of its routes, without duplicates.
+func (r *Role) AllTargetPools() []string {
+	if r == nil {
+		return nil
+	}
+
+	pools := slices.Clone(r.TargetPools)
+	for _, route := range r.Routes {
+		for _, p := range route.TargetPools {
+			if !slices.Contains(pools, p) {
+				pools = append(pools, p)
+			}
+		}
+	}
+
+	return pools
+}
+
+// Roles returns the roles generated for the ports allocated for the services,
+// forwarding the traffic to the node ports of the services on the target pools.
+func (s *ServiceLoadBalancer) Roles() []*Role {
+	if s == nil {
+		return nil
+	}
+
+	var roles []*Role
+	for _, svc := range s.Services {
+		for _, p := range svc.Ports {
+			roles = append(roles, &Role{
+				Name:        fmt.Sprintf("%s%d", ServiceRolePrefix, p.Port),
+				Protocol:    p.Protocol,
+				Port:        p.Port,
+				TargetPort:  p.NodePort,
+				TargetPools: slices.Clone(s.TargetPools),
+				RoleType:    RoleType_Ingress,
+				Settings: &Role_Settings{
+					// must be updated later, same as for the roles from the manifest.
+					EnvoyAdminPort: -1,
+				},
+			})
+		}
+	}
+
+	return roles
+}
+
+// LbPolicy returns the envoy lb_policy of the role. Sticky sessions without an
+// algorithm use RING_HASH, but the api server never uses a hash based policy, as
+// the source ip of the connections is not hashed for it.
+func (r *Role) LbPolicy() string {
+	alg := r.GetSettings().GetAlgorithm()
+	if alg == Role_ROUND_ROBIN && r.GetSettings().GetStickySessions() {
+		alg = Role_RING_HASH
+	}
+	if r.GetTargetPort() == 6443 && (alg == Role_RING_HASH || alg == Role_MAGLEV) {
+		alg = Role_ROUND_ROBIN
+	}
+	return alg.String()
+}
+
+// Weight returns the weight of the nodes of the target pool.
+func (r *Role) Weight(targetPool string) uint32 {
+	if w, ok := r.GetSettings().GetWeights()[targetPool]; ok {
+		return w
+	}
+	return 1
+}
+
+// SourceRanges returns the source ranges from which the role is reachable, for use
+// in the firewall rules of the templates. Returns 0.0.0.0/0 and ::/0 if the role is not restricted.
+func (r *Role) SourceRanges() []string {
+	if len(r.GetAllowedSourceRanges()) == 0 {
+		return []string{"0.0.0.0/0", "::/0"}
+	}
+	return r.GetAllowedSourceRanges()
+}
+
+// Endpoint returns the endpoint at which the LB is reachable, which is its
+// virtual IP if set, as the DNS records of the LB resolve to it, otherwise
+// the endpoint of its DNS.
+func (c *LBcluster) Endpoint() string {
+	if ip := c.GetVirtualIP().GetIp(); ip != "" {
+		return ip
+	}
+	return c.GetDns().GetEndpoint()
+}
+
+// Endpoints returns the [LBcluster.Endpoint] followed by the endpoint of the DNS,
+// if it differs, i.e. all of the names the LB is reachable at.
+func (c *LBcluster) Endpoints() []string {
+	var out []string
+	if ep := c.Endpoint(); ep != "" {
+		out = append(out, ep)
+	}
+	if ep := c.GetDns().GetEndpoint(); ep != "" && !slices.Contains(out, ep) {
+		out = append(out, ep)
+	}
+	return out
+}
+
 // IsApiEndpoint  checks whether the LB is selected as the API endpoint.
 func (c *LBcluster) IsApiEndpoint() bool {
 	if c == nil {
@@ -64,6 +173,127 @@ func (n *NodePool) EndpointNode() *Node {
 	return nil
 }
 
+// Default values of the [RollingUpdateStrategy] used when not specified.
+const (
+	DefaultMaxSurge       = "100%"
+	DefaultMaxUnavailable = "0"
+)
+
+// Resolve resolves the maxSurge and maxUnavailable of the strategy into an
+// absolute number of nodes for a nodepool with the given count. A percentage
+// is rounded up for maxSurge and rounded down for maxUnavailable. If both
+// values would resolve to 0 the surge is set to 1 so that the rolling update
+// can make progress. Works on a nil strategy, returning the defaults.
+func (s *RollingUpdateStrategy) Resolve(count int32) (surge, unavailable int32) {
+	maxSurge, maxUnavailable := s.GetMaxSurge(), s.GetMaxUnavailable()
+	if maxSurge == "" {
+		maxSurge = DefaultMaxSurge
+	}
+	if maxUnavailable == "" {
+		maxUnavailable = DefaultMaxUnavailable
+	}
+
+	surge = resolveIntOrPercent(maxSurge, count, true)
+	unavailable = min(resolveIntOrPercent(maxUnavailable, count, false), count)
+	if surge == 0 && unavailable == 0 {
+		surge = 1
+	}
+	return surge, unavailable
+}
+
+// resolveIntOrPercent resolves a value that is either an absolute number or
+// a percentage of total. Invalid values resolve to 0.
+func resolveIntOrPercent(v string, total int32, roundUp bool) int32 {
+	p, isPercent := strings.CutSuffix(v, "%")
+	n, err := strconv.ParseInt(p, 10, 32)
+	if err != nil || n < 0 {
+		return 0
+	}
+	if !isPercent {
+		return int32(n)
+	}
+	scaled := n * int64(total)
+	if roundUp {
+		return int32((scaled + 99) / 100)
+	}
+	return int32(scaled / 100)
+}
+
+// DefaultDrainTimeout is the timeout of the drain of a node used when not specified.
+const DefaultDrainTimeout = 30 * time.Minute
+
+// DefaultDrainPolicy returns the [DrainPolicy] used for the node pools that do not
+// specify one. The nodes are deleted once the [DefaultDrainTimeout] is reached, the
+// pods managed by DaemonSets are ignored and the data of emptyDir volumes is deleted.
+func DefaultDrainPolicy() *DrainPolicy {
+	return &DrainPolicy{
+		Timeout:            DefaultDrainTimeout.String(),
+		IgnoreDaemonSets:   true,
+		DeleteEmptyDirData: true,
+	}
+}
+
+// DrainTimeout returns the timeout of the drain of a single node.
+// Returns the [DefaultDrainTimeout] if the timeout is not set or is invalid.
+func (p *DrainPolicy) DrainTimeout() time.Duration {
+	if d, err := time.ParseDuration(p.GetTimeout()); err == nil && d > 0 {
+		return d
+	}
+	return DefaultDrainTimeout
+}
+
+// PublicV4 returns the public IPv4 address of the node, or an empty
+// string if the node is reachable only via IPv6.
+func (n *Node) PublicV4() string {
+	if addr, err := netip.ParseAddr(n.GetPublic()); err == nil && addr.Is4() {
+		return n.GetPublic()
+	}
+	return ""
+}
+
+// PublicV6 returns the public IPv6 address of the node, or an empty
+// string if the node has none.
+func (n *Node) PublicV6() string {
+	if n.GetPublicIPv6() != "" {
+		return n.GetPublicIPv6()
+	}
+	if addr, err := netip.ParseAddr(n.GetPublic()); err == nil && addr.Is6() {
+		return n.GetPublic()
+	}
+	return ""
+}
+
+// IsDualStack checks whether the cluster has an IPv6 network
+// configured alongside the IPv4 network.
+func (c *K8Scluster) IsDualStack() bool { return c.GetNetworkIPv6() != "" }
+
+// IPv6 ranges of the pods and services of the dual-stack clusters,
+// which the IPv6 network of the cluster must not overlap.
+const (
+	PodSubnetIPv6     = "fd01::/48"
+	ServiceSubnetIPv6 = "fd02::/108"
+)
+
+// PrivateIPv6 returns the IPv6 address of a node within the IPv6 network of the cluster.
+// The address is derived from the private IPv4 address of the node, which is embedded
+// into the lower 32 bits of the network. Returns an empty string if either of the
+// arguments is not valid.
+func PrivateIPv6(networkIPv6, privateIPv4 string) string {
+	network, err := netip.ParsePrefix(networkIPv6)
+	if err != nil || !network.Addr().Is6() || network.Bits() > 96 {
+		return ""
+	}
+	private, err := netip.ParseAddr(privateIPv4)
+	if err != nil || !private.Is4() {
+		return ""
+	}
+
+	b := network.Masked().Addr().As16()
+	v4 := private.As4()
+	copy(b[12:], v4[:])
+	return netip.AddrFrom16(b).String()
+}
+
 // Credentials extract the key for the provider to be used within terraform.
 func (pr *Provider) Credentials() string {
 	if pr == nil {
@@ -93,6 +323,8 @@ func (pr *Provider) Credentials() string {
 		return p.Verda.ClientSecret
 	case *Provider_Ovh:
 		return p.Ovh.ClientSecret
+	case *Provider_Rfc2136:
+		return p.Rfc2136.KeySecret
 	default:
 		panic(fmt.Sprintf("unexpected type %T", pr.ProviderType))
 	}
@@ -204,6 +436,18 @@ func (pr *Provider) CopyCredentials(other *Provider) (updated bool) {
 		p.Ovh.ClientSecret = o.Ovh.ClientSecret
 		p.Ovh.ServiceName = o.Ovh.ServiceName
 		updated = true
+	case *Provider_Rfc2136:
+		o, ok := other.ProviderType.(*Provider_Rfc2136)
+		if !ok {
+			return
+		}
+
+		p.Rfc2136.Server = o.Rfc2136.Server
+		p.Rfc2136.Port = o.Rfc2136.Port
+		p.Rfc2136.KeyName = o.Rfc2136.KeyName
+		p.Rfc2136.KeyAlgorithm = o.Rfc2136.KeyAlgorithm
+		p.Rfc2136.KeySecret = o.Rfc2136.KeySecret
+		updated = true
 	default:
 		// do nothing.
 	}
@@ -319,6 +563,17 @@ func (pr *Provider) CredentialsEqual(other *Provider) (equal bool) {
 		serviceName := p.Ovh.ServiceName == o.Ovh.ServiceName
 
 		equal = clientID && clientSecret && serviceName
+	case *Provider_Rfc2136:
+		o, ok := other.ProviderType.(*Provider_Rfc2136)
+		if !ok {
+			return
+		}
+
+		server := p.Rfc2136.Server == o.Rfc2136.Server && p.Rfc2136.Port == o.Rfc2136.Port
+		key := p.Rfc2136.KeyName == o.Rfc2136.KeyName && p.Rfc2136.KeyAlgorithm == o.Rfc2136.KeyAlgorithm
+		secret := p.Rfc2136.KeySecret == o.Rfc2136.KeySecret
+
+		equal = server && key && secret
 	default:
 		// do nothing.
 	}
@@ -304,7 +304,8 @@ func (te *Task) ConsumeUpdateResult(result *TaskResult_Update) error {
 
 			for k, v := range delta.AnsReplaceTargetPools.Roles {
 				consumed.Roles[k] = &Update_ReplacedTargetPools_TargetPools{
-					Pools: v.Pools,
+					Pools:  v.Pools,
+					Routes: v.Routes,
 				}
 			}
 
@@ -390,6 +391,12 @@ func (te *Task) ConsumeUpdateResult(result *TaskResult_Update) error {
 					OldApiEndpoint: delta.TfReplaceDns.OldApiEndpoint,
 				},
 			}
+		case *Update_TfReplaceDnsRecords:
+			update.Delta = &Update_ReplacedDnsRecords_{
+				ReplacedDnsRecords: &Update_ReplacedDnsRecords{
+					Handle: delta.TfReplaceDnsRecords.Handle,
+				},
+			}
 		default:
 			// other messages are non-consumable, do nothing.
 		}
@@ -1,7 +1,10 @@
 package spec
 
 import (
+	"slices"
 	"testing"
+
+	"google.golang.org/protobuf/proto"
 )
 
 // nolint
@@ -396,6 +399,22 @@ func TestCopyCredentials(t *testing.T) {
 			},
 		},
 
+		{
+			name: "RFC2136 copies the server and the TSIG key",
+			pr: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns1.example.com", KeyName: "old.", KeyAlgorithm: "hmac-sha256", KeySecret: "old-secret"},
+			}},
+			other: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns2.example.com", Port: 5353, KeyName: "new.", KeyAlgorithm: "hmac-sha512", KeySecret: "new-secret"},
+			}},
+			assertFunc: func(t *testing.T, pr *Provider) {
+				want := &RFC2136Provider{Server: "ns2.example.com", Port: 5353, KeyName: "new.", KeyAlgorithm: "hmac-sha512", KeySecret: "new-secret"}
+				if got := pr.GetRfc2136(); !proto.Equal(got, want) {
+					t.Errorf("CopyCredentials() = %v, want %v", got, want)
+				}
+			},
+		},
+
 		{
 			name: "AWS source provider is not mutated after copy",
 			pr: &Provider{ProviderType: &Provider_Aws{
@@ -819,6 +838,26 @@ func TestCredentialsEqual(t *testing.T) {
 			}},
 			expected: true,
 		},
+		{
+			name: "RFC2136 same key, different secret",
+			pr: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns1.example.com", KeyName: "claudie.", KeyAlgorithm: "hmac-sha256", KeySecret: "secret-A"},
+			}},
+			other: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns1.example.com", KeyName: "claudie.", KeyAlgorithm: "hmac-sha256", KeySecret: "secret-B"},
+			}},
+			expected: false,
+		},
+		{
+			name: "RFC2136 same credentials",
+			pr: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns1.example.com", Port: 53, KeyName: "claudie.", KeyAlgorithm: "hmac-sha256", KeySecret: "secret"},
+			}},
+			other: &Provider{ProviderType: &Provider_Rfc2136{
+				Rfc2136: &RFC2136Provider{Server: "ns1.example.com", Port: 53, KeyName: "claudie.", KeyAlgorithm: "hmac-sha256", KeySecret: "secret"},
+			}},
+			expected: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -830,3 +869,157 @@ func TestCredentialsEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestAllTargetPools(t *testing.T) {
+	var nilRole *Role
+	if got := nilRole.AllTargetPools(); got != nil {
+		t.Errorf("AllTargetPools() on nil = %v, want nil", got)
+	}
+
+	r := &Role{
+		TargetPools: []string{"np-1", "np-2"},
+		Routes: []*Role_Route{
+			{Host: "a.example.com", TargetPools: []string{"np-2", "np-3"}},
+			{PathPrefix: "/api", TargetPools: []string{"np-4", "np-3"}},
+		},
+	}
+
+	want := []string{"np-1", "np-2", "np-3", "np-4"}
+	if got := r.AllTargetPools(); !slices.Equal(got, want) {
+		t.Errorf("AllTargetPools() = %v, want %v", got, want)
+	}
+}
+
+func TestLbPolicy(t *testing.T) {
+	tests := []struct {
+		name string
+		role *Role
+		want string
+	}{
+		{name: "default", role: &Role{TargetPort: 8080, Settings: &Role_Settings{}}, want: "ROUND_ROBIN"},
+		{name: "sticky", role: &Role{TargetPort: 8080, Settings: &Role_Settings{StickySessions: true}}, want: "RING_HASH"},
+		{name: "maglev", role: &Role{TargetPort: 8080, Settings: &Role_Settings{StickySessions: true, Algorithm: Role_MAGLEV}}, want: "MAGLEV"},
+		{name: "random", role: &Role{TargetPort: 8080, Settings: &Role_Settings{Algorithm: Role_RANDOM}}, want: "RANDOM"},
+		{name: "api-server-sticky", role: &Role{TargetPort: 6443, Settings: &Role_Settings{StickySessions: true}}, want: "ROUND_ROBIN"},
+		{name: "api-server-least-request", role: &Role{TargetPort: 6443, Settings: &Role_Settings{Algorithm: Role_LEAST_REQUEST}}, want: "LEAST_REQUEST"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.role.LbPolicy(); got != tt.want {
+				t.Errorf("LbPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	r := &Role{Settings: &Role_Settings{Weights: map[string]uint32{"np-1": 0, "np-2": 50}}}
+	if got := r.Weight("np-1"); got != 0 {
+		t.Errorf("Weight(np-1) = %v, want 0", got)
+	}
+	if got := r.Weight("np-3"); got != 1 {
+		t.Errorf("Weight(np-3) = %v, want 1", got)
+	}
+}
+
+func TestRollingUpdateStrategyResolve(t *testing.T) {
+	tests := []struct {
+		name            string
+		strategy        *RollingUpdateStrategy
+		count           int32
+		wantSurge       int32
+		wantUnavailable int32
+	}{
+		{name: "nil-defaults", strategy: nil, count: 3, wantSurge: 3, wantUnavailable: 0},
+		{name: "empty-defaults", strategy: &RollingUpdateStrategy{}, count: 5, wantSurge: 5, wantUnavailable: 0},
+		{name: "absolute", strategy: &RollingUpdateStrategy{MaxSurge: "1", MaxUnavailable: "2"}, count: 5, wantSurge: 1, wantUnavailable: 2},
+		{name: "percent-rounding", strategy: &RollingUpdateStrategy{MaxSurge: "25%", MaxUnavailable: "25%"}, count: 5, wantSurge: 2, wantUnavailable: 1},
+		{name: "unavailable-capped", strategy: &RollingUpdateStrategy{MaxSurge: "0", MaxUnavailable: "10"}, count: 3, wantSurge: 0, wantUnavailable: 3},
+		{name: "both-zero", strategy: &RollingUpdateStrategy{MaxSurge: "0", MaxUnavailable: "10%"}, count: 5, wantSurge: 1, wantUnavailable: 0},
+		{name: "zero-count", strategy: &RollingUpdateStrategy{MaxSurge: "50%"}, count: 0, wantSurge: 1, wantUnavailable: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			surge, unavailable := tt.strategy.Resolve(tt.count)
+			if surge != tt.wantSurge || unavailable != tt.wantUnavailable {
+				t.Errorf("Resolve(%v) = (%v, %v), want (%v, %v)", tt.count, surge, unavailable, tt.wantSurge, tt.wantUnavailable)
+			}
+		})
+	}
+}
+
+func TestSourceRanges(t *testing.T) {
+	r := &Role{}
+	if got := r.SourceRanges(); !slices.Equal(got, []string{"0.0.0.0/0", "::/0"}) {
+		t.Errorf("SourceRanges() = %v, want [0.0.0.0/0 ::/0]", got)
+	}
+
+	r.AllowedSourceRanges = []string{"10.0.0.0/8", "2001:db8::/32"}
+	if got := r.SourceRanges(); !slices.Equal(got, r.AllowedSourceRanges) {
+		t.Errorf("SourceRanges() = %v, want %v", got, r.AllowedSourceRanges)
+	}
+}
+
+func TestLBclusterEndpoint(t *testing.T) {
+	lb := &LBcluster{Dns: &DNS{Endpoint: "lb.example.com"}}
+	if got := lb.Endpoint(); got != "lb.example.com" {
+		t.Errorf("Endpoint() = %v, want lb.example.com", got)
+	}
+
+	if got := lb.Endpoints(); !slices.Equal(got, []string{"lb.example.com"}) {
+		t.Errorf("Endpoints() = %v, want [lb.example.com]", got)
+	}
+
+	lb.VirtualIP = &VirtualIP{Ip: "192.168.1.100"}
+	if got := lb.Endpoint(); got != "192.168.1.100" {
+		t.Errorf("Endpoint() = %v, want 192.168.1.100", got)
+	}
+	if got := lb.Endpoints(); !slices.Equal(got, []string{"192.168.1.100", "lb.example.com"}) {
+		t.Errorf("Endpoints() = %v, want [192.168.1.100 lb.example.com]", got)
+	}
+
+	if got := (*LBcluster)(nil).Endpoint(); got != "" {
+		t.Errorf("Endpoint() = %v, want empty", got)
+	}
+	if got := (*LBcluster)(nil).Endpoints(); got != nil {
+		t.Errorf("Endpoints() = %v, want nil", got)
+	}
+}
+
+func TestNodeAddresses(t *testing.T) {
+	tests := []struct {
+		name   string
+		node   *Node
+		v4, v6 string
+	}{
+		{name: "ipv4", node: &Node{Public: "1.1.1.1"}, v4: "1.1.1.1"},
+		{name: "dual-stack", node: &Node{Public: "1.1.1.1", PublicIPv6: "2001:db8::1"}, v4: "1.1.1.1", v6: "2001:db8::1"},
+		{name: "ipv6", node: &Node{Public: "2001:db8::1"}, v6: "2001:db8::1"},
+		{name: "nil", node: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.node.PublicV4(); got != tt.v4 {
+				t.Errorf("PublicV4() = %v, want %v", got, tt.v4)
+			}
+			if got := tt.node.PublicV6(); got != tt.v6 {
+				t.Errorf("PublicV6() = %v, want %v", got, tt.v6)
+			}
+		})
+	}
+}
+
+func TestPrivateIPv6(t *testing.T) {
+	tests := []struct {
+		network, private, want string
+	}{
+		{network: "fd00:192:168::/96", private: "192.168.2.3", want: "fd00:192:168::c0a8:203"},
+		{network: "fd00:192:168::1/64", private: "192.168.2.3", want: "fd00:192:168::c0a8:203"},
+		{network: "fd00:192:168::/112", private: "192.168.2.3", want: ""},
+		{network: "192.168.2.0/24", private: "192.168.2.3", want: ""},
+		{network: "fd00:192:168::/96", private: "", want: ""},
+	}
+	for _, tt := range tests {
+		if got := PrivateIPv6(tt.network, tt.private); got != tt.want {
+			t.Errorf("PrivateIPv6(%v, %v) = %v, want %v", tt.network, tt.private, got, tt.want)
+		}
+	}
+}
@@ -58,8 +58,10 @@ func createNoProxyList(
 	}
 
 	for _, lbCluster := range lbs {
-		noProxyList = fmt.Sprintf("%s,%s", noProxyList, lbCluster.Dns.Endpoint)
-		for _, ep := range lbCluster.Dns.AlternativeNames {
+		for _, ep := range lbCluster.Endpoints() {
+			noProxyList = fmt.Sprintf("%s,%s", noProxyList, ep)
+		}
+		for _, ep := range lbCluster.GetDns().GetAlternativeNames() {
 			if ep.Endpoint != "" {
 				noProxyList = fmt.Sprintf("%s,%s", noProxyList, ep.Endpoint)
 			}
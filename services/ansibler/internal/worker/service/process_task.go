@@ -56,6 +56,8 @@ type (
 		Nodepools      NodePools
 		ClusterID      string
 		ClusterNetwork string
+		// ClusterNetworkIPv6 is set only for dual-stack clusters.
+		ClusterNetworkIPv6 string
 	}
 
 	AllNodesInventoryData struct {
@@ -116,7 +118,7 @@ passes:
 		case spec.StageAnsibler_INSTALL_VPN:
 			InstallVPN(logger, work.InputManifestName, processlimit, tracker)
 		case spec.StageAnsibler_RECONCILE_LOADBALANCERS:
-			ReconcileLoadBalancers(logger, work.InputManifestName, processlimit, tracker)
+			ReconcileLoadBalancers(ctx, logger, work.InputManifestName, processlimit, tracker)
 		case spec.StageAnsibler_REMOVE_CLAUDIE_UTILITIES:
 			RemoveUtilities(logger, work.InputManifestName, processlimit, tracker)
 		case spec.StageAnsibler_UPDATE_API_ENDPOINT:
@@ -95,8 +95,9 @@ func InstallVPN(
 					Dynamic: nodepools.Dynamic(k8snps),
 					Static:  nodepools.Static(k8snps),
 				},
-				ClusterID:      k8s.ClusterInfo.Id(),
-				ClusterNetwork: k8s.Network,
+				ClusterID:          k8s.ClusterInfo.Id(),
+				ClusterNetwork:     k8s.Network,
+				ClusterNetworkIPv6: k8s.NetworkIPv6,
 			},
 		},
 	}
@@ -116,8 +117,9 @@ func InstallVPN(
 				Dynamic: nodepools.Dynamic(lbnps),
 				Static:  nodepools.Static(lbnps),
 			},
-			ClusterID:      handle,
-			ClusterNetwork: k8s.Network,
+			ClusterID:          handle,
+			ClusterNetwork:     k8s.Network,
+			ClusterNetworkIPv6: k8s.NetworkIPv6,
 		})
 	}
 
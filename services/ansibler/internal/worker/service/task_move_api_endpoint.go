@@ -58,7 +58,7 @@ func MoveApiEndpoint(
 
 		ep.State = spec.ApiEndpointChangeState_EndpointRenamed
 		ep.CurrentEndpointId = *delta.ReplacedDns.OldApiEndpoint
-		ep.DesiredEndpointId = lb.Dns.Endpoint // this should have been build by now.
+		ep.DesiredEndpointId = lb.Endpoint() // this should have been build by now.
 
 		if ep.CurrentEndpointId == "" || ep.DesiredEndpointId == "" {
 			logger.
@@ -174,7 +174,7 @@ func handleAPIEndpointChange(
 		oldEndpoint = n.Public
 
 		state.LoadBalancers[lb].UsedApiEndpoint = true
-		newEndpoint = state.LoadBalancers[lb].Dns.Endpoint
+		newEndpoint = state.LoadBalancers[lb].Endpoint()
 	case spec.ApiEndpointChangeState_DetachingLoadBalancer:
 		lb := clusters.IndexLoadbalancerById(change.CurrentEndpointId, state.LoadBalancers)
 		if lb < 0 {
@@ -187,7 +187,7 @@ func handleAPIEndpointChange(
 		}
 
 		state.LoadBalancers[lb].UsedApiEndpoint = false
-		oldEndpoint = state.LoadBalancers[lb].Dns.Endpoint
+		oldEndpoint = state.LoadBalancers[lb].Endpoint()
 
 		n.NodeType = spec.NodeType_apiEndpoint
 		newEndpoint = n.Public
@@ -206,8 +206,8 @@ func handleAPIEndpointChange(
 		state.LoadBalancers[lbc].UsedApiEndpoint = false
 		state.LoadBalancers[lbd].UsedApiEndpoint = true
 
-		oldEndpoint = state.LoadBalancers[lbc].Dns.Endpoint
-		newEndpoint = state.LoadBalancers[lbd].Dns.Endpoint
+		oldEndpoint = state.LoadBalancers[lbc].Endpoint()
+		newEndpoint = state.LoadBalancers[lbd].Endpoint()
 	}
 
 	logger.Debug().Str("LB-cluster", state.K8S.ClusterInfo.Id()).Msgf("Changing the API endpoint from %s to %s", oldEndpoint, newEndpoint)
@@ -1,12 +1,16 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"net/netip"
 	"os"
 	"path/filepath"
 	"slices"
+	"time"
 
 	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/internal/certificates"
 	"github.com/berops/claudie/internal/clusters"
 	"github.com/berops/claudie/internal/concurrent"
 	"github.com/berops/claudie/internal/fileutils"
@@ -43,6 +47,14 @@ const (
 
 	// envoyLDS is the generated dynamic listeners config for a single role.
 	envoyLDS = "lds_temp.yml"
+
+	// obtainCertificateTimeout bounds the obtaining of a single certificate,
+	// including the propagation of the TXT records of the DNS-01 challenges.
+	obtainCertificateTimeout = 10 * time.Minute
+
+	// keepalivedPlaybookName to which the template will be generated to
+	// for the setup of the virtual IP of the load-balancer.
+	keepalivedPlaybookName = "keepalived.yml"
 )
 
 type (
@@ -67,9 +79,58 @@ type (
 		NodeExporterPort int
 	}
 
+	KeepalivedTemplateParams struct {
+		LoadBalancer string
+		VirtualIP    *spec.VirtualIP
+		// Admin ports of the envoy instances of the roles,
+		// whose readiness determines the health of a node.
+		EnvoyAdminPorts []int32
+	}
+
 	RolesTemplateParams struct {
 		Role        *spec.Role
-		TargetNodes []*spec.Node
+		TargetNodes []TargetNodeTemplateParams
+
+		// Routes of the role with the http protocol, each
+		// forwarding to its own envoy cluster.
+		Routes []RouteTemplateParams
+		// VirtualHosts of the role with the http protocol.
+		VirtualHosts []VirtualHostTemplateParams
+		// SourceRanges from which the role is reachable, not restricted if empty.
+		SourceRanges []SourceRangeTemplateParams
+		// IPv6 is set if any of the loadbalancer nodes has a public IPv6
+		// address, in which case the role also listens on IPv6.
+		IPv6 bool
+	}
+
+	SourceRangeTemplateParams struct {
+		Address   string
+		PrefixLen int
+	}
+
+	RouteTemplateParams struct {
+		// Cluster is the name of the envoy cluster of the route.
+		Cluster     string
+		Route       *spec.Role_Route
+		TargetNodes []TargetNodeTemplateParams
+	}
+
+	TargetNodeTemplateParams struct {
+		*spec.Node
+		// Weight of the node, from the weight of its target pool.
+		Weight uint32
+	}
+
+	VirtualHostTemplateParams struct {
+		Name    string
+		Domains []string
+		// Routes in the order in which they are matched.
+		Routes []HTTPRouteTemplateParams
+	}
+
+	HTTPRouteTemplateParams struct {
+		PathPrefix string
+		Cluster    string
 	}
 
 	EnvoyConfigTemplateParams struct {
@@ -85,6 +146,7 @@ type (
 )
 
 func ReconcileLoadBalancers(
+	ctx context.Context,
 	logger zerolog.Logger,
 	projectName string,
 	processLimit *semaphore.Weighted,
@@ -132,6 +194,7 @@ func ReconcileLoadBalancers(
 				for _, role := range lbs[idx].Roles {
 					if n, ok := tg.AnsReplaceTargetPools.Roles[role.Name]; ok {
 						role.TargetPools = n.Pools
+						role.Routes = n.Routes
 					}
 				}
 			}
@@ -167,15 +230,24 @@ func ReconcileLoadBalancers(
 		K8sClusterId:         clusterId,
 	}
 
+	obtained, err := obtainCertificates(ctx, logger, lbs)
+	if err != nil {
+		logger.Err(err).Msg("Failed to obtain certificates")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
 	if err := setUpLoadbalancers(logger, data, unreachable, processLimit); err != nil {
 		logger.Err(err).Msg("Failed to setup loadbalancers")
 		tracker.Diagnostics.Push(err)
 		return
 	}
 
-	// If replacement was done as part of a scheduled update, report back the changes.
+	// If replacement was done as part of a scheduled update, or new certificates
+	// were obtained, report back the changes.
 	switch {
 	case
+		obtained,
 		tracker.Task.GetUpdate().GetAnsReplaceTargetPools() != nil,
 		tracker.Task.GetUpdate().GetAnsReplaceRoleInternalSettings() != nil:
 
@@ -187,6 +259,38 @@ func ReconcileLoadBalancers(
 	logger.Info().Msg("Successfully reconciled LoadBalancers")
 }
 
+// obtainCertificates obtains the certificates for the roles with TLS termination that are
+// missing one or need to be renewed. Returns true if any certificate was obtained.
+func obtainCertificates(ctx context.Context, logger zerolog.Logger, lbs []*spec.LBcluster) (bool, error) {
+	var obtained bool
+	for _, lb := range lbs {
+		names := certificates.Names(lb)
+		for _, role := range lb.Roles {
+			tls := role.GetSettings().GetTls()
+			if tls == nil || !certificates.NeedsRenewal(tls, names, time.Now()) {
+				continue
+			}
+
+			logger.Info().Str("LB-cluster", lb.ClusterInfo.Id()).Msgf("Obtaining certificate for role %s for %v", role.Name, names)
+
+			solver, err := certificates.NewSolver(lb.Dns)
+			if err != nil {
+				return obtained, fmt.Errorf("failed to obtain certificate for role %s of %s: %w", role.Name, lb.ClusterInfo.Id(), err)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, obtainCertificateTimeout)
+			err = certificates.Obtain(ctx, tls, names, solver)
+			cancel()
+			if err != nil {
+				return obtained, fmt.Errorf("failed to obtain certificate for role %s of %s: %w", role.Name, lb.ClusterInfo.Id(), err)
+			}
+
+			obtained = true
+		}
+	}
+	return obtained, nil
+}
+
 // setUpLoadbalancers sets up the loadbalancers along with DNS and verifies their configuration
 func setUpLoadbalancers(
 	logger zerolog.Logger,
@@ -265,6 +369,12 @@ func setUpLoadbalancers(
 			return err
 		}
 
+		if lbCluster.VirtualIP != nil {
+			if err := setUpKeepalived(lbCluster, clusterDirectory, processLimit); err != nil {
+				return err
+			}
+		}
+
 		logger.Info().Msg("Loadbalancer cluster successfully set up")
 		return nil
 	})
@@ -300,6 +410,41 @@ func setUpNodeExporter(lbCluster *spec.LBcluster, clusterDirectory string, proce
 	return nil
 }
 
+// setUpKeepalived sets up keepalived on each node of the LB cluster, which assigns the virtual IP
+// of the LB cluster to one of the healthy nodes and fails it over to another node via VRRP.
+func setUpKeepalived(lbCluster *spec.LBcluster, clusterDirectory string, processLimit *semaphore.Weighted) error {
+	playbookParameters := KeepalivedTemplateParams{
+		LoadBalancer: lbCluster.ClusterInfo.Name,
+		VirtualIP:    lbCluster.VirtualIP,
+	}
+	for _, role := range lbCluster.Roles {
+		playbookParameters.EnvoyAdminPorts = append(playbookParameters.EnvoyAdminPorts, role.GetSettings().GetEnvoyAdminPort())
+	}
+
+	template, err := tmplutils.LoadTemplate(templates.KeepalivedPlaybookTemplate)
+	if err != nil {
+		return fmt.Errorf("error while loading %s template for keepalived playbook : %w", lbCluster.ClusterInfo.Name, err)
+	}
+
+	tpl := tmplutils.Templates{Directory: clusterDirectory}
+	if err := tpl.Generate(template, keepalivedPlaybookName, playbookParameters); err != nil {
+		return fmt.Errorf("error while generating %s for %s : %w", keepalivedPlaybookName, lbCluster.ClusterInfo.Name, err)
+	}
+
+	ansible := utils.Ansible{
+		RetryCount:        2,
+		Directory:         clusterDirectory,
+		Playbook:          keepalivedPlaybookName,
+		Inventory:         utils.InventoryFileName,
+		SpawnProcessLimit: processLimit,
+	}
+
+	if err = ansible.RunAnsiblePlaybook(fmt.Sprintf("LB - %s-%s", lbCluster.ClusterInfo.Name, lbCluster.ClusterInfo.Hash)); err != nil {
+		return fmt.Errorf("error while running ansible for %s : %w", lbCluster.ClusterInfo.Name, err)
+	}
+	return nil
+}
+
 // Installs or updates Docker and Docker Compose for setting up the load balancing functionality.
 // Each defined "role" of the load balancer cluster is deployed as a separate Docker container.
 // These containers are orchestrated using a Docker Compose file specific to the load balancer.
@@ -422,6 +567,13 @@ func setupEnvoyProxyViaDocker(
 }
 
 func roleTargetPools(lbCluster *spec.LBcluster, targetK8sNodepool []*spec.NodePool) (ri []RolesTemplateParams) {
+	var ipv6 bool
+	for _, np := range lbCluster.GetClusterInfo().GetNodePools() {
+		for _, n := range np.Nodes {
+			ipv6 = ipv6 || n.PublicV6() != ""
+		}
+	}
+
 	for _, role := range lbCluster.Roles {
 		pools := targetK8sNodepool
 		if role.RoleType == spec.RoleType_ApiServer {
@@ -431,34 +583,157 @@ func roleTargetPools(lbCluster *spec.LBcluster, targetK8sNodepool []*spec.NodePo
 			pools = slices.Collect(nodepools.Control(pools))
 		}
 
+		var routes []RouteTemplateParams
+		for i, route := range role.Routes {
+			routes = append(routes, RouteTemplateParams{
+				Cluster:     fmt.Sprintf("%s_route_%d", role.Name, i),
+				Route:       route,
+				TargetNodes: targetNodes(role, route.TargetPools, pools),
+			})
+		}
+
 		ri = append(ri, RolesTemplateParams{
-			Role:        role,
-			TargetNodes: targetNodes(role.TargetPools, pools),
+			Role:         role,
+			TargetNodes:  targetNodes(role, role.TargetPools, pools),
+			Routes:       routes,
+			VirtualHosts: virtualHosts(role.Name, routes),
+			SourceRanges: sourceRanges(role, targetK8sNodepool),
+			IPv6:         ipv6,
 		})
 	}
 
 	return
 }
 
-func targetNodes(targetPools []string, targetk8sPools []*spec.NodePool) (nodes []*spec.Node) {
-	var pools []*spec.NodePool
+// sourceRanges returns the allowed source ranges of the role. The nodes of the kubernetes
+// cluster reach the api server via the public endpoint of the loadbalancer, thus their
+// public IPv4 and IPv6 addresses are always allowed for the api server role.
+func sourceRanges(role *spec.Role, targetK8sNodepool []*spec.NodePool) []SourceRangeTemplateParams {
+	if len(role.AllowedSourceRanges) == 0 {
+		return nil
+	}
+
+	var prefixes []netip.Prefix
+	for _, r := range role.AllowedSourceRanges {
+		p, err := netip.ParsePrefix(r)
+		if err != nil {
+			// validated in the manifest, should not happen.
+			continue
+		}
+		prefixes = append(prefixes, p)
+	}
+
+	if role.RoleType == spec.RoleType_ApiServer {
+		for _, np := range targetK8sNodepool {
+			for _, n := range np.Nodes {
+				for _, ip := range []string{n.Public, n.PublicIPv6} {
+					if addr, err := netip.ParseAddr(ip); err == nil {
+						prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+					}
+				}
+			}
+		}
+	}
+
+	var result []SourceRangeTemplateParams
+	for _, p := range prefixes {
+		r := SourceRangeTemplateParams{Address: p.Masked().Addr().String(), PrefixLen: p.Bits()}
+		if !slices.Contains(result, r) {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// virtualHosts groups the routes by their hosts. The routes without a host are matched
+// for all of the hosts, after the routes of the host with the same path prefix. Within
+// a virtual host the routes are ordered by the longest path prefix, as envoy uses the
+// first matching route. Requests not matching any route are forwarded to the cluster
+// of the role.
+func virtualHosts(role string, routes []RouteTemplateParams) []VirtualHostTemplateParams {
+	var (
+		hosts   []string
+		anyHost []RouteTemplateParams
+	)
+
+	for _, r := range routes {
+		if r.Route.Host == "" {
+			anyHost = append(anyHost, r)
+		} else if !slices.Contains(hosts, r.Route.Host) {
+			hosts = append(hosts, r.Route.Host)
+		}
+	}
+
+	var result []VirtualHostTemplateParams
+	for i, host := range hosts {
+		var matched []RouteTemplateParams
+		for _, r := range routes {
+			if r.Route.Host == host {
+				matched = append(matched, r)
+			}
+		}
+
+		result = append(result, VirtualHostTemplateParams{
+			Name:    fmt.Sprintf("%s_host_%d", role, i),
+			Domains: []string{host},
+			Routes:  httpRoutes(role, append(matched, anyHost...)),
+		})
+	}
+
+	return append(result, VirtualHostTemplateParams{
+		Name:    fmt.Sprintf("%s_any", role),
+		Domains: []string{"*"},
+		Routes:  httpRoutes(role, anyHost),
+	})
+}
+
+// httpRoutes orders the routes by the longest path prefix, keeping only the first route
+// for the same path prefix, and adds the route to the cluster of the role for the
+// requests not matching any of the routes.
+func httpRoutes(role string, routes []RouteTemplateParams) []HTTPRouteTemplateParams {
+	var result []HTTPRouteTemplateParams
+	for _, r := range routes {
+		if !slices.ContainsFunc(result, func(o HTTPRouteTemplateParams) bool { return o.PathPrefix == r.Route.PathPrefix }) {
+			result = append(result, HTTPRouteTemplateParams{PathPrefix: r.Route.PathPrefix, Cluster: r.Cluster})
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b HTTPRouteTemplateParams) int {
+		return len(b.PathPrefix) - len(a.PathPrefix)
+	})
+
+	if !slices.ContainsFunc(result, func(o HTTPRouteTemplateParams) bool { return o.PathPrefix == "/" }) {
+		result = append(result, HTTPRouteTemplateParams{PathPrefix: "/", Cluster: role})
+	}
+
+	return result
+}
 
+// targetNodes returns the nodes of the target pools weighted by the weights of the role.
+// Nodes of the target pools with a weight of 0 are left out.
+func targetNodes(role *spec.Role, targetPools []string, targetk8sPools []*spec.NodePool) (nodes []TargetNodeTemplateParams) {
 	for _, target := range targetPools {
+		weight := role.Weight(target)
+		if weight == 0 {
+			continue
+		}
+
 		for _, np := range targetk8sPools {
+			var matches bool
 			if np.GetDynamicNodePool() != nil {
-				if nodepools.HasNodePoolTypeOf(target, np.Name) {
-					pools = append(pools, np)
-				}
+				matches = nodepools.HasNodePoolTypeOf(target, np.Name)
 			} else if np.GetStaticNodePool() != nil {
-				if target == np.Name {
-					pools = append(pools, np)
-				}
+				matches = target == np.Name
 			}
-		}
-	}
 
-	for _, np := range pools {
-		nodes = append(nodes, np.Nodes...)
+			if !matches {
+				continue
+			}
+
+			for _, n := range np.Nodes {
+				nodes = append(nodes, TargetNodeTemplateParams{Node: n, Weight: weight})
+			}
+		}
 	}
 
 	return
@@ -0,0 +1,448 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berops/claudie/internal/tmplutils"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/ansibler/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestVirtualHosts(t *testing.T) {
+	routes := []RouteTemplateParams{
+		{Cluster: "web_route_0", Route: &spec.Role_Route{Host: "app.example.com", PathPrefix: "/"}},
+		{Cluster: "web_route_1", Route: &spec.Role_Route{PathPrefix: "/static"}},
+		{Cluster: "web_route_2", Route: &spec.Role_Route{Host: "app.example.com", PathPrefix: "/api/v1"}},
+		{Cluster: "web_route_3", Route: &spec.Role_Route{PathPrefix: "/api"}},
+		{Cluster: "web_route_4", Route: &spec.Role_Route{Host: "*.example.com", PathPrefix: "/static"}},
+	}
+
+	assert.Equal(t, []VirtualHostTemplateParams{
+		{
+			Name:    "web_host_0",
+			Domains: []string{"app.example.com"},
+			Routes: []HTTPRouteTemplateParams{
+				{PathPrefix: "/api/v1", Cluster: "web_route_2"},
+				{PathPrefix: "/static", Cluster: "web_route_1"},
+				{PathPrefix: "/api", Cluster: "web_route_3"},
+				{PathPrefix: "/", Cluster: "web_route_0"},
+			},
+		},
+		{
+			Name:    "web_host_1",
+			Domains: []string{"*.example.com"},
+			Routes: []HTTPRouteTemplateParams{
+				{PathPrefix: "/static", Cluster: "web_route_4"},
+				{PathPrefix: "/api", Cluster: "web_route_3"},
+				{PathPrefix: "/", Cluster: "web"},
+			},
+		},
+		{
+			Name:    "web_any",
+			Domains: []string{"*"},
+			Routes: []HTTPRouteTemplateParams{
+				{PathPrefix: "/static", Cluster: "web_route_1"},
+				{PathPrefix: "/api", Cluster: "web_route_3"},
+				{PathPrefix: "/", Cluster: "web"},
+			},
+		},
+	}, virtualHosts("web", routes))
+
+	assert.Equal(t, []VirtualHostTemplateParams{{
+		Name:    "web_any",
+		Domains: []string{"*"},
+		Routes:  []HTTPRouteTemplateParams{{PathPrefix: "/", Cluster: "web"}},
+	}}, virtualHosts("web", nil))
+}
+
+func TestEnvoyHTTPRoleConfig(t *testing.T) {
+	k8sPools := []*spec.NodePool{
+		{Name: "np-1", Nodes: []*spec.Node{{Private: "192.168.2.1"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+		{Name: "np-2", Nodes: []*spec.Node{{Private: "192.168.2.2"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+	}
+	lb := &spec.LBcluster{Roles: []*spec.Role{{
+		Name:        "web",
+		Protocol:    "http",
+		Port:        80,
+		TargetPort:  8080,
+		TargetPools: []string{"np-1"},
+		RoleType:    spec.RoleType_Ingress,
+		Settings:    &spec.Role_Settings{ProxyProtocol: true, StickySessions: true},
+		Routes: []*spec.Role_Route{
+			{Host: "app.example.com", PathPrefix: "/api", TargetPools: []string{"np-2"}, TargetPort: 9090},
+		},
+	}}}
+
+	params := roleTargetPools(lb, k8sPools)
+	require.Len(t, params, 1)
+	require.Len(t, params[0].Routes, 1)
+	assert.Equal(t, "192.168.2.2", params[0].Routes[0].TargetNodes[0].Private)
+
+	dir := t.TempDir()
+	for file, tpl := range map[string]string{envoyCDS: templates.EnvoyDynamicClusters, envoyLDS: templates.EnvoyDynamicListeners} {
+		tmpl, err := tmplutils.LoadTemplate(tpl)
+		require.NoError(t, err)
+		require.NoError(t, tmplutils.Templates{Directory: dir}.Generate(tmpl, file, params[0]))
+	}
+
+	var cds struct {
+		Resources []struct {
+			Name           string `yaml:"name"`
+			LoadAssignment struct {
+				Endpoints []struct {
+					LbEndpoints []struct {
+						Endpoint struct {
+							Address struct {
+								SocketAddress struct {
+									Address   string `yaml:"address"`
+									PortValue int    `yaml:"port_value"`
+								} `yaml:"socket_address"`
+							} `yaml:"address"`
+						} `yaml:"endpoint"`
+					} `yaml:"lb_endpoints"`
+				} `yaml:"endpoints"`
+			} `yaml:"load_assignment"`
+		} `yaml:"resources"`
+	}
+	b, err := os.ReadFile(filepath.Join(dir, envoyCDS))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(b, &cds))
+	require.Len(t, cds.Resources, 2)
+	assert.Equal(t, "web", cds.Resources[0].Name)
+	assert.Equal(t, 8080, cds.Resources[0].LoadAssignment.Endpoints[0].LbEndpoints[0].Endpoint.Address.SocketAddress.PortValue)
+	assert.Equal(t, "web_route_0", cds.Resources[1].Name)
+	assert.Equal(t, "192.168.2.2", cds.Resources[1].LoadAssignment.Endpoints[0].LbEndpoints[0].Endpoint.Address.SocketAddress.Address)
+	assert.Equal(t, 9090, cds.Resources[1].LoadAssignment.Endpoints[0].LbEndpoints[0].Endpoint.Address.SocketAddress.PortValue)
+
+	var lds struct {
+		Resources []struct {
+			Address struct {
+				SocketAddress struct {
+					Protocol string `yaml:"protocol"`
+				} `yaml:"socket_address"`
+			} `yaml:"address"`
+			FilterChains []struct {
+				Filters []struct {
+					Name        string `yaml:"name"`
+					TypedConfig struct {
+						RouteConfig struct {
+							VirtualHosts []struct {
+								Domains []string `yaml:"domains"`
+								Routes  []struct {
+									Match struct {
+										Prefix string `yaml:"prefix"`
+									} `yaml:"match"`
+									Route struct {
+										Cluster string `yaml:"cluster"`
+									} `yaml:"route"`
+								} `yaml:"routes"`
+							} `yaml:"virtual_hosts"`
+						} `yaml:"route_config"`
+					} `yaml:"typed_config"`
+				} `yaml:"filters"`
+			} `yaml:"filter_chains"`
+		} `yaml:"resources"`
+	}
+	b, err = os.ReadFile(filepath.Join(dir, envoyLDS))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(b, &lds))
+	require.Len(t, lds.Resources, 1)
+	assert.Equal(t, "tcp", lds.Resources[0].Address.SocketAddress.Protocol)
+
+	hcm := lds.Resources[0].FilterChains[0].Filters[0]
+	assert.Equal(t, "envoy.filters.network.http_connection_manager", hcm.Name)
+
+	vhosts := hcm.TypedConfig.RouteConfig.VirtualHosts
+	require.Len(t, vhosts, 2)
+	assert.Equal(t, []string{"app.example.com"}, vhosts[0].Domains)
+	assert.Equal(t, "/api", vhosts[0].Routes[0].Match.Prefix)
+	assert.Equal(t, "web_route_0", vhosts[0].Routes[0].Route.Cluster)
+	assert.Equal(t, "web", vhosts[0].Routes[1].Route.Cluster)
+	assert.Equal(t, []string{"*"}, vhosts[1].Domains)
+}
+
+func TestEnvoyRoleAlgorithmAndWeights(t *testing.T) {
+	k8sPools := []*spec.NodePool{
+		{Name: "old", Nodes: []*spec.Node{{Private: "192.168.2.1"}, {Private: "192.168.2.2"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+		{Name: "new", Nodes: []*spec.Node{{Private: "192.168.2.3"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+		{Name: "drained", Nodes: []*spec.Node{{Private: "192.168.2.4"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+	}
+	lb := &spec.LBcluster{Roles: []*spec.Role{{
+		Name:        "tcp",
+		Protocol:    "tcp",
+		Port:        80,
+		TargetPort:  8080,
+		TargetPools: []string{"old", "new", "drained"},
+		RoleType:    spec.RoleType_Ingress,
+		Settings: &spec.Role_Settings{
+			Algorithm: spec.Role_LEAST_REQUEST,
+			Weights:   map[string]uint32{"old": 10, "drained": 0},
+		},
+	}}}
+
+	params := roleTargetPools(lb, k8sPools)
+	require.Len(t, params, 1)
+	require.Len(t, params[0].TargetNodes, 3)
+
+	dir := t.TempDir()
+	tmpl, err := tmplutils.LoadTemplate(templates.EnvoyDynamicClusters)
+	require.NoError(t, err)
+	require.NoError(t, tmplutils.Templates{Directory: dir}.Generate(tmpl, envoyCDS, params[0]))
+
+	var cds struct {
+		Resources []struct {
+			LbPolicy       string `yaml:"lb_policy"`
+			LoadAssignment struct {
+				Endpoints []struct {
+					LbEndpoints []struct {
+						Endpoint struct {
+							Address struct {
+								SocketAddress struct {
+									Address string `yaml:"address"`
+								} `yaml:"socket_address"`
+							} `yaml:"address"`
+						} `yaml:"endpoint"`
+						LoadBalancingWeight int `yaml:"load_balancing_weight"`
+					} `yaml:"lb_endpoints"`
+				} `yaml:"endpoints"`
+			} `yaml:"load_assignment"`
+		} `yaml:"resources"`
+	}
+	b, err := os.ReadFile(filepath.Join(dir, envoyCDS))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(b, &cds))
+	require.Len(t, cds.Resources, 1)
+	assert.Equal(t, "LEAST_REQUEST", cds.Resources[0].LbPolicy)
+
+	weights := make(map[string]int)
+	for _, e := range cds.Resources[0].LoadAssignment.Endpoints[0].LbEndpoints {
+		weights[e.Endpoint.Address.SocketAddress.Address] = e.LoadBalancingWeight
+	}
+	assert.Equal(t, map[string]int{"192.168.2.1": 10, "192.168.2.2": 10, "192.168.2.3": 1}, weights)
+}
+
+func TestEnvoyRoleSourceRangesAndLimits(t *testing.T) {
+	k8sPools := []*spec.NodePool{
+		{Name: "control", Nodes: []*spec.Node{{Private: "192.168.2.1", Public: "1.1.1.1"}}, IsControl: true, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+		{Name: "compute", Nodes: []*spec.Node{{Private: "192.168.2.2", Public: "2001:db8::1"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+	}
+	lb := &spec.LBcluster{Roles: []*spec.Role{
+		{
+			Name:                "tcp",
+			Protocol:            "tcp",
+			Port:                80,
+			TargetPort:          8080,
+			TargetPools:         []string{"compute"},
+			RoleType:            spec.RoleType_Ingress,
+			AllowedSourceRanges: []string{"10.0.0.0/8", "10.1.0.0/16", "10.0.0.0/8"},
+			Settings: &spec.Role_Settings{
+				MaxConnections: 100,
+				RateLimit:      &spec.Role_RateLimit{ConnectionsPerSecond: 10, Burst: 20},
+			},
+		},
+		{
+			Name:                "api",
+			Protocol:            "tcp",
+			Port:                6443,
+			TargetPort:          6443,
+			TargetPools:         []string{"control"},
+			RoleType:            spec.RoleType_ApiServer,
+			AllowedSourceRanges: []string{"10.0.0.0/8"},
+			Settings:            &spec.Role_Settings{},
+		},
+		{
+			Name:        "open",
+			Protocol:    "tcp",
+			Port:        443,
+			TargetPort:  443,
+			TargetPools: []string{"compute"},
+			RoleType:    spec.RoleType_Ingress,
+			Settings:    &spec.Role_Settings{},
+		},
+	}}
+
+	params := roleTargetPools(lb, k8sPools)
+	require.Len(t, params, 3)
+	assert.Equal(t, []SourceRangeTemplateParams{{Address: "10.0.0.0", PrefixLen: 8}, {Address: "10.1.0.0", PrefixLen: 16}}, params[0].SourceRanges)
+	assert.Equal(t, []SourceRangeTemplateParams{
+		{Address: "10.0.0.0", PrefixLen: 8},
+		{Address: "1.1.1.1", PrefixLen: 32},
+		{Address: "2001:db8::1", PrefixLen: 128},
+	}, params[1].SourceRanges)
+	assert.Empty(t, params[2].SourceRanges)
+
+	type filters struct {
+		Resources []struct {
+			FilterChains []struct {
+				Filters []struct {
+					Name        string `yaml:"name"`
+					TypedConfig struct {
+						Rules struct {
+							Action   string `yaml:"action"`
+							Policies map[string]struct {
+								Principals []struct {
+									DirectRemoteIP struct {
+										AddressPrefix string `yaml:"address_prefix"`
+										PrefixLen     int    `yaml:"prefix_len"`
+									} `yaml:"direct_remote_ip"`
+								} `yaml:"principals"`
+							} `yaml:"policies"`
+						} `yaml:"rules"`
+						TokenBucket struct {
+							MaxTokens     int    `yaml:"max_tokens"`
+							TokensPerFill int    `yaml:"tokens_per_fill"`
+							FillInterval  string `yaml:"fill_interval"`
+						} `yaml:"token_bucket"`
+						MaxConnections int `yaml:"max_connections"`
+					} `yaml:"typed_config"`
+				} `yaml:"filters"`
+			} `yaml:"filter_chains"`
+		} `yaml:"resources"`
+	}
+
+	render := func(p RolesTemplateParams) filters {
+		dir := t.TempDir()
+		tmpl, err := tmplutils.LoadTemplate(templates.EnvoyDynamicListeners)
+		require.NoError(t, err)
+		require.NoError(t, tmplutils.Templates{Directory: dir}.Generate(tmpl, envoyLDS, p))
+
+		var lds filters
+		b, err := os.ReadFile(filepath.Join(dir, envoyLDS))
+		require.NoError(t, err)
+		require.NoError(t, yaml.Unmarshal(b, &lds))
+		require.Len(t, lds.Resources, 1)
+		return lds
+	}
+
+	got := render(params[0]).Resources[0].FilterChains[0].Filters
+	require.Len(t, got, 4)
+	assert.Equal(t, "envoy.filters.network.rbac", got[0].Name)
+	assert.Equal(t, "ALLOW", got[0].TypedConfig.Rules.Action)
+	principals := got[0].TypedConfig.Rules.Policies["allowed-source-ranges"].Principals
+	require.Len(t, principals, 2)
+	assert.Equal(t, "10.1.0.0", principals[1].DirectRemoteIP.AddressPrefix)
+	assert.Equal(t, 16, principals[1].DirectRemoteIP.PrefixLen)
+	assert.Equal(t, "envoy.filters.network.local_ratelimit", got[1].Name)
+	assert.Equal(t, 20, got[1].TypedConfig.TokenBucket.MaxTokens)
+	assert.Equal(t, 10, got[1].TypedConfig.TokenBucket.TokensPerFill)
+	assert.Equal(t, "1s", got[1].TypedConfig.TokenBucket.FillInterval)
+	assert.Equal(t, "envoy.filters.network.connection_limit", got[2].Name)
+	assert.Equal(t, 100, got[2].TypedConfig.MaxConnections)
+	assert.Equal(t, "envoy.filters.network.tcp_proxy", got[3].Name)
+
+	got = render(params[2]).Resources[0].FilterChains[0].Filters
+	require.Len(t, got, 1)
+	assert.Equal(t, "envoy.filters.network.tcp_proxy", got[0].Name)
+}
+
+func TestEnvoyRoleIPv6(t *testing.T) {
+	k8sPools := []*spec.NodePool{
+		{Name: "control", Nodes: []*spec.Node{{Private: "192.168.2.1", Public: "1.1.1.1", PublicIPv6: "2001:db8::1"}}, IsControl: true, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+	}
+	lb := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{NodePools: []*spec.NodePool{
+			{Name: "lb", Nodes: []*spec.Node{{Private: "192.168.2.2", Public: "2.2.2.2", PublicIPv6: "2001:db8::2"}}, Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}}},
+		}},
+		Roles: []*spec.Role{{
+			Name:                "api",
+			Protocol:            "tcp",
+			Port:                6443,
+			TargetPort:          6443,
+			TargetPools:         []string{"control"},
+			RoleType:            spec.RoleType_ApiServer,
+			AllowedSourceRanges: []string{"2001:db8:1::/48"},
+			Settings:            &spec.Role_Settings{},
+		}},
+	}
+
+	params := roleTargetPools(lb, k8sPools)
+	require.Len(t, params, 1)
+	assert.True(t, params[0].IPv6)
+	assert.Equal(t, []SourceRangeTemplateParams{
+		{Address: "2001:db8:1::", PrefixLen: 48},
+		{Address: "1.1.1.1", PrefixLen: 32},
+		{Address: "2001:db8::1", PrefixLen: 128},
+	}, params[0].SourceRanges)
+
+	type socketAddress struct {
+		Address   string `yaml:"address"`
+		PortValue int    `yaml:"port_value"`
+	}
+	var lds struct {
+		Resources []struct {
+			Address struct {
+				SocketAddress socketAddress `yaml:"socket_address"`
+			} `yaml:"address"`
+			AdditionalAddresses []struct {
+				Address struct {
+					SocketAddress socketAddress `yaml:"socket_address"`
+				} `yaml:"address"`
+			} `yaml:"additional_addresses"`
+		} `yaml:"resources"`
+	}
+
+	dir := t.TempDir()
+	tmpl, err := tmplutils.LoadTemplate(templates.EnvoyDynamicListeners)
+	require.NoError(t, err)
+	require.NoError(t, tmplutils.Templates{Directory: dir}.Generate(tmpl, envoyLDS, params[0]))
+
+	b, err := os.ReadFile(filepath.Join(dir, envoyLDS))
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(b, &lds))
+	require.Len(t, lds.Resources, 1)
+	assert.Equal(t, socketAddress{Address: "0.0.0.0", PortValue: 6443}, lds.Resources[0].Address.SocketAddress)
+	require.Len(t, lds.Resources[0].AdditionalAddresses, 1)
+	assert.Equal(t, socketAddress{Address: "::", PortValue: 6443}, lds.Resources[0].AdditionalAddresses[0].Address.SocketAddress)
+}
+
+func TestKeepalivedPlaybook(t *testing.T) {
+	render := func(vip *spec.VirtualIP) map[string]string {
+		t.Helper()
+
+		params := KeepalivedTemplateParams{LoadBalancer: "lb", VirtualIP: vip, EnvoyAdminPorts: []int32{60000, 60001}}
+
+		dir := t.TempDir()
+		tmpl, err := tmplutils.LoadTemplate(templates.KeepalivedPlaybookTemplate)
+		require.NoError(t, err)
+		require.NoError(t, tmplutils.Templates{Directory: dir}.Generate(tmpl, keepalivedPlaybookName, params))
+
+		b, err := os.ReadFile(filepath.Join(dir, keepalivedPlaybookName))
+		require.NoError(t, err)
+
+		var playbook []struct {
+			Hosts string `yaml:"hosts"`
+			Tasks []struct {
+				Copy struct {
+					Dest    string `yaml:"dest"`
+					Content string `yaml:"content"`
+				} `yaml:"ansible.builtin.copy"`
+			} `yaml:"tasks"`
+		}
+		require.NoError(t, yaml.Unmarshal(b, &playbook))
+		require.Len(t, playbook, 1)
+		assert.Equal(t, "lb", playbook[0].Hosts)
+
+		files := make(map[string]string)
+		for _, task := range playbook[0].Tasks {
+			if task.Copy.Dest != "" {
+				files[task.Copy.Dest] = task.Copy.Content
+			}
+		}
+		return files
+	}
+
+	files := render(&spec.VirtualIP{Ip: "192.168.1.100", Interface: "eth1", VirtualRouterId: 42})
+	assert.Contains(t, files["/etc/keepalived/check_envoy.sh"], "http://{{ private_ip }}:60000/ready || exit 1")
+	assert.Contains(t, files["/etc/keepalived/check_envoy.sh"], "http://{{ private_ip }}:60001/ready || exit 1")
+	assert.Contains(t, files["/etc/keepalived/keepalived.conf"], "virtual_router_id 42")
+	assert.Contains(t, files["/etc/keepalived/keepalived.conf"], "192.168.1.100/32 dev eth1")
+	assert.Contains(t, files["/etc/keepalived/keepalived.conf"], "{% for host in groups['lb'] if host != inventory_hostname %}")
+
+	files = render(&spec.VirtualIP{Ip: "192.168.1.100", VirtualRouterId: 42})
+	assert.Contains(t, files["/etc/keepalived/keepalived.conf"], "192.168.1.100/32 dev {{ ansible_default_ipv4.interface }}")
+}
@@ -33,6 +33,9 @@ var (
 	//go:embed node-exporter.goyml
 	NodeExporterPlaybookTemplate string
 
+	//go:embed keepalived.goyml
+	KeepalivedPlaybookTemplate string
+
 	//go:embed proxy-envs.goini
 	ProxyEnvsInventoryTemplate string
 )
@@ -18,12 +18,15 @@ package controller
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode/utf8"
 
 	v1beta1manifest "github.com/berops/claudie/internal/api/crd/inputmanifest/v1beta1"
 	"github.com/berops/claudie/internal/api/manifest"
 	"github.com/berops/claudie/internal/generics"
+	"github.com/berops/claudie/proto/pb/spec"
+	managerclient "github.com/berops/claudie/services/manager/client"
 )
 
 // constructInputManifest takes the v1beta.InputManifest and providersWithSecret and returns a claudie type raw manifest.Manifest type.
@@ -297,6 +300,38 @@ func constructInputManifest(
 				Endpoint:     strings.TrimSpace(oEndpoint),
 				Templates:    &tmpl,
 			})
+		case v1beta1manifest.RFC2136:
+			rServer, err := p.ProviderSecretField(v1beta1manifest.RFC2136_SERVER)
+			if err != nil {
+				return manifest.Manifest{}, buildSecretError(secretNamespaceName, err)
+			}
+			rKeyName, err := p.ProviderSecretField(v1beta1manifest.RFC2136_KEY_NAME)
+			if err != nil {
+				return manifest.Manifest{}, buildSecretError(secretNamespaceName, err)
+			}
+			rKeySecret, err := p.ProviderSecretField(v1beta1manifest.RFC2136_KEY_SECRET)
+			if err != nil {
+				return manifest.Manifest{}, buildSecretError(secretNamespaceName, err)
+			}
+			// port and key algorithm are optional, defaulting to 53 and hmac-sha256.
+			rKeyAlgorithm, _ := p.ProviderSecretField(v1beta1manifest.RFC2136_KEY_ALGORITHM)
+
+			var rPort int64
+			if port, _ := p.ProviderSecretField(v1beta1manifest.RFC2136_PORT); strings.TrimSpace(port) != "" {
+				if rPort, err = strconv.ParseInt(strings.TrimSpace(port), 10, 32); err != nil {
+					return manifest.Manifest{}, buildSecretError(secretNamespaceName, fmt.Errorf("field %s is not a valid port: %w", v1beta1manifest.RFC2136_PORT, err))
+				}
+			}
+
+			providers.RFC2136 = append(providers.RFC2136, manifest.RFC2136{
+				Name:         p.ProviderName,
+				Server:       strings.TrimSpace(rServer),
+				Port:         int32(rPort),
+				KeyName:      strings.TrimSpace(rKeyName),
+				KeyAlgorithm: strings.TrimSpace(rKeyAlgorithm),
+				KeySecret:    strings.TrimSpace(rKeySecret),
+				Templates:    &tmpl,
+			})
 		}
 	}
 
@@ -312,7 +347,7 @@ func constructInputManifest(
 					secretNamespaceName := n.Secret.Namespace + "/" + n.Secret.Name
 					return manifest.Manifest{}, buildSecretError(secretNamespaceName, fmt.Errorf("field %s is not a valid UTF-8 string", v1beta1manifest.PRIVATE_KEY))
 				}
-				nodes = append(nodes, manifest.Node{Endpoint: n.Endpoint, Username: n.Username, Key: string(key)})
+				nodes = append(nodes, manifest.Node{Endpoint: n.Endpoint, EndpointIPv6: n.EndpointIPv6, Username: n.Username, Key: string(key)})
 			} else {
 				secretNamespaceName := n.Secret.Namespace + "/" + n.Secret.Name
 				return manifest.Manifest{}, buildSecretError(secretNamespaceName, fmt.Errorf("field %s not found", v1beta1manifest.PRIVATE_KEY))
@@ -363,6 +398,59 @@ func buildProvisioningError(state v1beta1manifest.InputManifestStatus) error {
 	return fmt.Errorf("%v", msg)
 }
 
+// stageName returns the name of the service that handles the stage.
+func stageName(stage *spec.Stage) string {
+	switch stage.GetStageKind().(type) {
+	case *spec.Stage_Ansibler:
+		return "Ansibler"
+	case *spec.Stage_KubeEleven:
+		return "KubeEleven"
+	case *spec.Stage_Kuber:
+		return "Kuber"
+	case *spec.Stage_Terraformer:
+		return "Terraformer"
+	default:
+		return "Unknown"
+	}
+}
+
+// applyPlan fills the planned tasks of each cluster into the state.
+func applyPlan(state *v1beta1manifest.InputManifestStatus, plan *managerclient.PlanManifestResponse) {
+	for _, cluster := range plan.Clusters {
+		status := state.Clusters[cluster.Cluster]
+		status.Plan = make([]v1beta1manifest.PlannedTask, 0, len(cluster.Tasks))
+		if status.Previous == nil {
+			status.Previous = make([]v1beta1manifest.FinishedWorkflow, 0)
+		}
+		if cluster.Error != "" {
+			status.Message = cluster.Error
+		}
+
+		for _, task := range cluster.Tasks {
+			pt := v1beta1manifest.PlannedTask{
+				Event:       task.Event.String(),
+				Description: task.Description,
+			}
+
+			for _, stage := range task.Pipeline {
+				pt.Stages = append(pt.Stages, stageName(stage))
+			}
+
+			for _, np := range task.Affected {
+				affected := np.Cluster + "/" + np.Nodepool
+				if len(np.Nodes) > 0 {
+					affected += ": " + strings.Join(np.Nodes, ",")
+				}
+				pt.NodePools = append(pt.NodePools, affected)
+			}
+
+			status.Plan = append(status.Plan, pt)
+		}
+
+		state.Clusters[cluster.Cluster] = status
+	}
+}
+
 func getStaticNodePool(name string, nps []v1beta1manifest.StaticNodePool) *v1beta1manifest.StaticNodePool {
 	for _, v := range nps {
 		if v.Name == name {
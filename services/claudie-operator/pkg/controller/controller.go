@@ -5,6 +5,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -213,6 +214,7 @@ func (r *InputManifestReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			}
 
 			snwd.Endpoint = n.Endpoint
+			snwd.EndpointIPv6 = n.EndpointIPv6
 			nodes = append(nodes, snwd)
 		}
 		staticNodeSecrets[s.Name] = nodes
@@ -284,6 +286,9 @@ func (r *InputManifestReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 		// Current state of the config.
 		configState spec.Manifest_State
+
+		// Whether the reconciliation of the individual clusters is paused.
+		pausedClusters = make(map[string]bool)
 	)
 
 	for _, config := range resp.Config {
@@ -303,21 +308,11 @@ func (r *InputManifestReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 				deletedCount++
 			}
 
+			pausedClusters[cluster] = state.Paused
+
 			stage := "None"
 			if state.InFlight != nil && len(state.InFlight.Pipeline) > 0 {
-				current := state.InFlight.Pipeline[state.InFlight.CurrentStage]
-				switch current.StageKind.(type) {
-				case *spec.Stage_Ansibler:
-					stage = "Ansibler"
-				case *spec.Stage_KubeEleven:
-					stage = "KubeEleven"
-				case *spec.Stage_Kuber:
-					stage = "Kuber"
-				case *spec.Stage_Terraformer:
-					stage = "Terraformer"
-				default:
-					stage = "Unknown"
-				}
+				stage = stageName(state.InFlight.Pipeline[state.InFlight.CurrentStage])
 			}
 
 			status := v1beta1manifest.ClustersStatus{
@@ -325,6 +320,7 @@ func (r *InputManifestReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 				Phase:    stage,
 				Message:  state.State.GetDescription(),
 				Previous: make([]v1beta1manifest.FinishedWorkflow, 0, 1),
+				Deferred: state.State.GetDeferred(),
 			}
 
 			for _, p := range state.State.Previous {
@@ -425,6 +421,60 @@ func (r *InputManifestReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{RequeueAfter: REQUEUE_IN_PROGRES}, nil
 	}
 
+	// Pausing does not change the manifest, thus it is applied
+	// regardless of the dry-run annotation.
+	if paused, ok := inputManifest.PausedClusters(); ok {
+		for cluster, isPaused := range pausedClusters {
+			if want := slices.Contains(paused, cluster); want != isPaused {
+				if err := r.SetClusterPaused(ctx, rawManifest.Name, cluster, want); err != nil {
+					r.Recorder.Eventf(
+						inputManifest,
+						nil,
+						corev1.EventTypeWarning,
+						"PauseFailed",
+						"PausingCluster",
+						"%v",
+						err,
+					)
+					return ctrl.Result{RequeueAfter: REQUEUE_AFTER_ERROR}, nil
+				}
+			}
+		}
+	}
+
+	// With the dry-run annotation, the changes are only planned and
+	// reported in the status, without being applied.
+	if inputManifest.IsDryRun() {
+		log.Info("Planning changes for InputManifest", "status", currentState.State)
+
+		plan, err := r.PlanConfig(ctx, &rawManifest)
+		if err != nil {
+			r.Recorder.Eventf(
+				inputManifest,
+				nil,
+				corev1.EventTypeWarning,
+				"PlanningFailed",
+				"PlanningChanges",
+				"%v",
+				err,
+			)
+			return ctrl.Result{RequeueAfter: REQUEUE_AFTER_ERROR}, nil
+		}
+
+		if !alreadyExists {
+			currentState.State = v1beta1manifest.STATUS_PLANNED
+		}
+
+		applyPlan(&currentState, plan)
+
+		inputManifest.SetUpdateResourceStatus(currentState)
+		if err := r.kc.Status().Update(ctx, inputManifest); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed updating status: %w", err)
+		}
+
+		return ctrl.Result{RequeueAfter: REQUEUE_WATCH}, nil
+	}
+
 	if !alreadyExists {
 		log.Info("Calling create config")
 
@@ -74,18 +74,6 @@ func (v *InputManifestValidator) ValidateCreate(ctx context.Context, obj *v1beta
 
 // ValidateUpdate defines the logic when a kubernetes obj resource is updated
 func (v *InputManifestValidator) ValidateUpdate(ctx context.Context, oldObj, newObj *v1beta.InputManifest) (admission.Warnings, error) {
-	nmap := getDynamicNodepoolsMap(oldObj)
-	for _, desired := range newObj.Spec.NodePools.Dynamic {
-		current, exists := nmap[desired.Name]
-		if !exists {
-			continue
-		}
-
-		if err := nodepoolImmutabilityCheck(&desired, current); err != nil {
-			return nil, fmt.Errorf("immutability check for dynamic nodepools failed: %w", err)
-		}
-	}
-
 	return nil, v.validate(ctx, newObj)
 }
 
@@ -129,6 +117,11 @@ func validateInputManifest(im *v1beta.InputManifest) error {
 			rawManifest.Providers.Verda = append(rawManifest.Providers.Verda, manifest.Verda{Name: p.ProviderName})
 		case v1beta.OVH:
 			rawManifest.Providers.OVH = append(rawManifest.Providers.OVH, manifest.OVH{Name: p.ProviderName})
+		case v1beta.RFC2136:
+			if p.TemplatesRef.Name == "" || p.TemplatesRef.Name == DefaultTemplatesReferenceName {
+				return fmt.Errorf("provider %q of type %q requires spec.providers.templatesRef to be set, as there are no default templates for it", p.ProviderName, p.ProviderType)
+			}
+			rawManifest.Providers.RFC2136 = append(rawManifest.Providers.RFC2136, manifest.RFC2136{Name: p.ProviderName})
 		}
 	}
 
@@ -277,81 +270,3 @@ func validateReferences(ctx context.Context, kc client.Client, im *v1beta.InputM
 
 	return nil
 }
-
-// getDynamicNodepoolsMap will read manifest from the given config and return map of provider names keyed by dynamic nodepool names
-func getDynamicNodepoolsMap(m *v1beta.InputManifest) map[string]*manifest.DynamicNodePool {
-	nmap := make(map[string]*manifest.DynamicNodePool)
-	for _, np := range m.Spec.NodePools.Dynamic {
-		nmap[np.Name] = &np
-	}
-
-	return nmap
-}
-
-func nodepoolImmutabilityCheck(desired, current *manifest.DynamicNodePool) error {
-	if desired.ProviderSpec != current.ProviderSpec {
-		return fmt.Errorf(
-			"dynamic nodepools are immutable, changing the provider specification from %q to %q for %q is not allowed, only 'count' and 'autoscaling' fields are allowed to be modified, consider removing %q and creating a new one",
-			safePrint(&current.ProviderSpec),
-			safePrint(&desired.ProviderSpec),
-			current.Name,
-			current.Name,
-		)
-	}
-
-	if desired.ServerType != current.ServerType {
-		return fmt.Errorf(
-			"dynamic nodepools are immutable, changing the server type, from %q to %q, for %q is not allowed, only 'count' and 'autoscaling' fields are allowed to be modified, consider removing %q and creating a new one",
-			current.ServerType,
-			desired.ServerType,
-			current.Name,
-			current.Name,
-		)
-	}
-
-	if desired.Image != current.Image {
-		return fmt.Errorf(
-			"dynamic nodepools are immutable, changing the image from %q to %q for %q is not allowed, only 'count' and 'autoscaling' fields are allowed to be modified, consider removing %q and creating a new one",
-			current.Image,
-			desired.Image,
-			current.Name,
-			current.Name,
-		)
-	}
-
-	storageDiskChanged := desired.StorageDiskSize == nil && current.StorageDiskSize != nil
-	storageDiskChanged = storageDiskChanged || (desired.StorageDiskSize != nil && current.StorageDiskSize == nil)
-	storageDiskChanged = storageDiskChanged || ((desired.StorageDiskSize != nil && current.StorageDiskSize != nil) && (*desired.StorageDiskSize != *current.StorageDiskSize))
-	if storageDiskChanged {
-		return fmt.Errorf(
-			"dynamic nodepools are immutable, changing the storage disk size from %q to %q for %q is not allowed, only 'count' and 'autoscaling' fields are allowed to be modified, consider removing %q and creating a new one",
-			safePrint(current.StorageDiskSize),
-			safePrint(desired.StorageDiskSize),
-			current.Name,
-			current.Name,
-		)
-	}
-
-	machineSpecChanged := desired.MachineSpec == nil && current.MachineSpec != nil
-	machineSpecChanged = machineSpecChanged || (desired.MachineSpec != nil && current.MachineSpec == nil)
-	machineSpecChanged = machineSpecChanged || ((desired.MachineSpec != nil && current.MachineSpec != nil) && (*desired.MachineSpec != *current.MachineSpec))
-	if machineSpecChanged {
-		return fmt.Errorf(
-			"dynamic nodepools are immutable, changing the machine spec from %q to %q for %q is not allowed, only 'count' and 'autoscaling' fields are allowed to be modified, consider removing %q and creating a new one",
-			safePrint(current.MachineSpec),
-			safePrint(desired.MachineSpec),
-			current.Name,
-			current.Name,
-		)
-	}
-
-	return nil
-}
-
-func safePrint[T any](p *T) string {
-	if p == nil {
-		return "<nil>"
-	}
-
-	return fmt.Sprintf("%+v", *p)
-}
@@ -37,6 +37,26 @@ func (u *Usecases) CreateConfig(ctx context.Context, inputManifest *manifest.Man
 	return nil
 }
 
+func (u *Usecases) PlanConfig(ctx context.Context, inputManifest *manifest.Manifest) (*managerclient.PlanManifestResponse, error) {
+	inputManifestMarshalled, err := yaml.Marshal(inputManifest)
+	if err != nil {
+		log.Err(err).Msgf("Failed to marshal manifest %s. Skipping...", inputManifest.Name)
+		return nil, err
+	}
+
+	resp, err := u.Manager.PlanManifest(ctx, &managerclient.PlanManifestRequest{
+		Name:     inputManifest.Name,
+		Manifest: &managerclient.Manifest{Raw: string(inputManifestMarshalled)},
+	})
+	if err != nil {
+		log.Err(err).Msgf("Failed to plan config %v due to error. Skipping...", inputManifest.Name)
+		return nil, err
+	}
+
+	log.Debug().Msgf("Planned config for input manifest %s", inputManifest.Name)
+	return resp, nil
+}
+
 func (u *Usecases) DeleteConfig(ctx context.Context, name string) error {
 	err := managerclient.Retry(&log.Logger, "MarkForDeletion", func() error {
 		err := u.Manager.MarkForDeletion(ctx, &managerclient.MarkForDeletionRequest{Name: name})
@@ -54,3 +74,18 @@ func (u *Usecases) DeleteConfig(ctx context.Context, name string) error {
 	log.Debug().Msgf("Config %s was successfully marked for deletion", name)
 	return nil
 }
+
+func (u *Usecases) SetClusterPaused(ctx context.Context, config, cluster string, paused bool) error {
+	req := &managerclient.SetClusterPausedRequest{Config: config, Cluster: cluster, Paused: paused}
+
+	err := managerclient.Retry(&log.Logger, fmt.Sprintf("SetClusterPaused %q", cluster), func() error {
+		return u.Manager.SetClusterPaused(ctx, req)
+	})
+	if err != nil {
+		log.Err(err).Msgf("Failed to set paused to %v for cluster %q of config %v", paused, cluster, config)
+		return err
+	}
+
+	log.Info().Msgf("Set paused to %v for cluster %q of config %v", paused, cluster, config)
+	return nil
+}
@@ -34,12 +34,17 @@ type KubeEleven struct {
 	// Kubernetes cluster that will be set up.
 	K8sCluster *spec.K8Scluster
 
-	// LoadBalancerEndpoint specifies the dns hostname
-	// that should be used as the endpoint for the K8sCluster.
+	// LoadBalancerEndpoint specifies the dns hostname, or the
+	// virtual IP, that should be used as the endpoint for the K8sCluster.
 	// If empty, one of the nodes in the supplied K8sCluster
 	// needs to have the role ApiEndpoint.
 	LoadBalancerEndpoint string
 
+	// LoadBalancerAlternativeNames are the other names of the loadbalancer
+	// included in the certificate of the api server, i.e. the dns hostname
+	// if the [KubeEleven.LoadBalancerEndpoint] is a virtual IP.
+	LoadBalancerAlternativeNames []string
+
 	// SpawnProcessLimit limits the number of spawned kubeone processes.
 	SpawnProcessLimit *semaphore.Weighted
 }
@@ -168,14 +173,23 @@ func (k *KubeEleven) templateData() (templateData, error) {
 			if n.NodeType != spec.NodeType_apiEndpoint {
 				alternativeNames = append(alternativeNames, n.Public)
 			}
+			if n.PublicIPv6 != "" {
+				alternativeNames = append(alternativeNames, n.PublicIPv6)
+			}
 		}
 	}
 	if k8sApiEndpoint {
 		data.AlternativeNames = alternativeNames
+	} else {
+		data.AlternativeNames = k.LoadBalancerAlternativeNames
 	}
 
 	data.KubernetesVersion = k.K8sCluster.GetKubernetes()
 	data.ClusterName = k.K8sCluster.ClusterInfo.Name
+	if data.DualStack = k.K8sCluster.IsDualStack(); data.DualStack {
+		data.PodSubnetIPv6 = spec.PodSubnetIPv6
+		data.ServiceSubnetIPv6 = spec.ServiceSubnetIPv6
+	}
 
 	return data, nil
 }
@@ -195,7 +209,7 @@ func (k *KubeEleven) getClusterNodes() []*NodepoolInfo {
 				Zone:              sanitise.String(nodepool.GetDynamicNodePool().Zone),
 				CloudProviderName: sanitise.String(nodepool.GetDynamicNodePool().Provider.CloudProviderName),
 				ProviderName:      sanitise.String(nodepool.GetDynamicNodePool().Provider.SpecName),
-				Nodes: getNodeData(nodepool, func(name string) string {
+				Nodes: getNodeData(nodepool, k.K8sCluster.NetworkIPv6, func(name string) string {
 					return strings.TrimPrefix(name, fmt.Sprintf("%s-", k.K8sCluster.ClusterInfo.Id()))
 				}),
 				IsDynamic: true,
@@ -207,7 +221,7 @@ func (k *KubeEleven) getClusterNodes() []*NodepoolInfo {
 				Zone:              sanitise.String(staticZone),
 				CloudProviderName: sanitise.String(staticProvider),
 				ProviderName:      sanitise.String(staticProviderName),
-				Nodes:             getNodeData(nodepool, func(s string) string { return s }),
+				Nodes:             getNodeData(nodepool, k.K8sCluster.NetworkIPv6, func(s string) string { return s }),
 				IsDynamic:         false,
 			}
 		}
@@ -233,14 +247,15 @@ func (k *KubeEleven) apiEndpoint() (string, bool, error) {
 }
 
 // getNodeData return template data for the nodes from the cluster.
-func getNodeData(nodepool *spec.NodePool, nameFunc func(string) string) []*NodeInfo {
+func getNodeData(nodepool *spec.NodePool, networkIPv6 string, nameFunc func(string) string) []*NodeInfo {
 	n := make([]*NodeInfo, 0, len(nodepool.Nodes))
 	for _, node := range nodepool.Nodes {
 		nodeName := nameFunc(node.Name)
 		n = append(n, &NodeInfo{
-			Name:    nodeName,
-			Node:    node,
-			SshPort: nodepools.NodeSSHPort(nodepool, node),
+			Name:        nodeName,
+			Node:        node,
+			SshPort:     nodepools.NodeSSHPort(nodepool, node),
+			PrivateIPv6: spec.PrivateIPv6(networkIPv6, node.Private),
 		})
 	}
 	return n
@@ -13,6 +13,9 @@ type (
 		// per-node override (shared-IP / NAT nodes) and otherwise falls back to
 		// the node pool's port.
 		SshPort int32
+		// PrivateIPv6 is the address of the node within the IPv6 network
+		// of the cluster, empty if the cluster is not dual-stack.
+		PrivateIPv6 string
 	}
 
 	// NodepoolInfo struct holds data necessary to define nodes in kubeone
@@ -35,5 +38,8 @@ type (
 		KubernetesVersion string
 		ClusterName       string
 		Nodepools         []*NodepoolInfo
+		DualStack         bool
+		PodSubnetIPv6     string
+		ServiceSubnetIPv6 string
 	}
 )
@@ -31,7 +31,7 @@ func Destroy(
 
 	var loadbalancerApiEndpoint string
 	if ep := clusters.FindAssignedLbApiEndpoint(toDelete.LoadBalancers); ep != nil {
-		loadbalancerApiEndpoint = ep.Dns.Endpoint
+		loadbalancerApiEndpoint = ep.Endpoint()
 	}
 
 	k := kube_eleven.KubeEleven{
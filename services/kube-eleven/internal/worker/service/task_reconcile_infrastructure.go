@@ -40,15 +40,22 @@ func Reconcile(
 
 	logger.Info().Msgf("Reconciling kubernetes cluster")
 
-	var loadbalancerApiEndpoint string
+	var (
+		loadbalancerApiEndpoint string
+		loadbalancerNames       []string
+	)
 	if ep := clusters.FindAssignedLbApiEndpoint(lbs); ep != nil {
-		loadbalancerApiEndpoint = ep.Dns.Endpoint
+		loadbalancerApiEndpoint = ep.Endpoint()
+		if names := ep.Endpoints(); len(names) > 1 {
+			loadbalancerNames = names[1:]
+		}
 	}
 
 	k := kube_eleven.KubeEleven{
-		K8sCluster:           k8s,
-		LoadBalancerEndpoint: loadbalancerApiEndpoint,
-		SpawnProcessLimit:    processLimit,
+		K8sCluster:                   k8s,
+		LoadBalancerEndpoint:         loadbalancerApiEndpoint,
+		LoadBalancerAlternativeNames: loadbalancerNames,
+		SpawnProcessLimit:            processLimit,
 	}
 
 	if err := k.BuildCluster(); err != nil {
@@ -13,6 +13,13 @@ features:
     deploy: true
 
 clusterNetwork:
+  {{- if .DualStack }}
+  # the cilium CNI deployed by kubeone enables IPv6 based on the ip family.
+  ipFamily: IPv4+IPv6
+  podSubnetIPv6: '{{ .PodSubnetIPv6 }}'
+  serviceSubnetIPv6: '{{ .ServiceSubnetIPv6 }}'
+  nodeCIDRMaskSizeIPv6: 64
+  {{- end }}
   kubeProxy:
     skipInstallation: true
   cni:
@@ -39,6 +46,10 @@ controlPlane:
     {{- if ge $nodeInfo.Node.NodeType 1}}
   - publicAddress: '{{ $nodeInfo.Node.Public }}'
     privateAddress: '{{ $nodeInfo.Node.Private }}'
+    {{- with $nodeInfo.PrivateIPv6 }}
+    ipv6Addresses:
+    - '{{ . }}'
+    {{- end }}
     sshPort: {{ $nodeInfo.SshPort }}
     {{- if $nodepool.IsDynamic }}
     sshUsername: root
@@ -65,6 +76,10 @@ staticWorkers:
     {{- if eq $nodeInfo.Node.NodeType 0}}
   - publicAddress: '{{ $nodeInfo.Node.Public }}'
     privateAddress: '{{ $nodeInfo.Node.Private }}'
+    {{- with $nodeInfo.PrivateIPv6 }}
+    ipv6Addresses:
+    - '{{ . }}'
+    {{- end }}
     sshPort: {{ $nodeInfo.SshPort }}
     {{- if $nodepool.IsDynamic }}
     sshUsername: root
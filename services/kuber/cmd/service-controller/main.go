@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/berops/claudie/internal/envs"
+	"github.com/berops/claudie/internal/loggerutils"
+	"github.com/berops/claudie/services/kuber/internal/servicecontroller"
+	managerclient "github.com/berops/claudie/services/manager/client"
+	"github.com/rs/zerolog/log"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	// ConfigName and ClusterName identify the cluster within claudie
+	// into which the controller is deployed.
+	ConfigName  = os.Getenv("CONFIG_NAME")
+	ClusterName = os.Getenv("CLUSTER_NAME")
+
+	// Token authenticating the controller with the manager
+	// for the loadbalancer designated for the services.
+	Token = os.Getenv("MANAGER_TOKEN")
+
+	// Workers syncing the services concurrently.
+	Workers = envs.GetOrDefaultInt("WORKERS", 2)
+)
+
+func main() {
+	loggerutils.Init("service-controller")
+
+	if err := run(); err != nil {
+		log.Fatal().Msgf("service-controller finished with: %s", err)
+	}
+}
+
+func run() error {
+	if ConfigName == "" || ClusterName == "" || Token == "" {
+		return errors.New("CONFIG_NAME, CLUSTER_NAME and MANAGER_TOKEN must be set")
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return err
+	}
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	manager, err := managerclient.NewServiceLoadBalancerClient(&log.Logger, envs.ManagerURL, Token)
+	if err != nil {
+		return err
+	}
+	defer manager.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	factory := informers.NewSharedInformerFactory(client, servicecontroller.ResyncPeriod)
+	controller, err := servicecontroller.New(
+		ConfigName,
+		ClusterName,
+		client,
+		manager,
+		factory.Core().V1().Services(),
+		log.With().Str("cluster", ClusterName).Logger(),
+	)
+	if err != nil {
+		return err
+	}
+
+	factory.Start(ctx.Done())
+	defer factory.Shutdown()
+
+	log.Info().Msgf("Watching services of cluster %q from config %q", ClusterName, ConfigName)
+	return controller.Run(ctx, Workers)
+}
@@ -0,0 +1,308 @@
+// Package servicecontroller implements the controller deployed into the kubernetes clusters
+// built by claudie, which exposes the Services of type LoadBalancer on the loadbalancer
+// designated for the services of the cluster.
+package servicecontroller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	managerclient "github.com/berops/claudie/services/manager/client"
+	"github.com/rs/zerolog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	// Finalizer added to the handled services, so that the allocated
+	// ports are released before the service is deleted.
+	Finalizer = "claudie.io/service-loadbalancer"
+
+	// LoadBalancerClass of the services handled by the controller.
+	// Services without a class are handled as well.
+	LoadBalancerClass = "claudie.io/loadbalancer"
+
+	// PortsAnnotation lists the ports allocated on the loadbalancer for the
+	// ports of the service, as comma separated name=port pairs.
+	PortsAnnotation = "claudie.io/loadbalancer-ports"
+
+	// ResyncPeriod is the interval at which all of the services are synced again,
+	// picking up any changes of the allocated ports made by the manager.
+	ResyncPeriod = 10 * time.Minute
+)
+
+// Manager is the part of the manager API used by the controller.
+type Manager interface {
+	UpdateServiceLoadBalancer(ctx context.Context, request *managerclient.UpdateServiceLoadBalancerRequest) (*managerclient.UpdateServiceLoadBalancerResponse, error)
+}
+
+// Controller syncs the Services of type LoadBalancer with the ports allocated for them
+// on the loadbalancer designated for the services of the cluster.
+type Controller struct {
+	// Name of the config and of the cluster within it.
+	config  string
+	cluster string
+
+	client  kubernetes.Interface
+	manager Manager
+	logger  zerolog.Logger
+
+	lister corelisters.ServiceLister
+	synced cache.InformerSynced
+	queue  workqueue.TypedRateLimitingInterface[string]
+}
+
+// New returns a controller for the services of the cluster, watched via the informer.
+func New(
+	config, cluster string,
+	client kubernetes.Interface,
+	manager Manager,
+	informer coreinformers.ServiceInformer,
+	logger zerolog.Logger,
+) (*Controller, error) {
+	c := &Controller{
+		config:  config,
+		cluster: cluster,
+		client:  client,
+		manager: manager,
+		logger:  logger,
+		lister:  informer.Lister(),
+		synced:  informer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "services"},
+		),
+	}
+
+	_, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, obj any) { c.enqueue(obj) },
+		DeleteFunc: c.enqueue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register event handler: %w", err)
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueue(obj any) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run syncs the services with the workers until the context is cancelled.
+func (c *Controller) Run(ctx context.Context, workers int) error {
+	defer c.queue.ShutDown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced) {
+		return errors.New("failed to wait for the services to be synced")
+	}
+
+	c.logger.Info().Msgf("Syncing services with %v workers", workers)
+
+	for range workers {
+		go wait.UntilWithContext(ctx, c.work, time.Second)
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func (c *Controller) work(ctx context.Context) {
+	for {
+		key, shutdown := c.queue.Get()
+		if shutdown {
+			return
+		}
+
+		if err := c.sync(ctx, key); err != nil {
+			c.logger.Err(err).Msgf("Failed to sync service %q, retrying", key)
+			c.queue.AddRateLimited(key)
+		} else {
+			c.queue.Forget(key)
+		}
+
+		c.queue.Done(key)
+	}
+}
+
+// sync allocates the ports for the service on the loadbalancer and updates its status,
+// or releases them if the service is no longer of type LoadBalancer or is being deleted.
+func (c *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	svc, err := c.lister.Services(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		// Handled services are only removed once the finalizer
+		// is removed, at which point the ports were released.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !Handled(svc) || svc.DeletionTimestamp != nil {
+		return c.release(ctx, svc)
+	}
+
+	svc = svc.DeepCopy()
+	if !slices.Contains(svc.Finalizers, Finalizer) {
+		svc.Finalizers = append(svc.Finalizers, Finalizer)
+		if svc, err = c.client.CoreV1().Services(namespace).Update(ctx, svc, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to add finalizer: %w", err)
+		}
+	}
+
+	request := &managerclient.UpdateServiceLoadBalancerRequest{
+		Config:    c.config,
+		Cluster:   c.cluster,
+		Namespace: namespace,
+		Name:      name,
+	}
+	for _, p := range svc.Spec.Ports {
+		// without a node port the traffic can't be forwarded to the service.
+		if p.NodePort == 0 {
+			continue
+		}
+		request.Ports = append(request.Ports, managerclient.ServicePort{
+			Name:     p.Name,
+			Protocol: string(p.Protocol),
+			Port:     p.Port,
+			NodePort: p.NodePort,
+		})
+	}
+
+	resp, err := c.manager.UpdateServiceLoadBalancer(ctx, request)
+	if err != nil {
+		return fmt.Errorf("failed to allocate ports: %w", err)
+	}
+
+	if ports := FormatPorts(resp.Ports); svc.Annotations[PortsAnnotation] != ports {
+		if svc.Annotations == nil {
+			svc.Annotations = make(map[string]string)
+		}
+		svc.Annotations[PortsAnnotation] = ports
+		if svc, err = c.client.CoreV1().Services(namespace).Update(ctx, svc, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to annotate allocated ports: %w", err)
+		}
+	}
+
+	var ingress []corev1.LoadBalancerIngress
+	if resp.Hostname != "" {
+		ingress = append(ingress, corev1.LoadBalancerIngress{Hostname: resp.Hostname})
+	} else {
+		for _, ip := range resp.IPs {
+			ingress = append(ingress, corev1.LoadBalancerIngress{IP: ip})
+		}
+	}
+
+	return c.updateIngress(ctx, svc, ingress)
+}
+
+// release releases the ports allocated for the service, clears its status and removes the finalizer.
+func (c *Controller) release(ctx context.Context, svc *corev1.Service) error {
+	if !slices.Contains(svc.Finalizers, Finalizer) {
+		return nil
+	}
+
+	_, err := c.manager.UpdateServiceLoadBalancer(ctx, &managerclient.UpdateServiceLoadBalancerRequest{
+		Config:    c.config,
+		Cluster:   c.cluster,
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+	})
+	// without a designated loadbalancer, or with the config or
+	// cluster already deleted, there is nothing to release.
+	released := status.Code(err) == codes.FailedPrecondition ||
+		status.Code(err) == codes.NotFound ||
+		errors.Is(err, managerclient.ErrNotFound)
+	if err != nil && !released {
+		return fmt.Errorf("failed to release ports: %w", err)
+	}
+
+	svc = svc.DeepCopy()
+	if svc.DeletionTimestamp == nil {
+		if err := c.updateIngress(ctx, svc, nil); err != nil {
+			return err
+		}
+	}
+
+	svc.Finalizers = slices.DeleteFunc(svc.Finalizers, func(f string) bool { return f == Finalizer })
+	delete(svc.Annotations, PortsAnnotation)
+	if _, err := c.client.CoreV1().Services(svc.Namespace).Update(ctx, svc, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to remove finalizer: %w", err)
+	}
+
+	c.logger.Info().Msgf("Released ports of service %s/%s", svc.Namespace, svc.Name)
+	return nil
+}
+
+func (c *Controller) updateIngress(ctx context.Context, svc *corev1.Service, ingress []corev1.LoadBalancerIngress) error {
+	if equalIngress(svc.Status.LoadBalancer.Ingress, ingress) {
+		return nil
+	}
+
+	svc.Status.LoadBalancer.Ingress = ingress
+	updated, err := c.client.CoreV1().Services(svc.Namespace).UpdateStatus(ctx, svc, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	*svc = *updated
+	return nil
+}
+
+func equalIngress(a, b []corev1.LoadBalancerIngress) bool {
+	return slices.EqualFunc(a, b, func(a, b corev1.LoadBalancerIngress) bool {
+		return a.IP == b.IP && a.Hostname == b.Hostname
+	})
+}
+
+// Handled returns true if the service is of type LoadBalancer and
+// either has no load balancer class or the [LoadBalancerClass].
+func Handled(svc *corev1.Service) bool {
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer {
+		return false
+	}
+	return svc.Spec.LoadBalancerClass == nil || *svc.Spec.LoadBalancerClass == LoadBalancerClass
+}
+
+// FormatPorts formats the allocated ports for the [PortsAnnotation], sorted by the name.
+func FormatPorts(ports map[string]int32) string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(ports)) {
+		if b.Len() > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(strconv.Itoa(int(ports[name])))
+	}
+	return b.String()
+}
@@ -0,0 +1,198 @@
+package servicecontroller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	managerclient "github.com/berops/claudie/services/manager/client"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type fakeManager struct {
+	requests []*managerclient.UpdateServiceLoadBalancerRequest
+	resp     *managerclient.UpdateServiceLoadBalancerResponse
+	err      error
+}
+
+func (m *fakeManager) UpdateServiceLoadBalancer(_ context.Context, request *managerclient.UpdateServiceLoadBalancerRequest) (*managerclient.UpdateServiceLoadBalancerResponse, error) {
+	m.requests = append(m.requests, request)
+	if m.err != nil {
+		return nil, m.err
+	}
+	resp := *m.resp
+	if len(request.Ports) == 0 {
+		resp.Ports = nil
+	}
+	return &resp, nil
+}
+
+func newTestController(t *testing.T, manager Manager, objs ...*corev1.Service) (*Controller, *fake.Clientset) {
+	t.Helper()
+
+	client := fake.NewClientset()
+	factory := informers.NewSharedInformerFactory(client, 0)
+	informer := factory.Core().V1().Services()
+
+	for _, obj := range objs {
+		_, err := client.CoreV1().Services(obj.Namespace).Create(context.Background(), obj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	c, err := New("config", "k8s", client, manager, informer, zerolog.Nop())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	return c, client
+}
+
+func TestController_sync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeLoadBalancer,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, NodePort: 31080},
+				{Name: "pending", Protocol: corev1.ProtocolTCP, Port: 81},
+			},
+		},
+	}
+
+	manager := &fakeManager{resp: &managerclient.UpdateServiceLoadBalancerResponse{
+		Ports: map[string]int32{"http": 30000},
+		IPs:   []string{"1.1.1.1", "2.2.2.2"},
+	}}
+	c, client := newTestController(t, manager, svc)
+
+	require.NoError(t, c.sync(ctx, "default/web"))
+
+	require.Len(t, manager.requests, 1)
+	assert.Equal(t, []managerclient.ServicePort{{Name: "http", Protocol: "TCP", Port: 80, NodePort: 31080}}, manager.requests[0].Ports)
+
+	got, err := client.CoreV1().Services("default").Get(ctx, "web", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, got.Finalizers, Finalizer)
+	assert.Equal(t, "http=30000", got.Annotations[PortsAnnotation])
+	assert.Equal(t, []corev1.LoadBalancerIngress{{IP: "1.1.1.1"}, {IP: "2.2.2.2"}}, got.Status.LoadBalancer.Ingress)
+
+	// the service is no longer of type LoadBalancer.
+	got.Spec.Type = corev1.ServiceTypeNodePort
+	_, err = client.CoreV1().Services("default").Update(ctx, got, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		cached, err := c.lister.Services("default").Get("web")
+		return err == nil && cached.Spec.Type == corev1.ServiceTypeNodePort
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, c.sync(ctx, "default/web"))
+
+	require.Len(t, manager.requests, 2)
+	assert.Empty(t, manager.requests[1].Ports)
+
+	got, err = client.CoreV1().Services("default").Get(ctx, "web", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, got.Finalizers, Finalizer)
+	assert.NotContains(t, got.Annotations, PortsAnnotation)
+	assert.Empty(t, got.Status.LoadBalancer.Ingress)
+}
+
+func TestController_releaseDeletedCluster(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "web",
+			Finalizers:  []string{Finalizer},
+			Annotations: map[string]string{PortsAnnotation: "http=30000"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:  corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, NodePort: 31080}},
+		},
+	}
+
+	manager := &fakeManager{err: errors.Join(
+		status.Error(codes.NotFound, "no config with name \"config\" exists"),
+		managerclient.ErrNotFound,
+	)}
+	c, client := newTestController(t, manager, svc)
+
+	require.NoError(t, c.sync(ctx, "default/web"))
+	require.Len(t, manager.requests, 1)
+
+	got, err := client.CoreV1().Services("default").Get(ctx, "web", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, got.Finalizers, Finalizer)
+	assert.NotContains(t, got.Annotations, PortsAnnotation)
+}
+
+func TestController_syncHostname(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	class := "other.io/loadbalancer"
+	manager := &fakeManager{resp: &managerclient.UpdateServiceLoadBalancerResponse{
+		Ports:    map[string]int32{"dns": 30001},
+		Hostname: "lb.example.com",
+		IPs:      []string{"1.1.1.1"},
+	}}
+	c, client := newTestController(t, manager,
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "dns"},
+			Spec: corev1.ServiceSpec{
+				Type:  corev1.ServiceTypeLoadBalancer,
+				Ports: []corev1.ServicePort{{Name: "dns", Protocol: corev1.ProtocolUDP, Port: 53, NodePort: 31053}},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "other"},
+			Spec: corev1.ServiceSpec{
+				Type:              corev1.ServiceTypeLoadBalancer,
+				LoadBalancerClass: &class,
+				Ports:             []corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, NodePort: 31080}},
+			},
+		},
+	)
+
+	require.NoError(t, c.sync(ctx, "default/dns"))
+	require.NoError(t, c.sync(ctx, "default/other"))
+	require.NoError(t, c.sync(ctx, "default/missing"))
+
+	// services of other classes are left alone.
+	require.Len(t, manager.requests, 1)
+
+	got, err := client.CoreV1().Services("default").Get(ctx, "dns", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}}, got.Status.LoadBalancer.Ingress)
+
+	other, err := client.CoreV1().Services("default").Get(ctx, "other", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, other.Finalizers)
+}
+
+func TestFormatPorts(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", FormatPorts(nil))
+	assert.Equal(t, "dns=30002,http=30000,https=30001", FormatPorts(map[string]int32{"https": 30001, "http": 30000, "dns": 30002}))
+}
@@ -7,10 +7,11 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
 	"github.com/berops/claudie/internal/clusters"
 	comm "github.com/berops/claudie/internal/command"
+	"github.com/berops/claudie/internal/hooks"
+	"github.com/berops/claudie/internal/kube"
 	"github.com/berops/claudie/internal/kubectl"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
@@ -22,8 +23,6 @@ const (
 
 	UnreachableNodesPingCount = clusters.PingRetryCount + 3
 
-	drainTimeout = 30 * time.Minute
-
 	upgradeLockLabelKey = "claudie.io/upgrade-lock"
 )
 
@@ -31,6 +30,10 @@ const (
 // drain because they have the claudie.io/upgrade-lock label set by the operator.
 var ErrUpgradeLocked = errors.New("nodes with claudie.io/upgrade-lock label skipped, waiting for operator to remove label")
 
+// ErrPreDrainHookPending is returned when one or more nodes were skipped during
+// drain because the pre-drain hook of the cluster did not approve their drain.
+var ErrPreDrainHookPending = errors.New("nodes not approved by the pre-drain hook skipped, waiting for the hook to approve them")
+
 // etcdMemberList wraps parsed structures that are
 // needed from the output of the `etcdctl member list`
 // command, ignoring others.
@@ -47,6 +50,7 @@ type etcdMemberList struct {
 type nodeInfo struct {
 	fullname       string
 	k8sName        string
+	nodepool       string
 	publicEndpoint string
 }
 
@@ -56,34 +60,50 @@ type Deleter struct {
 	kubeconfig    string
 	clusterPrefix string
 	controlNode   string
+	preDrainHook  *spec.NodeHook
+	drainPolicy   *spec.DrainPolicy
+
+	// warnings are the non-fatal diagnostics of the deletion,
+	// i.e. the evictions of the nodes deleted despite the drain timeout.
+	warnings []error
 }
 
 // New returns new [Deleter] struct, used for node deletion from a k8s cluster
 // The passed in [spec.K8Scluster] is not modified in any way by any of the
 // functions of the deleter. The passed in deleteMaster and deleteWorker nodes
 // are being worked with to delete them from the kubernetes cluster via the
-// kubeconfig of the [spec.K8Scluster].
+// kubeconfig of the [spec.K8Scluster]. The nodes are drained according to the
+// passed in policy of their nodepool, if nil the [spec.DefaultDrainPolicy] is used.
 func NewDeleter(
 	deleteMaster, deleteWorker []*spec.Node,
+	policy *spec.DrainPolicy,
 	cluster *spec.K8Scluster,
 ) (*Deleter, error) {
+	if policy == nil {
+		policy = spec.DefaultDrainPolicy()
+	}
+
 	var (
 		clusterID = cluster.ClusterInfo.Id()
 		mn, wn    []nodeInfo
 	)
 
 	for i := range deleteMaster {
+		k8sName := strings.TrimPrefix(deleteMaster[i].Name, fmt.Sprintf("%s-", clusterID))
 		mn = append(mn, nodeInfo{
 			fullname:       deleteMaster[i].Name,
-			k8sName:        strings.TrimPrefix(deleteMaster[i].Name, fmt.Sprintf("%s-", clusterID)),
+			k8sName:        k8sName,
+			nodepool:       nodePoolOf(k8sName),
 			publicEndpoint: deleteMaster[i].Public,
 		})
 	}
 
 	for i := range deleteWorker {
+		k8sName := strings.TrimPrefix(deleteWorker[i].Name, fmt.Sprintf("%s-", clusterID))
 		wn = append(wn, nodeInfo{
 			fullname:       deleteWorker[i].Name,
-			k8sName:        strings.TrimPrefix(deleteWorker[i].Name, fmt.Sprintf("%s-", clusterID)),
+			k8sName:        k8sName,
+			nodepool:       nodePoolOf(k8sName),
 			publicEndpoint: deleteWorker[i].Public,
 		})
 	}
@@ -111,12 +131,35 @@ func NewDeleter(
 		kubeconfig:    cluster.Kubeconfig,
 		clusterPrefix: clusterID,
 		controlNode:   strings.TrimPrefix(notDeleted, fmt.Sprintf("%s-", clusterID)),
+		preDrainHook:  cluster.GetNodeHooks().GetPreDrain(),
+		drainPolicy:   policy,
 	}, nil
 }
 
+// nodePoolOf returns the name of the nodepool of the node, as the names
+// of the nodes are of format <nodepool>-<index>.
+func nodePoolOf(k8sName string) string {
+	if i := strings.LastIndex(k8sName, "-"); i > 0 {
+		return k8sName[:i]
+	}
+	return k8sName
+}
+
 // DeleteNodes deletes nodes specified in d.masterNodes and d.workerNodes
 // return nil if successful, error otherwise
 func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
+	client, err := kube.ForCluster(d.clusterPrefix, d.kubeconfig, kube.Options{})
+	if err != nil {
+		return err
+	}
+	return d.deleteNodes(context.Background(), logger, client)
+}
+
+// Warnings returns the non-fatal diagnostics of the last [Deleter.DeleteNodes] call.
+func (d *Deleter) Warnings() []error { return d.warnings }
+
+func (d *Deleter) deleteNodes(ctx context.Context, logger zerolog.Logger, client *kube.Client) error {
+	// etcd members are managed via `etcdctl` executed in the etcd pods.
 	kubectl := kubectl.Kubectl{
 		Kubeconfig:        d.kubeconfig,
 		MaxKubectlRetries: 3,
@@ -124,20 +167,16 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 	kubectl.Stdout = comm.GetStdOut(d.clusterPrefix)
 	kubectl.Stderr = comm.GetStdErr(d.clusterPrefix)
 
+	drainer := newDrainer(client.Kubernetes(), d.drainPolicy)
+	d.warnings = nil
+
 	// get real node names
-	n, err := kubectl.KubectlGetNodeNames()
+	k8snodes, err := client.NodeNames(ctx)
 	if err != nil {
 		return fmt.Errorf("error while getting nodes from cluster: %w", err)
 	}
-
-	var k8snodes []string
-	for node := range strings.SplitSeq(string(n), "\n") {
-		if node := strings.TrimSpace(node); node != "" {
-			k8snodes = append(k8snodes, node)
-		}
-	}
 	var errDel error
-	var locked bool
+	var locked, pending bool
 
 	// Remove master nodes sequentially to minimise risk of faults in etcd
 	for _, master := range d.masterNodes {
@@ -148,10 +187,10 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 			continue
 		}
 
-		hasLock, err := kubectl.KubectlNodeHasLabel(master.k8sName, upgradeLockLabelKey)
+		hasLock, err := client.NodeHasLabel(ctx, master.k8sName, upgradeLockLabelKey)
 		if err != nil {
 			// Fail closed: treat the node as locked so the task is retried
-			// instead of draining on a transient kubectl failure.
+			// instead of draining on a transient API server failure.
 			logger.Warn().Err(err).Msgf("failed to check upgrade-lock label on node %s, deferring drain", master.k8sName)
 			locked = true
 			continue
@@ -162,6 +201,11 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 			continue
 		}
 
+		if !d.preDrainApproved(logger, master) {
+			pending = true
+			continue
+		}
+
 		logger.
 			Info().
 			Msgf("verifying if node %s is reachable", master.k8sName)
@@ -175,7 +219,7 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 							"before deleting it from the cluster", master.k8sName,
 					)
 
-				if err := kubectl.KubectlTaintNodeShutdown(master.k8sName); err != nil {
+				if err := client.TaintNode(ctx, master.k8sName, kube.OutOfServiceTaint); err != nil {
 					logger.
 						Err(err).
 						Msgf(
@@ -195,20 +239,13 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 
 		// IMPORTANT: first you have to cordon and drain, and only after that you can remove from etcd
 		// kubectl cordon <node-name> <args>
-		if err := kubectl.KubectlCordon(master.k8sName); err != nil {
+		if err := client.Cordon(ctx, master.k8sName); err != nil {
 			errDel = errors.Join(errDel, fmt.Errorf("error while cordon master node %s from cluster: %w", master.k8sName, err))
 			continue
 		}
 
-		// kubectl drain <node-name> --ignore-daemonsets --delete-emptydir-data
-		if err := kubectl.KubectlDrainWithTimeout(drainTimeout, master.k8sName); err != nil {
-			if !errors.Is(err, context.DeadlineExceeded) {
-				return fmt.Errorf("error while draining node %s from cluster: %w", master.k8sName, err)
-			}
-
-			logger.
-				Info().
-				Msgf("timeout for draining node %q reached, continuing with deletion", master.k8sName)
+		if err := d.drainNode(ctx, logger, drainer, master.k8sName); err != nil {
+			return err
 		}
 
 		// delete master nodes from etcd
@@ -217,7 +254,7 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 		}
 
 		// delete master nodes
-		if err := d.deleteNodesByName(logger, kubectl, master, k8snodes); err != nil {
+		if err := d.deleteNodesByName(ctx, logger, client, master, k8snodes); err != nil {
 			return fmt.Errorf("error while deleting nodes from master nodes: %w", err)
 		}
 	}
@@ -231,10 +268,10 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 			continue
 		}
 
-		hasLock, err := kubectl.KubectlNodeHasLabel(worker.k8sName, upgradeLockLabelKey)
+		hasLock, err := client.NodeHasLabel(ctx, worker.k8sName, upgradeLockLabelKey)
 		if err != nil {
 			// Fail closed: treat the node as locked so the task is retried
-			// instead of draining on a transient kubectl failure.
+			// instead of draining on a transient API server failure.
 			logger.Warn().Err(err).Msgf("failed to check upgrade-lock label on node %s, deferring drain", worker.k8sName)
 			locked = true
 			continue
@@ -245,6 +282,11 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 			continue
 		}
 
+		if !d.preDrainApproved(logger, worker) {
+			pending = true
+			continue
+		}
+
 		logger.
 			Info().
 			Msgf("verifying if node %s is reachable", worker.k8sName)
@@ -258,7 +300,7 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 							"before deleting it from the cluster", worker.k8sName,
 					)
 
-				if err := kubectl.KubectlTaintNodeShutdown(worker.k8sName); err != nil {
+				if err := client.TaintNode(ctx, worker.k8sName, kube.OutOfServiceTaint); err != nil {
 					logger.
 						Err(err).
 						Msgf(
@@ -276,7 +318,7 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 			}
 		}
 
-		if err := disableDiskScheduling(kubectl, worker.k8sName); err != nil {
+		if err := disableDiskScheduling(ctx, client, worker.k8sName); err != nil {
 			// not a fatal error.
 			logger.
 				Warn().
@@ -284,28 +326,21 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 		}
 
 		// kubectl cordon <node-name> <args>
-		if err := kubectl.KubectlCordon(worker.k8sName); err != nil {
+		if err := client.Cordon(ctx, worker.k8sName); err != nil {
 			errDel = errors.Join(errDel, fmt.Errorf("error while cordon worker node %s from cluster: %w", worker.k8sName, err))
 			continue
 		}
 
-		// kubectl drain <node-name> --ignore-daemonsets --delete-emptydir-data
-		if err := kubectl.KubectlDrainWithTimeout(drainTimeout, worker.k8sName); err != nil {
-			if !errors.Is(err, context.DeadlineExceeded) {
-				return fmt.Errorf("error while draining node %s from cluster: %w", worker.k8sName, err)
-			}
-
-			logger.
-				Info().
-				Msgf("timeout for draining node %q reached, continuing with deletion", worker.k8sName)
+		if err := d.drainNode(ctx, logger, drainer, worker.k8sName); err != nil {
+			return err
 		}
 
-		if err := d.deleteNodesByName(logger, kubectl, worker, k8snodes); err != nil {
+		if err := d.deleteNodesByName(ctx, logger, client, worker, k8snodes); err != nil {
 			errDel = errors.Join(errDel, fmt.Errorf("error while deleting node %s from cluster: %w", worker.k8sName, err))
 			continue
 		}
 
-		if err := removeReplicasOnDeletedNode(kubectl, worker.k8sName); err != nil {
+		if err := removeReplicasOnDeletedNode(ctx, client, worker.k8sName); err != nil {
 			// not a fatal error.
 			logger.
 				Warn().
@@ -318,13 +353,61 @@ func (d *Deleter) DeleteNodes(logger zerolog.Logger) error {
 	}
 
 	if locked {
-		return errors.Join(errDel, ErrUpgradeLocked)
+		errDel = errors.Join(errDel, ErrUpgradeLocked)
+	}
+	if pending {
+		errDel = errors.Join(errDel, ErrPreDrainHookPending)
 	}
 	return errDel
 }
 
+// drainNode evicts the pods of the node. Once the timeout of the drain policy is
+// reached the node is deleted regardless, unless the policy requires to fail instead,
+// in which case the error describing the blocked evictions is returned. Otherwise
+// the error is kept as a warning, so that the blocked evictions are still reported.
+func (d *Deleter) drainNode(ctx context.Context, logger zerolog.Logger, drainer *drainer, node string) error {
+	err := drainer.drain(ctx, logger, node)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrDrainTimeout) && !d.drainPolicy.FailOnTimeout:
+		logger.Warn().Msgf("%v, continuing with deletion", err)
+		d.warnings = append(d.warnings, fmt.Errorf("%w, node was deleted regardless", err))
+		return nil
+	default:
+		return fmt.Errorf("error while draining node %s from cluster: %w", node, err)
+	}
+}
+
+// preDrainApproved calls the pre-drain hook of the cluster for the node and reports
+// whether the node can be drained. Same as with the upgrade-lock label, any failure
+// of the hook defers the drain so that the task is retried.
+func (d *Deleter) preDrainApproved(logger zerolog.Logger, node nodeInfo) bool {
+	if d.preDrainHook == nil {
+		return true
+	}
+
+	err := hooks.Call(context.Background(), d.preDrainHook, hooks.Request{
+		Event:    hooks.PreDrain,
+		Cluster:  d.clusterPrefix,
+		NodePool: node.nodepool,
+		Node:     node.k8sName,
+		Endpoint: node.publicEndpoint,
+	})
+	switch {
+	case err == nil:
+		logger.Info().Msgf("pre-drain hook approved drain of node %s", node.k8sName)
+		return true
+	case errors.Is(err, hooks.ErrRetryLater):
+		logger.Info().Msgf("pre-drain hook asked to retry later, skipping drain of node %s", node.k8sName)
+	default:
+		logger.Warn().Err(err).Msgf("pre-drain hook failed, deferring drain of node %s", node.k8sName)
+	}
+	return false
+}
+
 // deleteNodesByName deletes node from the k8s cluster.
-func (d *Deleter) deleteNodesByName(logger zerolog.Logger, kc kubectl.Kubectl, node nodeInfo, realNodeNames []string) error {
+func (d *Deleter) deleteNodesByName(ctx context.Context, logger zerolog.Logger, client *kube.Client, node nodeInfo, realNodeNames []string) error {
 	if !slices.Contains(realNodeNames, node.k8sName) {
 		logger.Warn().Msgf("Node with name %s not found in cluster", node.k8sName)
 		return nil
@@ -332,8 +415,7 @@ func (d *Deleter) deleteNodesByName(logger zerolog.Logger, kc kubectl.Kubectl, n
 
 	logger.Info().Msgf("Deleting node %s from k8s cluster", node.k8sName)
 
-	//kubectl delete node <node-name>
-	if err := kc.KubectlDeleteResource("nodes", node.k8sName); err != nil {
+	if err := client.DeleteNode(ctx, node.k8sName); err != nil {
 		return fmt.Errorf("error while deleting node %s from cluster: %w", node.k8sName, err)
 	}
 
@@ -0,0 +1,114 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berops/claudie/internal/kube"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testReplica(name, node string, failed bool) *unstructured.Unstructured {
+	r := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "longhorn.io/v1beta2",
+		"kind":       "Replica",
+		"metadata":   map[string]any{"name": name, "namespace": longhornNamespace},
+		"spec":       map[string]any{"nodeID": node},
+		"status":     map[string]any{"currentState": "stopped", "started": false},
+	}}
+	if failed {
+		r.Object["spec"].(map[string]any)["failedAt"] = "2026-01-01T00:00:00Z"
+	}
+	return r
+}
+
+func TestDeleteWorkerNodes(t *testing.T) {
+	t.Parallel()
+
+	cluster := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "test",
+			Hash: "abcdefg",
+			NodePools: []*spec.NodePool{
+				{
+					Name:      "control",
+					IsControl: true,
+					Nodes:     []*spec.Node{{Name: "test-abcdefg-control-01"}},
+				},
+				{
+					Name: "worker",
+					Nodes: []*spec.Node{
+						{Name: "test-abcdefg-worker-01", Public: "127.0.0.1"},
+						{Name: "test-abcdefg-worker-02", Public: "127.0.0.1"},
+					},
+				},
+			},
+		},
+	}
+
+	node := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	clientset := fake.NewClientset(
+		node("control-01", nil),
+		node("worker-01", nil),
+		node("worker-02", map[string]string{upgradeLockLabelKey: ""}),
+	)
+
+	longhornNode := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "longhorn.io/v1beta2",
+		"kind":       "Node",
+		"metadata":   map[string]any{"name": "worker-01", "namespace": longhornNamespace},
+		"spec":       map[string]any{"allowScheduling": true},
+	}}
+	dyn := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			longhornReplicas: "ReplicaList",
+			longhornNodes:    "NodeList",
+		},
+		longhornNode,
+		testReplica("failed", "worker-01", true),
+		testReplica("healthy", "worker-01", false),
+		testReplica("other", "worker-02", true),
+	)
+	client := kube.NewForClientsets(cluster.ClusterInfo.Id(), clientset, dyn)
+
+	d, err := NewDeleter(nil, cluster.ClusterInfo.NodePools[1].Nodes, nil, cluster)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = d.deleteNodes(ctx, zerolog.Nop(), client)
+	require.ErrorIs(t, err, ErrUpgradeLocked)
+
+	names, err := client.NodeNames(ctx)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"control-01", "worker-02"}, names)
+
+	locked, err := client.Node(ctx, "worker-02")
+	require.NoError(t, err)
+	require.False(t, locked.Spec.Unschedulable)
+
+	replicas, err := client.List(ctx, longhornReplicas, longhornNamespace)
+	require.NoError(t, err)
+	var remaining []string
+	for _, r := range replicas {
+		remaining = append(remaining, r.GetName())
+	}
+	require.ElementsMatch(t, []string{"healthy", "other"}, remaining)
+
+	lnode, err := dyn.Resource(longhornNodes).Namespace(longhornNamespace).Get(ctx, "worker-01", metav1.GetOptions{})
+	require.NoError(t, err)
+	allow, _, _ := unstructured.NestedBool(lnode.Object, "spec", "allowScheduling")
+	require.False(t, allow)
+}
@@ -0,0 +1,227 @@
+package nodes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// evictionRetryInterval is the interval between the retries of the
+	// evictions that were refused, i.e. blocked by a PodDisruptionBudget.
+	evictionRetryInterval = 5 * time.Second
+
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+)
+
+// ErrDrainTimeout is returned when the pods of a node were not evicted
+// within the timeout of the [spec.DrainPolicy] of the nodepool.
+var ErrDrainTimeout = errors.New("timeout for draining node reached")
+
+// drainer evicts the pods of a node, respecting their PodDisruptionBudgets.
+type drainer struct {
+	client   kubernetes.Interface
+	policy   *spec.DrainPolicy
+	interval time.Duration
+}
+
+func newDrainer(client kubernetes.Interface, policy *spec.DrainPolicy) *drainer {
+	return &drainer{client: client, policy: policy, interval: evictionRetryInterval}
+}
+
+// drain evicts the pods of the node until all of them are deleted. If the timeout
+// of the policy is reached, an error wrapping [ErrDrainTimeout] is returned that
+// describes the pods that were not evicted and the PodDisruptionBudgets blocking them.
+// The node is expected to be already cordoned.
+func (d *drainer) drain(ctx context.Context, logger zerolog.Logger, node string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.policy.DrainTimeout())
+	defer cancel()
+
+	pods, err := d.podsToEvict(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	logger.Info().Msgf("evicting %v pods from node %s", len(pods), node)
+
+	blocked := make(map[types.UID]string)
+	for {
+		for _, pod := range pods {
+			if pod.DeletionTimestamp != nil {
+				// already evicted, waiting for the pod to terminate.
+				continue
+			}
+
+			reason, err := d.evict(ctx, pod)
+			if err != nil {
+				if ctx.Err() != nil {
+					break
+				}
+				return fmt.Errorf("failed to evict pod %s/%s from node %s: %w", pod.Namespace, pod.Name, node, err)
+			}
+			if reason != "" {
+				blocked[pod.UID] = reason
+			} else {
+				delete(blocked, pod.UID)
+			}
+		}
+
+		if pods, err = d.remaining(ctx, pods); err != nil && ctx.Err() == nil {
+			return fmt.Errorf("failed to check the evicted pods of node %s: %w", node, err)
+		}
+		if len(pods) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			var reasons []string
+			for _, pod := range pods {
+				reason, ok := blocked[pod.UID]
+				if !ok {
+					reason = fmt.Sprintf("pod %s/%s did not terminate", pod.Namespace, pod.Name)
+				}
+				reasons = append(reasons, reason)
+			}
+			slices.Sort(reasons)
+			return fmt.Errorf("%w for node %s after %v: %s", ErrDrainTimeout, node, d.policy.DrainTimeout(), strings.Join(reasons, "; "))
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+// podsToEvict returns the pods of the node that are evicted by the drain. Returns
+// an error if there are pods on the node that the policy does not allow to evict.
+func (d *drainer) podsToEvict(ctx context.Context, node string) ([]corev1.Pod, error) {
+	list, err := d.client.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", node).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of node %s: %w", node, err)
+	}
+
+	var (
+		out                             []corev1.Pod
+		daemonSets, emptyDir, unmanaged []string
+	)
+
+	for _, pod := range list.Items {
+		if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+			// static pods are managed by the kubelet, can't be evicted.
+			continue
+		}
+
+		name := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+		finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		controller := metav1.GetControllerOf(&pod)
+
+		if controller != nil && controller.Kind == "DaemonSet" {
+			if !d.policy.GetIgnoreDaemonSets() {
+				daemonSets = append(daemonSets, name)
+			}
+			continue
+		}
+
+		if !finished {
+			if controller == nil {
+				unmanaged = append(unmanaged, name)
+				continue
+			}
+			if !d.policy.GetDeleteEmptyDirData() && slices.ContainsFunc(pod.Spec.Volumes, func(v corev1.Volume) bool { return v.EmptyDir != nil }) {
+				emptyDir = append(emptyDir, name)
+				continue
+			}
+		}
+
+		out = append(out, pod)
+	}
+
+	var errs error
+	if len(daemonSets) > 0 {
+		errs = errors.Join(errs, fmt.Errorf("pods managed by DaemonSets are not ignored by the drain policy: %v", daemonSets))
+	}
+	if len(emptyDir) > 0 {
+		errs = errors.Join(errs, fmt.Errorf("pods with emptyDir volumes are not deleted by the drain policy: %v", emptyDir))
+	}
+	if len(unmanaged) > 0 {
+		errs = errors.Join(errs, fmt.Errorf("pods not managed by a controller can't be evicted: %v", unmanaged))
+	}
+	if errs != nil {
+		return nil, fmt.Errorf("cannot drain node %s: %w", node, errs)
+	}
+
+	return out, nil
+}
+
+// evict evicts the pod. If the eviction was refused, the reason is returned.
+func (d *drainer) evict(ctx context.Context, pod corev1.Pod) (string, error) {
+	err := d.client.PolicyV1().Evictions(pod.Namespace).Evict(ctx, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+	})
+	switch {
+	case err == nil, apierrors.IsNotFound(err):
+		return "", nil
+	case apierrors.IsTooManyRequests(err):
+		pdbs, lerr := d.disruptionBudgetsOf(ctx, pod)
+		if lerr != nil || len(pdbs) == 0 {
+			return fmt.Sprintf("eviction of pod %s/%s refused: %v", pod.Namespace, pod.Name, err), nil
+		}
+		return fmt.Sprintf("eviction of pod %s/%s blocked by PodDisruptionBudget %v", pod.Namespace, pod.Name, pdbs), nil
+	default:
+		return "", err
+	}
+}
+
+// disruptionBudgetsOf returns the names of the PodDisruptionBudgets selecting the pod.
+func (d *drainer) disruptionBudgetsOf(ctx context.Context, pod corev1.Pod) ([]string, error) {
+	list, err := d.client.PolicyV1().PodDisruptionBudgets(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for _, pdb := range list.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			out = append(out, fmt.Sprintf("%s/%s", pdb.Namespace, pdb.Name))
+		}
+	}
+	return out, nil
+}
+
+// remaining returns the pods that were not yet deleted, with their state refreshed.
+func (d *drainer) remaining(ctx context.Context, pods []corev1.Pod) ([]corev1.Pod, error) {
+	var out []corev1.Pod
+	for _, pod := range pods {
+		current, err := d.client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return pods, err
+		case current.UID != pod.UID:
+			// the pod was recreated by its controller under the same name.
+			continue
+		}
+		out = append(out, *current)
+	}
+	return out, nil
+}
@@ -0,0 +1,156 @@
+package nodes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func testPod(name, owner string, podLabels map[string]string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID("uid-" + name),
+			Labels:    podLabels,
+		},
+		Spec: corev1.PodSpec{NodeName: "worker-01"},
+	}
+	if owner != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			Kind:       owner,
+			Name:       "owner",
+			Controller: new(true),
+		}}
+	}
+	return pod
+}
+
+// newTestDrainer returns a drainer for which the evictions of the pods not
+// listed in blocked succeed, deleting the pod, and the others are refused.
+func newTestDrainer(t *testing.T, policy *spec.DrainPolicy, blocked []string, objs ...runtime.Object) *drainer {
+	t.Helper()
+
+	client := fake.NewClientset(objs...)
+	client.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		eviction := action.(k8stesting.CreateAction).GetObject().(*policyv1.Eviction)
+		for _, b := range blocked {
+			if b == eviction.Name {
+				return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 0)
+			}
+		}
+		gvr := corev1.SchemeGroupVersion.WithResource("pods")
+		return true, nil, client.Tracker().Delete(gvr, eviction.Namespace, eviction.Name)
+	})
+
+	d := newDrainer(client, policy)
+	d.interval = 10 * time.Millisecond
+	return d
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	mirror := testPod("kube-proxy", "", nil)
+	mirror.Annotations = map[string]string{mirrorPodAnnotation: "hash"}
+
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-pdb", Namespace: "default"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		},
+	}
+
+	t.Run("evicts-pods", func(t *testing.T) {
+		t.Parallel()
+
+		d := newTestDrainer(t, spec.DefaultDrainPolicy(), nil,
+			testPod("web", "ReplicaSet", nil),
+			testPod("agent", "DaemonSet", nil),
+			mirror,
+		)
+		require.NoError(t, d.drain(context.Background(), zerolog.Nop(), "worker-01"))
+
+		pods, err := d.client.CoreV1().Pods("default").List(context.Background(), metav1.ListOptions{})
+		require.NoError(t, err)
+		require.Len(t, pods.Items, 2)
+	})
+
+	t.Run("blocked-by-pdb", func(t *testing.T) {
+		t.Parallel()
+
+		policy := spec.DefaultDrainPolicy()
+		policy.Timeout = "100ms"
+
+		d := newTestDrainer(t, policy, []string{"db-0"},
+			testPod("web", "ReplicaSet", nil),
+			testPod("db-0", "StatefulSet", map[string]string{"app": "db"}),
+			pdb,
+		)
+		err := d.drain(context.Background(), zerolog.Nop(), "worker-01")
+		require.ErrorIs(t, err, ErrDrainTimeout)
+		require.ErrorContains(t, err, "eviction of pod default/db-0 blocked by PodDisruptionBudget [default/db-pdb]")
+		require.NotContains(t, err.Error(), "default/web")
+	})
+
+	t.Run("forced-after-timeout", func(t *testing.T) {
+		t.Parallel()
+
+		policy := spec.DefaultDrainPolicy()
+		policy.Timeout = "100ms"
+
+		drainer := newTestDrainer(t, policy, []string{"db-0"},
+			testPod("db-0", "StatefulSet", map[string]string{"app": "db"}),
+			pdb,
+		)
+		d := &Deleter{drainPolicy: policy}
+		require.NoError(t, d.drainNode(context.Background(), zerolog.Nop(), drainer, "worker-01"))
+
+		require.Len(t, d.Warnings(), 1)
+		require.ErrorIs(t, d.Warnings()[0], ErrDrainTimeout)
+		require.ErrorContains(t, d.Warnings()[0], "eviction of pod default/db-0 blocked by PodDisruptionBudget [default/db-pdb]")
+
+		policy.FailOnTimeout = true
+		d = &Deleter{drainPolicy: policy}
+		require.ErrorIs(t, d.drainNode(context.Background(), zerolog.Nop(), drainer, "worker-01"), ErrDrainTimeout)
+		require.Empty(t, d.Warnings())
+	})
+
+	t.Run("policy-refuses", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &spec.DrainPolicy{Timeout: "1m"}
+		withEmptyDir := testPod("cache", "ReplicaSet", nil)
+		withEmptyDir.Spec.Volumes = []corev1.Volume{{
+			Name:         "data",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}}
+
+		d := newTestDrainer(t, policy, nil,
+			testPod("agent", "DaemonSet", nil),
+			testPod("standalone", "", nil),
+			withEmptyDir,
+		)
+		err := d.drain(context.Background(), zerolog.Nop(), "worker-01")
+		require.ErrorContains(t, err, "DaemonSets are not ignored by the drain policy: [default/agent]")
+		require.ErrorContains(t, err, "emptyDir volumes are not deleted by the drain policy: [default/cache]")
+		require.ErrorContains(t, err, "not managed by a controller can't be evicted: [default/standalone]")
+		require.NotErrorIs(t, err, ErrDrainTimeout)
+	})
+}
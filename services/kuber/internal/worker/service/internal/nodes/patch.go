@@ -8,12 +8,14 @@ import (
 	"maps"
 	"strings"
 
-	comm "github.com/berops/claudie/internal/command"
-	"github.com/berops/claudie/internal/kubectl"
+	"github.com/berops/claudie/internal/kube"
 	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const patchProviderIDPathFormat = "{\"spec\":{\"providerID\":\"%s\"}}"
@@ -33,7 +35,7 @@ type MetadataAnnotations struct {
 }
 
 type patchData struct {
-	kBase        kubectl.Kubectl
+	client       *kube.Client
 	clusterID    string
 	wg           *errgroup.Group
 	processLimit *semaphore.Weighted
@@ -46,14 +48,25 @@ func Patch(
 	cluster *spec.K8Scluster,
 	processLimit *semaphore.Weighted,
 	workersLimit int,
+) error {
+	client, err := kube.ForCluster(cluster.ClusterInfo.Id(), cluster.Kubeconfig, kube.Options{})
+	if err != nil {
+		return err
+	}
+	return patchNodes(logger, client, patch, cluster, processLimit, workersLimit)
+}
+
+func patchNodes(
+	logger zerolog.Logger,
+	client *kube.Client,
+	patch *spec.Update_KuberPatchNodes,
+	cluster *spec.K8Scluster,
+	processLimit *semaphore.Weighted,
+	workersLimit int,
 ) error {
 	var (
 		clusterID = cluster.ClusterInfo.Id()
 		errChan   = make(chan error)
-		kbase     = kubectl.Kubectl{
-			Kubeconfig:        cluster.Kubeconfig,
-			MaxKubectlRetries: 3,
-		}
 
 		aggregateDone  = make(chan struct{})
 		aggregateError error
@@ -71,7 +84,7 @@ func Patch(
 	wg.SetLimit(workersLimit)
 
 	p := patchData{
-		kBase:        kbase,
+		client:       client,
 		clusterID:    clusterID,
 		wg:           wg,
 		processLimit: processLimit,
@@ -99,7 +112,7 @@ func Patch(
 	wg, ctx = errgroup.WithContext(context.Background())
 	wg.SetLimit(workersLimit)
 	p = patchData{
-		kBase:        kbase,
+		client:       client,
 		clusterID:    clusterID,
 		wg:           wg,
 		processLimit: processLimit,
@@ -136,10 +149,6 @@ func patchProviderID(
 		nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 		patchPath := fmt.Sprintf(patchProviderIDPathFormat, fmt.Sprintf(spec.ProviderIdFormat, nodeName))
 
-		kc := p.kBase
-		kc.Stdout = comm.GetStdOut(p.clusterID)
-		kc.Stderr = comm.GetStdErr(p.clusterID)
-
 		p.wg.Go(func() error {
 			if err := p.processLimit.Acquire(ctx, 1); err != nil {
 				p.errChan <- fmt.Errorf("error while patching node, failed to acquire sempahore: %w", err)
@@ -147,7 +156,7 @@ func patchProviderID(
 			}
 			defer p.processLimit.Release(1)
 
-			if err := kc.KubectlPatch("node", nodeName, patchPath); err != nil {
+			if err := p.client.PatchNode(ctx, nodeName, types.StrategicMergePatchType, []byte(patchPath)); err != nil {
 				logger.Err(err).Str("node", nodeName).Msgf("Error while patching node with patch %s", patchPath)
 				p.errChan <- fmt.Errorf("error while patching one or more nodes with providerID")
 				// fallthrough
@@ -175,10 +184,6 @@ func removeLabels(
 		for _, node := range np.Nodes {
 			nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-			kc := p.kBase
-			kc.Stdout = comm.GetStdOut(p.clusterID)
-			kc.Stderr = comm.GetStdErr(p.clusterID)
-
 			p.wg.Go(func() error {
 				if err := p.processLimit.Acquire(ctx, 1); err != nil {
 					p.errChan <- fmt.Errorf("error while patching node, failed to acquire sempahore: %w", err)
@@ -186,7 +191,7 @@ func removeLabels(
 				}
 				defer p.processLimit.Release(1)
 
-				if err := kc.KubectlPatch("node", nodeName, patchPath, "--type", "json"); err != nil {
+				if err := p.client.PatchNode(ctx, nodeName, types.JSONPatchType, []byte(patchPath)); err != nil {
 					logger.Err(err).Str("node", nodeName).Msgf("Failed to patch labels on node with path %s", patchPath)
 					p.errChan <- fmt.Errorf("failed to remove labels on node %s with path %s: %w", nodeName, patchPath, err)
 					// fallthrough
@@ -232,10 +237,6 @@ func label(
 	for _, node := range nodes {
 		nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-		kc := p.kBase
-		kc.Stdout = comm.GetStdOut(p.clusterID)
-		kc.Stderr = comm.GetStdErr(p.clusterID)
-
 		p.wg.Go(func() error {
 			if err := p.processLimit.Acquire(ctx, 1); err != nil {
 				p.errChan <- fmt.Errorf("error while patching node, failed to acquire semaphore: %w", err)
@@ -243,7 +244,7 @@ func label(
 			}
 			defer p.processLimit.Release(1)
 
-			if err := kc.KubectlPatch("node", nodeName, patch, "--type", "json"); err != nil {
+			if err := p.client.PatchNode(ctx, nodeName, types.JSONPatchType, []byte(patch)); err != nil {
 				logger.Err(err).Str("node", nodeName).Msgf("Failed to patch labels on node with path %s", patch)
 				p.errChan <- fmt.Errorf("error while patching one or more nodes with labels")
 				// fallthrough
@@ -272,10 +273,6 @@ func removeAnnotations(
 		for _, node := range np.Nodes {
 			nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-			kc := p.kBase
-			kc.Stdout = comm.GetStdOut(p.clusterID)
-			kc.Stderr = comm.GetStdErr(p.clusterID)
-
 			p.wg.Go(func() error {
 				if err := p.processLimit.Acquire(ctx, 1); err != nil {
 					p.errChan <- fmt.Errorf("error while patching node, failed to acquire semaphore: %w", err)
@@ -283,7 +280,7 @@ func removeAnnotations(
 				}
 				defer p.processLimit.Release(1)
 
-				if err := kc.KubectlPatch("node", nodeName, patchPath, "--type", "json"); err != nil {
+				if err := p.client.PatchNode(ctx, nodeName, types.JSONPatchType, []byte(patchPath)); err != nil {
 					logger.Err(err).Str("node", nodeName).Msgf("Failed to patch annotations on node %s", nodeName)
 					p.errChan <- fmt.Errorf("failed to remove annotations on node %s: %w", nodeName, err)
 					// fallthrough
@@ -364,10 +361,6 @@ func annotate(ctx context.Context, logger zerolog.Logger, p patchData, patch str
 	for _, node := range nodes {
 		nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-		kc := p.kBase
-		kc.Stdout = comm.GetStdOut(p.clusterID)
-		kc.Stderr = comm.GetStdErr(p.clusterID)
-
 		p.wg.Go(func() error {
 			if err := p.processLimit.Acquire(ctx, 1); err != nil {
 				p.errChan <- fmt.Errorf("error while patching node, failed to acquire semaphore: %w", err)
@@ -375,7 +368,7 @@ func annotate(ctx context.Context, logger zerolog.Logger, p patchData, patch str
 			}
 			defer p.processLimit.Release(1)
 
-			if err := kc.KubectlPatch("node", nodeName, patch, "--type", "merge"); err != nil {
+			if err := p.client.PatchNode(ctx, nodeName, types.MergePatchType, []byte(patch)); err != nil {
 				logger.Err(err).Str("node", nodeName).Msgf("Failed to patch annotations on node %s", nodeName)
 				p.errChan <- fmt.Errorf("error while applying annotations %v for node %s: %w", patch, nodeName, err)
 				// fallthrough
@@ -393,16 +386,14 @@ func removeTaints(
 	taints []*spec.Taint,
 ) {
 	for _, taint := range taints {
-		key := taint.Key
-		value := taint.Value
-		effect := taint.Effect
+		toRemove := corev1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: corev1.TaintEffect(taint.Effect),
+		}
 		for _, node := range np.Nodes {
 			nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-			kc := p.kBase
-			kc.Stdout = comm.GetStdOut(p.clusterID)
-			kc.Stderr = comm.GetStdErr(p.clusterID)
-
 			p.wg.Go(func() error {
 				if err := p.processLimit.Acquire(ctx, 1); err != nil {
 					p.errChan <- fmt.Errorf("error while patching node, failed to acquire semaphore: %w", err)
@@ -410,7 +401,7 @@ func removeTaints(
 				}
 				defer p.processLimit.Release(1)
 
-				if err := kc.KubectlTaintRemove(nodeName, key, value, effect); err != nil {
+				if err := p.client.RemoveTaints(ctx, nodeName, toRemove); err != nil {
 					logger.Err(err).Str("node", nodeName).Msgf("Failed to remove taint %s on node %s", taint, nodeName)
 					p.errChan <- fmt.Errorf("failed to remove taint %s on node %s: %w", taint, nodeName, err)
 					// fallthrough
@@ -450,10 +441,6 @@ func taint(
 	for _, node := range nodes {
 		nodeName := strings.TrimPrefix(node.Name, fmt.Sprintf("%s-", p.clusterID))
 
-		kc := p.kBase
-		kc.Stdout = comm.GetStdOut(p.clusterID)
-		kc.Stderr = comm.GetStdErr(p.clusterID)
-
 		p.wg.Go(func() error {
 			if err := p.processLimit.Acquire(ctx, 1); err != nil {
 				p.errChan <- fmt.Errorf("error while patching node, failed to acquire semaphore: %w", err)
@@ -461,7 +448,7 @@ func taint(
 			}
 			defer p.processLimit.Release(1)
 
-			if err := kc.KubectlPatch("node", nodeName, patchPath, "--type", "json"); err != nil {
+			if err := p.client.PatchNode(ctx, nodeName, types.JSONPatchType, []byte(patchPath)); err != nil {
 				logger.Err(err).Str("node", nodeName).Msgf("Failed to patch taints on node with path %s", patchPath)
 				p.errChan <- fmt.Errorf("error while patching nodes with taints: %w", err)
 				// fallthrough
@@ -0,0 +1,82 @@
+package nodes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/berops/claudie/internal/kube"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPatchNodes(t *testing.T) {
+	t.Parallel()
+
+	cluster := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "test",
+			Hash: "abcdefg",
+			NodePools: []*spec.NodePool{{
+				Name:        "static",
+				Type:        &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}},
+				Labels:      map[string]string{"env": "prod"},
+				Annotations: map[string]string{"team": "db"},
+				Taints:      []*spec.Taint{{Key: "dedicated", Value: "db", Effect: "NoSchedule"}},
+				Nodes:       []*spec.Node{{Name: "test-abcdefg-static-01"}},
+			}},
+		},
+	}
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "static-01",
+			Labels:      map[string]string{"stale": "true"},
+			Annotations: map[string]string{"stale": "true"},
+		},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{{Key: "stale", Value: "true", Effect: corev1.TaintEffectNoExecute}},
+		},
+	}
+	client := kube.NewForClientsets(cluster.ClusterInfo.Id(), fake.NewClientset(node), nil)
+
+	patch := &spec.Update_KuberPatchNodes{
+		Add: &spec.Update_KuberPatchNodes_AddBatch{
+			Labels: map[string]*spec.Update_KuberPatchNodes_MapOfLabels{
+				"static": {Labels: map[string]string{"tier": "backend"}},
+			},
+		},
+		Remove: &spec.Update_KuberPatchNodes_RemoveBatch{
+			Labels: map[string]*spec.Update_KuberPatchNodes_ListOfLabelKeys{
+				"static": {Labels: []string{"stale"}},
+			},
+			Annotations: map[string]*spec.Update_KuberPatchNodes_ListOfAnnotationKeys{
+				"static": {Annotations: []string{"stale"}},
+			},
+		},
+	}
+
+	err := patchNodes(zerolog.Nop(), client, patch, cluster, semaphore.NewWeighted(2), 2)
+	require.NoError(t, err)
+
+	got, err := client.Node(context.Background(), "static-01")
+	require.NoError(t, err)
+
+	require.Equal(t, fmt.Sprintf(spec.ProviderIdFormat, "static-01"), got.Spec.ProviderID)
+	require.Equal(t, "prod", got.Labels["env"])
+	require.Equal(t, "backend", got.Labels["tier"])
+	require.Equal(t, "static", got.Labels["claudie.io/nodepool"])
+	require.NotContains(t, got.Labels, "stale")
+
+	require.Equal(t, "db", got.Annotations["team"])
+	require.Contains(t, got.Annotations, "node.longhorn.io/default-node-tags")
+	require.NotContains(t, got.Annotations, "stale")
+
+	require.Equal(t, []corev1.Taint{{Key: "dedicated", Value: "db", Effect: corev1.TaintEffectNoSchedule}}, got.Spec.Taints)
+}
@@ -1,47 +1,53 @@
 package nodes
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
-	"github.com/berops/claudie/internal/kubectl"
-	"go.yaml.in/yaml/v3"
+	"github.com/berops/claudie/internal/kube"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-type ReplicaList struct {
-	Items []LonghornReplica `yaml:"items"`
-}
+var (
+	longhornReplicas = schema.GroupVersionResource{Group: "longhorn.io", Version: "v1beta2", Resource: "replicas"}
+	longhornNodes    = schema.GroupVersionResource{Group: "longhorn.io", Version: "v1beta2", Resource: "nodes"}
+)
 
 type LonghornReplica struct {
 	Metadata struct {
-		Name string `yaml:"name"`
-	} `yaml:"metadata"`
+		Name string `json:"name"`
+	} `json:"metadata"`
 
 	Status struct {
-		InstanceManagerName string `yaml:"instanceManagerName"`
-		CurrentState        string `yaml:"currentState"`
-		Started             bool   `yaml:"started"`
-	} `yaml:"status"`
+		InstanceManagerName string `json:"instanceManagerName"`
+		CurrentState        string `json:"currentState"`
+		Started             bool   `json:"started"`
+	} `json:"status"`
 
 	Spec struct {
-		NodeID   string `yaml:"nodeID"`
-		FailedAt string `yaml:"failedAt"`
-	} `yaml:"spec"`
+		NodeID   string `json:"nodeID"`
+		FailedAt string `json:"failedAt"`
+	} `json:"spec"`
 }
 
-func removeReplicasOnDeletedNode(kc kubectl.Kubectl, node string) error {
-	out, err := kc.KubectlGet("replicas.longhorn.io", "-n", longhornNamespace, "-o", "yaml")
+func removeReplicasOnDeletedNode(ctx context.Context, client *kube.Client, node string) error {
+	items, err := client.List(ctx, longhornReplicas, longhornNamespace)
 	if err != nil {
 		return fmt.Errorf("failed to list all replicas : %w", err)
 	}
 
-	var replicaList ReplicaList
-	if err := yaml.Unmarshal(out, &replicaList); err != nil {
-		return fmt.Errorf("failed unmarshal kubectl output : %w", err)
-	}
-
 	var errAll error
-	for _, replica := range replicaList.Items {
+	for _, item := range items {
+		var replica LonghornReplica
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &replica); err != nil {
+			errAll = errors.Join(errAll, fmt.Errorf("failed to convert replica %s : %w", item.GetName(), err))
+			continue
+		}
+
 		// https://github.com/longhorn/longhorn/blob/6cc47ec5e942f33b10f644a5eaf0970b650e27a7/deploy/longhorn.yaml#L3048
 		// spec.NodeID is the node where the replica is on, this should
 		// matched the deleted node.
@@ -52,8 +58,7 @@ func removeReplicasOnDeletedNode(kc kubectl.Kubectl, node string) error {
 		del = del && replica.Spec.FailedAt != ""
 
 		if del {
-			err := kc.KubectlDeleteResource("replicas.longhorn.io", replica.Metadata.Name, "-n", longhornNamespace)
-			if err != nil {
+			if err := client.Delete(ctx, longhornReplicas, longhornNamespace, replica.Metadata.Name); err != nil {
 				errAll = errors.Join(errAll, fmt.Errorf("failed to delete replica %s: %w", replica.Metadata.Name, err))
 			}
 		}
@@ -63,14 +68,14 @@ func removeReplicasOnDeletedNode(kc kubectl.Kubectl, node string) error {
 }
 
 // Disables disk scheduling on the specified node. Needed when the node is about to be deleted.
-func disableDiskScheduling(kc kubectl.Kubectl, node string) error {
+func disableDiskScheduling(ctx context.Context, client *kube.Client, node string) error {
 	// https://longhorn.io/docs/archives/1.4.0/volumes-and-nodes/maintenance/#removing-a-node
-	return kc.KubectlPatch(
-		"nodes.longhorn.io",
-		node,
-		"{\"spec\":{\"allowScheduling\":false}}",
-		"--type=merge",
-		"-n",
+	return client.Patch(
+		ctx,
+		longhornNodes,
 		longhornNamespace,
+		node,
+		types.MergePatchType,
+		[]byte("{\"spec\":{\"allowScheduling\":false}}"),
 	)
 }
@@ -0,0 +1,182 @@
+package servicecontroller
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"text/template"
+
+	comm "github.com/berops/claudie/internal/command"
+	"github.com/berops/claudie/internal/hash"
+	"github.com/berops/claudie/internal/kube"
+	"github.com/berops/claudie/internal/kubectl"
+	"github.com/berops/claudie/internal/tmplutils"
+	"github.com/berops/claudie/services/kuber/templates"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	serviceControllerDeployment = "service-controller.yaml"
+
+	// name and namespace of all of the resources of the controller.
+	serviceControllerName      = "claudie-service-controller"
+	serviceControllerNamespace = "kube-system"
+)
+
+type ClusterView struct {
+	Id         string
+	Name       string
+	Kubeconfig string
+}
+
+// LoadBalancerView is the loadbalancer designated for the services of the cluster.
+type LoadBalancerView struct {
+	Name string
+	// Token authenticating the controller with the manager.
+	Token string
+}
+
+// ServiceController deploys the controller handling the services of type LoadBalancer for given k8s cluster.
+type ServiceController struct {
+	// Project name where k8s cluster is defined.
+	projectName string
+
+	cluster ClusterView
+
+	loadBalancer LoadBalancerView
+
+	// Image of the controller.
+	image string
+
+	// Address of the manager, reachable from within the cluster.
+	managerURL string
+
+	// Output directory.
+	directory string
+}
+
+type serviceControllerDeploymentData struct {
+	ClusterName     string
+	ProjectName     string
+	Image           string
+	ManagerHostname string
+	ManagerPort     string
+
+	LoadBalancerName string
+	Token            string
+	TokenChecksum    string
+}
+
+// NewServiceController returns configured ServiceController which can deploy the controller.
+func NewServiceController(
+	projectName string,
+	directory string,
+	image string,
+	managerURL string,
+	view ClusterView,
+	lb LoadBalancerView,
+) *ServiceController {
+	return &ServiceController{
+		projectName:  projectName,
+		directory:    directory,
+		image:        image,
+		managerURL:   managerURL,
+		cluster:      view,
+		loadBalancer: lb,
+	}
+}
+
+func (sc *ServiceController) DeployServiceController() error {
+	// Create files from templates.
+	if err := sc.generateFiles(); err != nil {
+		return err
+	}
+	// Apply generated files.
+	kc := kubectl.Kubectl{
+		Kubeconfig:        sc.cluster.Kubeconfig,
+		Directory:         sc.directory,
+		MaxKubectlRetries: 3,
+	}
+	kc.Stdout = comm.GetStdOut(sc.cluster.Id)
+	kc.Stderr = comm.GetStdErr(sc.cluster.Id)
+
+	// deploys to namespace defined in the template (kube-system)
+	if err := kc.KubectlApply(serviceControllerDeployment, ""); err != nil {
+		return fmt.Errorf("error while applying service controller for cluster %s : %w", sc.cluster.Name, err)
+	}
+	return nil
+}
+
+// generateFiles generates all manifests required for deploying the service controller.
+func (sc *ServiceController) generateFiles() error {
+	host, port, err := net.SplitHostPort(sc.managerURL)
+	if err != nil {
+		return fmt.Errorf("invalid manager address %q : %w", sc.managerURL, err)
+	}
+
+	tpl := tmplutils.Templates{Directory: sc.directory}
+	var scTemplate *template.Template
+
+	if scTemplate, err = tmplutils.LoadTemplate(templates.ServiceControllerTemplate); err != nil {
+		return fmt.Errorf("error loading service controller template : %w", err)
+	}
+
+	data := &serviceControllerDeploymentData{
+		ClusterName:      sc.cluster.Name,
+		ProjectName:      sc.projectName,
+		Image:            sc.image,
+		ManagerHostname:  host,
+		ManagerPort:      port,
+		LoadBalancerName: sc.loadBalancer.Name,
+		Token:            sc.loadBalancer.Token,
+		TokenChecksum:    hex.EncodeToString(hash.Digest(sc.loadBalancer.Token)),
+	}
+
+	if err := tpl.Generate(scTemplate, serviceControllerDeployment, data); err != nil {
+		return fmt.Errorf("error generating service controller deployment : %w", err)
+	}
+
+	return nil
+}
+
+// DeleteServiceController removes all of the resources of the controller from the cluster,
+// if present, once no loadbalancer is designated for the services of the cluster.
+func DeleteServiceController(ctx context.Context, client *kube.Client) error {
+	var (
+		k8s  = client.Kubernetes()
+		opts = metav1.DeleteOptions{}
+		errs []error
+	)
+
+	deletions := []struct {
+		kind   string
+		delete func() error
+	}{
+		{"deployment", func() error {
+			return k8s.AppsV1().Deployments(serviceControllerNamespace).Delete(ctx, serviceControllerName, opts)
+		}},
+		{"secret", func() error {
+			return k8s.CoreV1().Secrets(serviceControllerNamespace).Delete(ctx, serviceControllerName, opts)
+		}},
+		{"service account", func() error {
+			return k8s.CoreV1().ServiceAccounts(serviceControllerNamespace).Delete(ctx, serviceControllerName, opts)
+		}},
+		{"cluster role binding", func() error {
+			return k8s.RbacV1().ClusterRoleBindings().Delete(ctx, serviceControllerName, opts)
+		}},
+		{"cluster role", func() error {
+			return k8s.RbacV1().ClusterRoles().Delete(ctx, serviceControllerName, opts)
+		}},
+	}
+
+	for _, d := range deletions {
+		if err := d.delete(); err != nil && !kube.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete %s of the service controller: %w", d.kind, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
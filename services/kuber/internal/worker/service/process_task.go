@@ -39,6 +39,10 @@ type (
 
 		// Diagnostics during the processing of the received [Work.Task]
 		Diagnostics *Diagnostics
+
+		// Warnings are the non-fatal diagnostics during the processing
+		// of the received [Work.Task], which do not fail the task.
+		Warnings *Diagnostics
 	}
 
 	Diagnostics []error
@@ -64,6 +68,9 @@ func ProcessTask(ctx context.Context, work Work) *spec.TaskResult {
 		// diags holds any errors throughout all of the passes.
 		diags Diagnostics
 
+		// warnings holds any non-fatal errors throughout all of the passes.
+		warnings Diagnostics
+
 		// state the current state of the progress in [Work].
 		// Start with None and update result as passes make changes.
 		result = spec.TaskResult{Result: &spec.TaskResult_None_{None: new(spec.TaskResult_None)}}
@@ -86,6 +93,7 @@ passes:
 			Task:        work.Task,
 			Result:      &result,
 			Diagnostics: &diags,
+			Warnings:    &warnings,
 		}
 		last := len(diags)
 
@@ -114,6 +122,8 @@ passes:
 			StoreScrapeConfig(logger, tracker)
 		case spec.StageKuber_DEPLOY_KUBELET_CSR_APPROVER:
 			DeployKubeletCSRApprover(logger, work.InputManifestName, tracker)
+		case spec.StageKuber_DEPLOY_SERVICE_CONTROLLER:
+			DeployServiceController(logger, work.InputManifestName, tracker)
 		default:
 			logger.Warn().Msg("Stage not recognized, skipping")
 			continue
@@ -147,6 +157,10 @@ passes:
 		}
 	}
 
+	for _, w := range warnings {
+		result.Warnings = append(result.Warnings, w.Error())
+	}
+
 	if len(diags) > 0 {
 		result.Error = &spec.TaskResult_Error{
 			Kind:        spec.TaskResult_Error_PARTIAL,
@@ -40,6 +40,14 @@ var (
 
 	// Ack wait time in minutes for processing incoming NATS messages.
 	AckWait = time.Duration(envs.GetOrDefaultInt("KUBER_ACK_WAIT_TIME", 8)) * time.Minute
+
+	// ServiceControllerImage is the image of the controller for services of type LoadBalancer
+	// deployed into the clusters, which is built together with kuber.
+	ServiceControllerImage = envs.GetOrDefault("SERVICE_CONTROLLER_IMAGE", "ghcr.io/berops/claudie/kuber")
+
+	// ServiceControllerManagerURL is the address of the manager as reachable from within
+	// the clusters, used by the deployed service controllers.
+	ServiceControllerManagerURL = envs.GetOrDefault("SERVICE_CONTROLLER_MANAGER_URL", "")
 )
 
 type grpcServer struct {
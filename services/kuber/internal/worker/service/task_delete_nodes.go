@@ -1,11 +1,11 @@
 package service
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/berops/claudie/internal/kubectl"
+	"github.com/berops/claudie/internal/kube"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/berops/claudie/services/kuber/internal/worker/service/internal/nodes"
@@ -36,40 +36,32 @@ func DeleteNodes(logger zerolog.Logger, tracker Tracker) {
 	}
 }
 
-func isControlNode(name string, kubeconfig string) (bool, error) {
-	kc := kubectl.Kubectl{
-		Kubeconfig:        kubeconfig,
-		MaxKubectlRetries: kubectl.NoRetries,
-	}
-
-	type nodeOutput struct {
-		Metadata struct {
-			Name   string `json:"name"`
-			Labels map[string]any
-		} `json:"metadata"`
-	}
-
-	out, err := kc.KubectlGet("nodes", name, "-ojson")
+func isControlNode(k8s *spec.K8Scluster, name string) (bool, error) {
+	client, err := kube.ForCluster(k8s.ClusterInfo.Id(), k8s.Kubeconfig, kube.Options{})
 	if err != nil {
-		return false, fmt.Errorf("failed to output nodes: %w", err)
-	}
-
-	var description nodeOutput
-	if err := json.Unmarshal(out, &description); err != nil {
-		return false, fmt.Errorf("failed to unmarshal node output: %w", err)
+		return false, err
 	}
 
-	_, ok := description.Metadata.Labels["node-role.kubernetes.io/control-plane"]
-	return ok, nil
+	return client.NodeHasLabel(context.Background(), name, "node-role.kubernetes.io/control-plane")
 }
 
-func deleteNodes(logger zerolog.Logger, master, worker []*spec.Node, k8s *spec.K8Scluster) error {
-	deleter, err := nodes.NewDeleter(master, worker, k8s)
+func deleteNodes(
+	logger zerolog.Logger,
+	master, worker []*spec.Node,
+	policy *spec.DrainPolicy,
+	k8s *spec.K8Scluster,
+	tracker Tracker,
+) error {
+	deleter, err := nodes.NewDeleter(master, worker, policy, k8s)
 	if err != nil {
 		return err
 	}
 
-	return deleter.DeleteNodes(logger)
+	err = deleter.DeleteNodes(logger)
+	for _, w := range deleter.Warnings() {
+		tracker.Warnings.Push(w)
+	}
+	return err
 }
 
 func deleteFromState(
@@ -96,7 +88,7 @@ func deleteFromState(
 			fullname := a.KDeleteNodes.Nodes[0]
 			strippedName := strings.TrimPrefix(fullname, fmt.Sprintf("%s-", k8s.ClusterInfo.Id()))
 
-			isControl, err := isControlNode(strippedName, k8s.Kubeconfig)
+			isControl, err := isControlNode(k8s, strippedName)
 			if err != nil {
 				logger.
 					Warn().
@@ -115,7 +107,7 @@ func deleteFromState(
 				worker = append(worker, &spec.Node{Name: fullname})
 			}
 
-			if err := deleteNodes(logger, master, worker, k8s); err != nil {
+			if err := deleteNodes(logger, master, worker, nil, k8s, tracker); err != nil {
 				logger.Err(err).Msg("Failed to delete nodes")
 				tracker.Diagnostics.Push(err)
 				return
@@ -142,7 +134,10 @@ func deleteNodesFromCluster(
 	k8s *spec.K8Scluster,
 	tracker Tracker,
 ) {
-	var master, worker []*spec.Node
+	var (
+		master, worker []*spec.Node
+		policy         *spec.DrainPolicy
+	)
 
 	switch typ := a.DeletedK8SNodes.Kind.(type) {
 	case *spec.Update_DeletedK8SNodes_Partial_:
@@ -162,7 +157,7 @@ func deleteNodesFromCluster(
 			fullname := node.Name
 			strippedName := strings.TrimPrefix(fullname, fmt.Sprintf("%s-", k8s.ClusterInfo.Id()))
 
-			isControl, err := isControlNode(strippedName, k8s.Kubeconfig)
+			isControl, err := isControlNode(k8s, strippedName)
 			if err != nil {
 				logger.
 					Warn().
@@ -179,7 +174,7 @@ func deleteNodesFromCluster(
 				worker = append(worker, node)
 			}
 
-			if err := deleteNodes(logger, master, worker, k8s); err != nil {
+			if err := deleteNodes(logger, master, worker, nil, k8s, tracker); err != nil {
 				logger.Err(err).Msg("Failed to delete nodes")
 				tracker.Diagnostics.Push(err)
 				return
@@ -190,12 +185,14 @@ func deleteNodesFromCluster(
 			return
 		}
 
+		policy = np.DrainPolicy
 		if np.IsControl {
 			master = append(master, typ.Partial.Nodes...)
 		} else {
 			worker = append(worker, typ.Partial.Nodes...)
 		}
 	case *spec.Update_DeletedK8SNodes_Whole:
+		policy = typ.Whole.Nodepool.DrainPolicy
 		if typ.Whole.Nodepool.IsControl {
 			master = append(master, typ.Whole.Nodepool.Nodes...)
 		} else {
@@ -212,7 +209,7 @@ func deleteNodesFromCluster(
 		Info().
 		Msgf("Deleting %v control nodes %v worker nodes", len(master), len(worker))
 
-	if err := deleteNodes(logger, master, worker, k8s); err != nil {
+	if err := deleteNodes(logger, master, worker, policy, k8s, tracker); err != nil {
 		logger.Err(err).Msg("Failed to delete nodes")
 		tracker.Diagnostics.Push(err)
 		return
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/berops/claudie/internal/fileutils"
+	"github.com/berops/claudie/internal/hash"
+	"github.com/berops/claudie/internal/kube"
+	"github.com/berops/claudie/proto/pb/spec"
+	sc "github.com/berops/claudie/services/kuber/internal/worker/service/internal/service-controller"
+	"github.com/rs/zerolog"
+)
+
+func DeployServiceController(
+	logger zerolog.Logger,
+	projectName string,
+	tracker Tracker,
+) {
+	var (
+		k8s *spec.K8Scluster
+		lbs []*spec.LBcluster
+	)
+
+	switch do := tracker.Task.Do.(type) {
+	case *spec.Task_Create:
+		k8s = do.Create.K8S
+		lbs = do.Create.LoadBalancers
+	case *spec.Task_Update:
+		k8s = do.Update.State.K8S
+		lbs = do.Update.State.LoadBalancers
+	default:
+		logger.
+			Warn().
+			Msgf("Received task %T while wanting to deploy service controller, assuming it was mischeduled, ignoring", tracker.Task.Do)
+		return
+	}
+
+	idx := slices.IndexFunc(lbs, func(lb *spec.LBcluster) bool {
+		return lb.TargetedK8S == k8s.ClusterInfo.Name && lb.ServiceLoadBalancer != nil
+	})
+	if idx < 0 {
+		removeServiceController(logger, k8s, tracker)
+		return
+	}
+	lb := lbs[idx]
+
+	if ServiceControllerManagerURL == "" {
+		logger.Debug().Msg("SERVICE_CONTROLLER_MANAGER_URL is not set, skipping deployment of service controller")
+		return
+	}
+
+	logger.Info().Msg("Deploying service controller")
+
+	var (
+		tempClusterID = fmt.Sprintf("%s-%s", k8s.ClusterInfo.Id(), hash.Create(hash.Length))
+		clusterDir    = filepath.Join(OutputDir, tempClusterID)
+	)
+
+	if err := fileutils.CreateDirectory(clusterDir); err != nil {
+		err := fmt.Errorf("error while creating directory %s when deploying service controller : %w", clusterDir, err)
+		logger.Err(err).Msg("Failed to create directory for templates")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
+	defer func() {
+		if err := os.RemoveAll(clusterDir); err != nil {
+			logger.Err(err).Msg("Failed to remove directory where templates were generated")
+			return
+		}
+	}()
+
+	view := sc.ClusterView{
+		Id:         k8s.ClusterInfo.Id(),
+		Name:       k8s.ClusterInfo.Name,
+		Kubeconfig: k8s.Kubeconfig,
+	}
+
+	lbView := sc.LoadBalancerView{
+		Name:  lb.ClusterInfo.Name,
+		Token: lb.ServiceLoadBalancer.Token,
+	}
+
+	controller := sc.NewServiceController(projectName, clusterDir, ServiceControllerImage, ServiceControllerManagerURL, view, lbView)
+	if err := controller.DeployServiceController(); err != nil {
+		err := fmt.Errorf("error while deploying service controller for %s : %w", k8s.ClusterInfo.Id(), err)
+		logger.Err(err).Msg("Failed to deploy service controller")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
+	logger.Info().Msg("Finished deploying service controller")
+}
+
+// removeServiceController removes the service controller, if previously deployed,
+// from the cluster which no longer has a loadbalancer designated for its services.
+func removeServiceController(logger zerolog.Logger, k8s *spec.K8Scluster, tracker Tracker) {
+	logger.Info().Msg("Removing service controller, if present")
+
+	client, err := kube.ForCluster(k8s.ClusterInfo.Id(), k8s.Kubeconfig, kube.Options{})
+	if err != nil {
+		logger.Err(err).Msg("Failed to create kubernetes client")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
+	if err := sc.DeleteServiceController(context.Background(), client); err != nil {
+		err := fmt.Errorf("error while removing service controller for %s : %w", k8s.ClusterInfo.Id(), err)
+		logger.Err(err).Msg("Failed to remove service controller")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
+	logger.Info().Msg("Finished removing service controller")
+}
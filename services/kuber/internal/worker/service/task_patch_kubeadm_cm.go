@@ -53,17 +53,16 @@ func PatchKubeadmCM(logger zerolog.Logger, tracker Tracker) {
 		}
 	}()
 
-	var lbApiEndpoint string
+	var lbApiEndpoints []string
 	if ep := clusters.FindAssignedLbApiEndpoint(action.Update.State.LoadBalancers); ep != nil {
-		lbApiEndpoint = ep.Dns.Endpoint
+		lbApiEndpoints = ep.Endpoints()
 	}
 
 	// Kubeadm uses this config map when joining new nodes, we need to update it with correct certSANs
 	// after api endpoint change.
 	// https://github.com/berops/claudie/issues/1597
-	certSANs := []string{lbApiEndpoint}
-	if lbApiEndpoint == "" {
-		certSANs = certSANs[:len(certSANs)-1]
+	certSANs := lbApiEndpoints
+	if len(certSANs) == 0 {
 		for n := range nodepools.Control(action.Update.State.K8S.ClusterInfo.NodePools) {
 			for _, n := range n.Nodes {
 				certSANs = append(certSANs, n.Public)
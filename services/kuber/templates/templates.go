@@ -20,4 +20,7 @@ var (
 
 	//go:embed storage-class.goyaml
 	StorageClassTemplate string
+
+	//go:embed service-controller.goyaml
+	ServiceControllerTemplate string
 )
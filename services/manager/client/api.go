@@ -23,4 +23,5 @@ type ClientAPI interface {
 
 	ManifestAPI
 	CrudAPI
+	StateAPI
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/berops/claudie/internal/envs"
 	"github.com/berops/claudie/internal/grpcutils"
@@ -14,8 +15,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// importStateChunkSize is the size of the chunks in which the archive is streamed.
+const importStateChunkSize = 1 << 20
+
 var _ ClientAPI = (*Client)(nil)
 
 type Client struct {
@@ -24,8 +29,12 @@ type Client struct {
 	logger *zerolog.Logger
 }
 
-func New(logger *zerolog.Logger) (*Client, error) {
-	conn, err := grpcutils.GrpcDialWithRetryAndBackoff("manager", envs.ManagerURL)
+func New(logger *zerolog.Logger) (*Client, error) { return NewWithAddress(logger, envs.ManagerURL) }
+
+// NewWithAddress returns a client for the manager listening on the address
+// instead of the one from the MANAGER_HOSTNAME and MANAGER_PORT envs.
+func NewWithAddress(logger *zerolog.Logger, address string) (*Client, error) {
+	conn, err := grpcutils.GrpcDialWithRetryAndBackoff("manager", address)
 	if err != nil {
 		return nil, err
 	}
@@ -165,6 +174,157 @@ func (t *Client) UpsertManifest(ctx context.Context, request *UpsertManifestRequ
 	return err
 }
 
+func (t *Client) PlanManifest(ctx context.Context, request *PlanManifestRequest) (*PlanManifestResponse, error) {
+	req := &pb.PlanManifestRequest{Name: request.Name}
+	if request.Manifest != nil {
+		req.Manifest = &spec.Manifest{Raw: request.Manifest.Raw}
+	}
+
+	resp, err := t.client.PlanManifest(ctx, req)
+	if err == nil {
+		return &PlanManifestResponse{Clusters: resp.Clusters}, nil
+	}
+	if e, ok := status.FromError(err); ok && e.Code() == codes.NotFound {
+		t.logger.Debug().Msgf("PlanManifest(): no config with name %q found", request.Name)
+		return nil, fmt.Errorf("config with name %q: %w", request.Name, ErrNotFound)
+	}
+	t.logger.Debug().Msgf("Received error %v while calling PlanManifest", err)
+	return nil, err
+}
+
+func (t *Client) ListTaskHistory(ctx context.Context, request *ListTaskHistoryRequest) (*ListTaskHistoryResponse, error) {
+	req := &pb.ListTaskHistoryRequest{
+		Config:    request.Config,
+		Cluster:   request.Cluster,
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+	}
+	if request.Since != nil {
+		req.Since = timestamppb.New(*request.Since)
+	}
+
+	resp, err := t.client.ListTaskHistory(ctx, req)
+	if err == nil {
+		return &ListTaskHistoryResponse{Entries: resp.Entries, NextPageToken: resp.NextPageToken}, nil
+	}
+	t.logger.Debug().Msgf("Received error %v while calling ListTaskHistory", err)
+	return nil, err
+}
+
+func (t *Client) RollbackCluster(ctx context.Context, request *RollbackClusterRequest) (*RollbackClusterResponse, error) {
+	resp, err := t.client.RollbackCluster(ctx, &pb.RollbackClusterRequest{
+		Config:  request.Config,
+		Cluster: request.Cluster,
+	})
+	if err == nil {
+		return &RollbackClusterResponse{TaskId: resp.TaskId, Description: resp.Description}, nil
+	}
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			err = errors.Join(err, fmt.Errorf("config %q cluster %q: %w", request.Config, request.Cluster, ErrNotFound))
+		case codes.Aborted:
+			err = errors.Join(err, fmt.Errorf("%w", ErrVersionMismatch))
+		}
+	}
+
+	t.logger.Debug().Msgf("Received error %v while calling RollbackCluster", err)
+	return nil, err
+}
+
+func (t *Client) SetClusterPaused(ctx context.Context, request *SetClusterPausedRequest) error {
+	_, err := t.client.SetClusterPaused(ctx, &pb.SetClusterPausedRequest{
+		Config:  request.Config,
+		Cluster: request.Cluster,
+		Paused:  request.Paused,
+	})
+	if err == nil {
+		return nil
+	}
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			err = errors.Join(err, fmt.Errorf("config %q cluster %q: %w", request.Config, request.Cluster, ErrNotFound))
+		case codes.Aborted:
+			err = errors.Join(err, fmt.Errorf("%w", ErrVersionMismatch))
+		}
+	}
+
+	t.logger.Debug().Msgf("Received error %v while calling SetClusterPaused", err)
+	return err
+}
+
+func (t *Client) ExportState(ctx context.Context, request *ExportStateRequest, w io.Writer) error {
+	stream, err := t.client.ExportState(ctx, &pb.ExportStateRequest{Passphrase: request.Passphrase})
+	if err != nil {
+		t.logger.Debug().Msgf("Received error %v while calling ExportState", err)
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			t.logger.Debug().Msgf("Received error %v while receiving ExportState", err)
+			return err
+		}
+		if _, err := w.Write(resp.Chunk); err != nil {
+			return fmt.Errorf("failed to write exported state: %w", err)
+		}
+	}
+}
+
+func (t *Client) ImportState(ctx context.Context, request *ImportStateRequest, r io.Reader) (*ImportStateResponse, error) {
+	stream, err := t.client.ImportState(ctx)
+	if err != nil {
+		t.logger.Debug().Msgf("Received error %v while calling ImportState", err)
+		return nil, err
+	}
+
+	// The passphrase is always sent within the first message,
+	// even if the archive is empty.
+	var (
+		buf  = make([]byte, importStateChunkSize)
+		sent bool
+	)
+	for {
+		n, rerr := io.ReadFull(r, buf)
+		if n > 0 || !sent {
+			req := &pb.ImportStateRequest{Chunk: buf[:n]}
+			if !sent {
+				req.Passphrase = request.Passphrase
+			}
+			if err := stream.Send(req); err != nil {
+				// The actual error is returned by CloseAndRecv.
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				t.logger.Debug().Msgf("Received error %v while sending ImportState", err)
+				return nil, err
+			}
+			sent = true
+		}
+		if errors.Is(rerr, io.EOF) || errors.Is(rerr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if rerr != nil {
+			return nil, errors.Join(fmt.Errorf("failed to read state to import: %w", rerr), stream.CloseSend())
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.logger.Debug().Msgf("Received error %v while calling ImportState", err)
+		return nil, err
+	}
+
+	return &ImportStateResponse{Configs: resp.Configs, StateFiles: int(resp.StateFiles)}, nil
+}
+
 func (t *Client) ListConfigs(ctx context.Context, _ *ListConfigRequest) (*ListConfigResponse, error) {
 	resp, err := t.client.ListConfigs(ctx, new(pb.ListConfigsRequest))
 	if err == nil {
@@ -2,6 +2,7 @@ package managerclient
 
 import (
 	"context"
+	"time"
 
 	"github.com/berops/claudie/proto/pb/spec"
 )
@@ -25,6 +26,31 @@ type CrudAPI interface {
 
 	// NodePoolUpdateTargetSize updates the target size of the nodepool.
 	NodePoolUpdateTargetSize(ctx context.Context, request *NodePoolUpdateTargetSizeRequest) (*NodePoolUpdateTargetSizeResponse, error)
+
+	// ListTaskHistory lists a single page of the recorded task history of the config, ordered
+	// from the oldest to the newest entry. To list the next page, pass the returned
+	// [ListTaskHistoryResponse.NextPageToken] in the next request.
+	ListTaskHistory(ctx context.Context, request *ListTaskHistoryRequest) (*ListTaskHistoryResponse, error)
+
+	// RollbackCluster schedules a task that reverts the partially applied changes of the
+	// failed update task of the cluster. Each call rolls back a single step, once there is
+	// nothing left to be rolled back the failed task is discarded and an empty
+	// [RollbackClusterResponse.TaskId] is returned. The cluster is kept at its rolled back
+	// state until the manifest changes.
+	//
+	// If the requested config/cluster tuple is not found the [ErrNotFound] error is returned.
+	//
+	// If the change couldn't be handled by the Manager the [ErrVersionMismatch] error is returned
+	// in which case the caller should either retry the operation or abort.
+	RollbackCluster(ctx context.Context, request *RollbackClusterRequest) (*RollbackClusterResponse, error)
+
+	// SetClusterPaused pauses or resumes the reconciliation of the cluster.
+	//
+	// If the requested config/cluster tuple is not found the [ErrNotFound] error is returned.
+	//
+	// If the change couldn't be handled by the Manager the [ErrVersionMismatch] error is returned
+	// in which case the caller should either retry the operation or abort.
+	SetClusterPaused(ctx context.Context, request *SetClusterPausedRequest) error
 }
 
 type GetConfigRequest struct{ Name string }
@@ -111,3 +137,43 @@ type NodePoolUpdateTargetSizeResponse struct {
 	// The new update targetSize
 	TargetSize int32
 }
+
+type ListTaskHistoryRequest struct {
+	Config string
+
+	// If set only the history of the cluster is listed.
+	Cluster *string
+
+	// If set only the entries recorded at or after the time are listed.
+	Since *time.Time
+
+	// Maximum number of entries in the page, 0 for the default page size.
+	PageSize int32
+
+	// Token of the page to list, empty for the first page.
+	PageToken string
+}
+
+type ListTaskHistoryResponse struct {
+	Entries []*spec.TaskHistoryEntry
+
+	// Token of the next page, empty if there are no more entries.
+	NextPageToken string
+}
+
+type RollbackClusterRequest struct {
+	Config  string
+	Cluster string
+}
+
+type RollbackClusterResponse struct {
+	// Id of the scheduled task, empty if there was nothing to be rolled back.
+	TaskId      string
+	Description string
+}
+
+type SetClusterPausedRequest struct {
+	Config  string
+	Cluster string
+	Paused  bool
+}
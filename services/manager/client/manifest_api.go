@@ -1,6 +1,10 @@
 package managerclient
 
-import "context"
+import (
+	"context"
+
+	"github.com/berops/claudie/proto/pb"
+)
 
 type ManifestAPI interface {
 	// UpsertManifest will update the [store.Manifest] and [store.KubernetesContext] of an existing
@@ -16,6 +20,12 @@ type ManifestAPI interface {
 	// along with other errors. If the requested config for deletion is not found the ErrNotFound error
 	// is returned.
 	MarkForDeletion(ctx context.Context, request *MarkForDeletionRequest) error
+
+	// PlanManifest will compute the tasks that would be scheduled for the specified Config
+	// without persisting or scheduling any of them. If [PlanManifestRequest.Manifest] is set
+	// the plan is computed for the passed in manifest instead of the stored one. If the
+	// requested config is not found and no manifest was passed the ErrNotFound error is returned.
+	PlanManifest(ctx context.Context, request *PlanManifestRequest) (*PlanManifestResponse, error)
 }
 
 type UpsertManifestRequest struct {
@@ -28,3 +38,13 @@ type Manifest struct{ Raw string }
 type KubernetesContext struct{ Name, Namespace string }
 
 type MarkForDeletionRequest struct{ Name string }
+
+type PlanManifestRequest struct {
+	Name     string
+	Manifest *Manifest
+}
+
+type PlanManifestResponse struct {
+	// Planned tasks for each of the clusters of the config.
+	Clusters []*pb.PlanManifestResponse_ClusterPlan
+}
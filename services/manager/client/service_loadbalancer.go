@@ -0,0 +1,137 @@
+package managerclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/berops/claudie/internal/grpcutils"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/rs/zerolog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnauthenticated is returned when the token passed to the [ServiceLoadBalancerClient]
+// does not match the token of the loadbalancer designated for the services of the cluster.
+var ErrUnauthenticated = errors.New("unauthenticated")
+
+// ServiceLoadBalancerAPI is the API of the manager reachable from the workload clusters,
+// separate from the [ClientAPI] which must not be exposed outside of the claudie namespace.
+type ServiceLoadBalancerAPI interface {
+	// UpdateServiceLoadBalancer allocates the ports for the Kubernetes Service of type LoadBalancer
+	// on the loadbalancer designated for the services of the cluster. If no ports are passed the
+	// ports allocated for the service are released.
+	//
+	// If the requested config/cluster tuple is not found the [ErrNotFound] error is returned.
+	//
+	// If the token of the client is not valid for the designated loadbalancer
+	// the [ErrUnauthenticated] error is returned.
+	//
+	// If the change couldn't be handled by the Manager the [ErrVersionMismatch] error is returned
+	// in which case the caller should either retry the operation or abort.
+	UpdateServiceLoadBalancer(ctx context.Context, request *UpdateServiceLoadBalancerRequest) (*UpdateServiceLoadBalancerResponse, error)
+}
+
+var _ ServiceLoadBalancerAPI = (*ServiceLoadBalancerClient)(nil)
+
+// ServiceLoadBalancerClient is the client of the ServiceLoadBalancerService
+// of the manager, authenticating each request with the token of the
+// loadbalancer designated for the services of the cluster.
+type ServiceLoadBalancerClient struct {
+	conn   *grpc.ClientConn
+	client pb.ServiceLoadBalancerServiceClient
+	token  string
+	logger *zerolog.Logger
+}
+
+// NewServiceLoadBalancerClient returns a client for the ServiceLoadBalancerService
+// of the manager listening on the address.
+func NewServiceLoadBalancerClient(logger *zerolog.Logger, address, token string) (*ServiceLoadBalancerClient, error) {
+	conn, err := grpcutils.GrpcDialWithRetryAndBackoff("manager", address)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceLoadBalancerClient{
+		conn:   conn,
+		client: pb.NewServiceLoadBalancerServiceClient(conn),
+		token:  token,
+		logger: logger,
+	}, nil
+}
+
+func (t *ServiceLoadBalancerClient) Close() error { return t.conn.Close() }
+
+func (t *ServiceLoadBalancerClient) UpdateServiceLoadBalancer(ctx context.Context, request *UpdateServiceLoadBalancerRequest) (*UpdateServiceLoadBalancerResponse, error) {
+	req := pb.UpdateServiceLoadBalancerRequest{
+		Config:    request.Config,
+		Cluster:   request.Cluster,
+		Namespace: request.Namespace,
+		Name:      request.Name,
+	}
+	for _, p := range request.Ports {
+		req.Ports = append(req.Ports, &pb.UpdateServiceLoadBalancerRequest_Port{
+			Name:     p.Name,
+			Protocol: p.Protocol,
+			Port:     p.Port,
+			NodePort: p.NodePort,
+		})
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+t.token)
+	resp, err := t.client.UpdateServiceLoadBalancer(ctx, &req)
+	if err == nil {
+		out := &UpdateServiceLoadBalancerResponse{
+			Ports:    make(map[string]int32, len(resp.Ports)),
+			Hostname: resp.Hostname,
+			IPs:      resp.Ips,
+		}
+		for _, p := range resp.Ports {
+			out.Ports[p.Name] = p.Port
+		}
+		return out, nil
+	}
+
+	if e, ok := status.FromError(err); ok {
+		switch e.Code() {
+		case codes.NotFound:
+			err = errors.Join(err, fmt.Errorf("config %q cluster %q: %w", request.Config, request.Cluster, ErrNotFound))
+		case codes.Aborted:
+			err = errors.Join(err, fmt.Errorf("%w", ErrVersionMismatch))
+		case codes.Unauthenticated:
+			err = errors.Join(err, fmt.Errorf("%w", ErrUnauthenticated))
+		}
+	}
+
+	t.logger.Debug().Msgf("Received error %v while calling UpdateServiceLoadBalancer", err)
+	return nil, err
+}
+
+type ServicePort struct {
+	Name     string
+	Protocol string
+	Port     int32
+	NodePort int32
+}
+
+type UpdateServiceLoadBalancerRequest struct {
+	Config    string
+	Cluster   string
+	Namespace string
+	Name      string
+	Ports     []ServicePort
+}
+
+type UpdateServiceLoadBalancerResponse struct {
+	// Ports allocated on the loadbalancer by the name of the port of the service.
+	Ports map[string]int32
+
+	// Hostname of the loadbalancer, empty if not yet assigned.
+	Hostname string
+
+	// Public IPs of the loadbalancer nodes.
+	IPs []string
+}
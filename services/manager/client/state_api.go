@@ -0,0 +1,26 @@
+package managerclient
+
+import (
+	"context"
+	"io"
+)
+
+type StateAPI interface {
+	// ExportState writes the archive with all of the configs and the state files of their
+	// infrastructure into w. If [ExportStateRequest.Passphrase] is set the archive is encrypted.
+	ExportState(ctx context.Context, request *ExportStateRequest, w io.Writer) error
+
+	// ImportState restores the configs and state files from the archive read from r, as
+	// produced by ExportState. If any of the configs in the archive already exists, nothing
+	// is restored and an error is returned.
+	ImportState(ctx context.Context, request *ImportStateRequest, r io.Reader) (*ImportStateResponse, error)
+}
+
+type ExportStateRequest struct{ Passphrase string }
+
+type ImportStateRequest struct{ Passphrase string }
+
+type ImportStateResponse struct {
+	Configs    []string
+	StateFiles int
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	managerclient "github.com/berops/claudie/services/manager/client"
+)
+
+func listConfigs(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, _ []string) error {
+	cfgs, err := configs(ctx, c, "")
+	if err != nil {
+		return err
+	}
+	return write(w, format, newConfigViews(cfgs))
+}
+
+func listClusters(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, args []string) error {
+	cfgs, err := configs(ctx, c, arg(args, 0))
+	if err != nil {
+		return err
+	}
+	return write(w, format, newClusterViews(cfgs))
+}
+
+func listTasks(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, args []string) error {
+	cfgs, err := configs(ctx, c, arg(args, 0))
+	if err != nil {
+		return err
+	}
+	if cluster := arg(args, 1); cluster != "" {
+		if _, err := clusterState(cfgs[0], cluster); err != nil {
+			return err
+		}
+	}
+	return write(w, format, newTaskViews(cfgs, arg(args, 1)))
+}
+
+func listNodes(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, args []string) error {
+	cfgs, err := configs(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+	state, err := clusterState(cfgs[0], args[1])
+	if err != nil {
+		return err
+	}
+	return write(w, format, newNodeViews(state))
+}
+
+// printKubeconfig prints the kubeconfig as is, regardless of the output format.
+func printKubeconfig(ctx context.Context, c managerclient.CrudAPI, w io.Writer, _ string, args []string) error {
+	cfgs, err := configs(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+	state, err := clusterState(cfgs[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	kubeconfig := state.GetCurrent().GetK8S().GetKubeconfig()
+	if kubeconfig == "" {
+		return fmt.Errorf("cluster %q within config %q has no kubeconfig yet", args[1], args[0])
+	}
+
+	_, err = io.WriteString(w, kubeconfig)
+	return err
+}
+
+func listHistory(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, args []string) error {
+	cfgs, err := configs(ctx, c, args[0])
+	if err != nil {
+		return err
+	}
+	if cluster := arg(args, 1); cluster != "" {
+		if _, err := clusterState(cfgs[0], cluster); err != nil {
+			return err
+		}
+	}
+	return write(w, format, newHistoryViews(cfgs, arg(args, 1)))
+}
+
+// configs returns the config with the name, or all configs if the name is empty.
+func configs(ctx context.Context, c managerclient.CrudAPI, name string) ([]*spec.Config, error) {
+	if name == "" {
+		resp, err := c.ListConfigs(ctx, &managerclient.ListConfigRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list configs: %w", err)
+		}
+		return resp.Config, nil
+	}
+
+	resp, err := c.GetConfig(ctx, &managerclient.GetConfigRequest{Name: name})
+	if err != nil {
+		if errors.Is(err, managerclient.ErrNotFound) {
+			return nil, fmt.Errorf("config %q not found", name)
+		}
+		return nil, fmt.Errorf("failed to get config %q: %w", name, err)
+	}
+	return []*spec.Config{resp.Config}, nil
+}
+
+func clusterState(cfg *spec.Config, cluster string) (*spec.ClusterState, error) {
+	state, ok := cfg.GetClusters()[cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q not found within config %q", cluster, cfg.GetName())
+	}
+	return state, nil
+}
+
+func arg(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	managerclient "github.com/berops/claudie/services/manager/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeManager serves the configs for the read-only commands.
+type fakeManager struct {
+	managerclient.CrudAPI
+	configs []*spec.Config
+}
+
+func (f *fakeManager) GetConfig(_ context.Context, req *managerclient.GetConfigRequest) (*managerclient.GetConfigResponse, error) {
+	for _, c := range f.configs {
+		if c.Name == req.Name {
+			return &managerclient.GetConfigResponse{Config: c}, nil
+		}
+	}
+	return nil, fmt.Errorf("config with name %q: %w", req.Name, managerclient.ErrNotFound)
+}
+
+func (f *fakeManager) ListConfigs(context.Context, *managerclient.ListConfigRequest) (*managerclient.ListConfigResponse, error) {
+	return &managerclient.ListConfigResponse{Config: f.configs}, nil
+}
+
+func testConfig() *spec.Config {
+	ts := timestamppb.New(time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC))
+
+	k8s := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "prod",
+			Hash: "abc",
+			NodePools: []*spec.NodePool{
+				{
+					Name: "control",
+					Type: &spec.NodePool_DynamicNodePool{DynamicNodePool: &spec.DynamicNodePool{
+						Provider: &spec.Provider{CloudProviderName: "hetzner"},
+					}},
+					Nodes: []*spec.Node{
+						{Name: "control-1", NodeType: spec.NodeType_apiEndpoint, Status: spec.NodeStatus_Joined, Public: "1.1.1.1", Private: "192.168.2.1"},
+					},
+				},
+				{
+					Name: "compute",
+					Type: &spec.NodePool_StaticNodePool{StaticNodePool: &spec.StaticNodePool{}},
+					Nodes: []*spec.Node{
+						{Name: "compute-1", NodeType: spec.NodeType_worker, Status: spec.NodeStatus_MarkedForDeletion, Private: "192.168.2.2"},
+						{Name: "compute-2", NodeType: spec.NodeType_worker, Status: spec.NodeStatus_Preparing},
+					},
+				},
+			},
+		},
+		Kubernetes: "v1.31.0",
+		Kubeconfig: "apiVersion: v1\nkind: Config\n",
+	}
+
+	lb := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "lb",
+			Hash: "def",
+			NodePools: []*spec.NodePool{{
+				Name: "lb-pool",
+				Type: &spec.NodePool_DynamicNodePool{DynamicNodePool: &spec.DynamicNodePool{
+					Provider: &spec.Provider{CloudProviderName: "aws"},
+				}},
+				Nodes: []*spec.Node{{Name: "lb-1", NodeType: spec.NodeType_worker, Status: spec.NodeStatus_Joined, Public: "2.2.2.2"}},
+			}},
+		},
+	}
+
+	return &spec.Config{
+		Name:    "config",
+		Version: 3,
+		K8SCtx:  &spec.KubernetesContext{Name: "config", Namespace: "claudie"},
+		Manifest: &spec.Manifest{
+			State:          spec.Manifest_Scheduled,
+			StateTimestamp: ts,
+		},
+		Clusters: map[string]*spec.ClusterState{
+			"prod": {
+				Current: &spec.Clusters{K8S: k8s, LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{lb}}},
+				State: &spec.Workflow{
+					Status: spec.Workflow_IN_PROGRESS,
+					Previous: []*spec.FinishedWorkflow{
+						{Status: spec.Workflow_DONE, TaskDescription: "create", Stage: "Kuber", Timestamp: ts},
+					},
+				},
+				InFlight: &spec.TaskEvent{
+					Id:           "task-1",
+					Event:        spec.Event_UPDATE,
+					Timestamp:    ts,
+					Description:  "updating nodepools",
+					CurrentStage: 1,
+					Pipeline: []*spec.Stage{
+						{StageKind: &spec.Stage_Terraformer{Terraformer: &spec.StageTerraformer{Description: &spec.StageDescription{About: "infrastructure"}}}},
+						{StageKind: &spec.Stage_Ansibler{Ansibler: &spec.StageAnsibler{Description: &spec.StageDescription{About: "configure nodes"}}}},
+					},
+					LowerPriority: &spec.TaskEvent{Id: "task-2"},
+				},
+			},
+			"dev": {
+				Current: &spec.Clusters{},
+				State:   &spec.Workflow{Status: spec.Workflow_PAUSED},
+				Paused:  true,
+			},
+		},
+	}
+}
+
+func TestCommands(t *testing.T) {
+	t.Parallel()
+
+	c := &fakeManager{configs: []*spec.Config{testConfig()}}
+
+	tests := []struct {
+		name    string
+		cmd     string
+		args    []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "configs",
+			cmd:  "configs",
+			want: []string{"NAME", "config", "claudie", "Scheduled", "2024-06-15T10:00:00Z"},
+		},
+		{
+			name: "clusters",
+			cmd:  "clusters",
+			args: []string{"config"},
+			want: []string{"dev", "PAUSED", "true", "prod", "v1.31.0", "IN_PROGRESS", "task-1"},
+		},
+		{
+			name: "tasks",
+			cmd:  "tasks",
+			want: []string{"task-1", "UPDATE", "2/2", "Ansibler", "configure nodes"},
+		},
+		{
+			name:    "tasks-missing-cluster",
+			cmd:     "tasks",
+			args:    []string{"config", "missing"},
+			wantErr: `cluster "missing" not found within config "config"`,
+		},
+		{
+			name: "nodes",
+			cmd:  "nodes",
+			args: []string{"config", "prod"},
+			want: []string{"control-1", "apiEndpoint", "Joined", "hetzner", "compute-1", "static", "MarkedForDeletion", "compute-2", "Preparing", "lb-1", "aws"},
+		},
+		{
+			name: "kubeconfig",
+			cmd:  "kubeconfig",
+			args: []string{"config", "prod"},
+			want: []string{"kind: Config"},
+		},
+		{
+			name:    "kubeconfig-missing",
+			cmd:     "kubeconfig",
+			args:    []string{"config", "dev"},
+			wantErr: "has no kubeconfig yet",
+		},
+		{
+			name: "history",
+			cmd:  "history",
+			args: []string{"config", "prod"},
+			want: []string{"FINISHED", "DONE", "Kuber", "create"},
+		},
+		{
+			name:    "missing-config",
+			cmd:     "history",
+			args:    []string{"missing"},
+			wantErr: `config "missing" not found`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			out := new(bytes.Buffer)
+			err := commands[tt.cmd].run(t.Context(), c, out, outputTable, tt.args)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, w := range tt.want {
+				assert.Contains(t, out.String(), w)
+			}
+		})
+	}
+}
+
+func TestOutputFormats(t *testing.T) {
+	t.Parallel()
+
+	nodes := newNodeViews(testConfig().Clusters["prod"])
+	require.Len(t, nodes, 4)
+
+	out := new(bytes.Buffer)
+	require.NoError(t, write(out, outputJSON, nodes))
+
+	var fromJSON nodeViews
+	require.NoError(t, json.Unmarshal(out.Bytes(), &fromJSON))
+	assert.Equal(t, nodes, fromJSON)
+
+	out.Reset()
+	require.NoError(t, write(out, outputYAML, nodes))
+
+	var fromYAML nodeViews
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &fromYAML))
+	assert.Equal(t, nodes, fromYAML)
+
+	out.Reset()
+	require.NoError(t, write(out, outputTable, nodes))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.True(t, strings.HasPrefix(lines[0], "CLUSTER"))
+	// empty cells are rendered as a dash to keep the columns aligned.
+	assert.Contains(t, lines[3], "-")
+
+	assert.Error(t, validOutput("xml"))
+}
+
+func TestParseInterspersed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		out  = new(bytes.Buffer)
+		args = []string{"nodes", "config", "-o", "json", "prod"}
+	)
+
+	err := run([]string{"unknown"}, out, out)
+	assert.ErrorContains(t, err, `unknown command "unknown"`)
+
+	err = run([]string{"nodes", "config"}, out, out)
+	assert.ErrorContains(t, err, `invalid number of arguments for "nodes"`)
+
+	err = run([]string{"-o", "xml", "configs"}, out, out)
+	assert.ErrorContains(t, err, "unsupported output format")
+
+	// the flags are parsed regardless of their position.
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	format := fs.String("o", outputTable, "")
+	positional, err := parseInterspersed(fs, args)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nodes", "config", "prod"}, positional)
+	assert.Equal(t, outputJSON, *format)
+}
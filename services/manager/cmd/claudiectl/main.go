@@ -0,0 +1,125 @@
+// Command claudiectl is a read-only command line interface for inspecting
+// the configs and clusters managed by Claudie, via the manager service.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/berops/claudie/internal/envs"
+	managerclient "github.com/berops/claudie/services/manager/client"
+	"github.com/rs/zerolog"
+)
+
+const usage = `claudiectl is a read-only interface for inspecting the state of Claudie.
+
+Usage:
+  claudiectl <command> [flags] [args]
+
+Commands:
+  configs                          List the configs.
+  clusters [config]                List the clusters of all configs or of a single config.
+  tasks [config] [cluster]         Show the tasks in flight and their current pipeline stage.
+  nodes <config> <cluster>         List the nodes of the cluster and its load balancers.
+  kubeconfig <config> <cluster>    Print the kubeconfig of the cluster.
+  history <config> [cluster]       Show the previously finished workflows of the clusters.
+
+Flags:
+`
+
+// command is a single read-only command of the CLI.
+type command struct {
+	// minimum and maximum number of positional arguments.
+	minArgs, maxArgs int
+	run              func(ctx context.Context, c managerclient.CrudAPI, w io.Writer, format string, args []string) error
+}
+
+var commands = map[string]command{
+	"configs":    {minArgs: 0, maxArgs: 0, run: listConfigs},
+	"clusters":   {minArgs: 0, maxArgs: 1, run: listClusters},
+	"tasks":      {minArgs: 0, maxArgs: 2, run: listTasks},
+	"nodes":      {minArgs: 2, maxArgs: 2, run: listNodes},
+	"kubeconfig": {minArgs: 2, maxArgs: 2, run: printKubeconfig},
+	"history":    {minArgs: 1, maxArgs: 2, run: listHistory},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("claudiectl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	var (
+		format  = fs.String("o", outputTable, "Output format, one of table, json, yaml.")
+		address = fs.String("manager", envs.ManagerURL, "Address of the manager service.")
+		timeout = fs.Duration("timeout", 30*time.Second, "Timeout of the requests to the manager.")
+	)
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		fs.Usage()
+		return flag.ErrHelp
+	}
+
+	name, positional := positional[0], positional[1:]
+	cmd, ok := commands[name]
+	if !ok {
+		fs.Usage()
+		return fmt.Errorf("unknown command %q", name)
+	}
+	if len(positional) < cmd.minArgs || len(positional) > cmd.maxArgs {
+		fs.Usage()
+		return fmt.Errorf("invalid number of arguments for %q", name)
+	}
+	if err := validOutput(*format); err != nil {
+		return err
+	}
+
+	logger := zerolog.Nop()
+	client, err := managerclient.NewWithAddress(&logger, *address)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	return cmd.run(ctx, client, stdout, *format, positional)
+}
+
+// parseInterspersed parses the flags that can be mixed with the positional
+// arguments, as the standard library stops parsing on the first non-flag.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
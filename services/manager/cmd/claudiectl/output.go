@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// table is implemented by all of the views printed by the commands.
+// In the JSON and YAML output formats the view itself is encoded,
+// in the table output format the header and rows are printed.
+type table interface {
+	header() []string
+	rows() [][]string
+}
+
+func validOutput(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, expected one of %q, %q, %q", format, outputTable, outputJSON, outputYAML)
+	}
+}
+
+func write(w io.Writer, format string, t table) error {
+	switch format {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(t)
+	case outputYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(t); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		tw := tabwriter.NewWriter(w, 0, 8, 3, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(t.header(), "\t"))
+		for _, row := range t.rows() {
+			for i := range row {
+				if row[i] == "" {
+					row[i] = "-"
+				}
+			}
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+}
@@ -0,0 +1,338 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type configView struct {
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	State     string `json:"state" yaml:"state"`
+	Version   uint64 `json:"version" yaml:"version"`
+	Clusters  int    `json:"clusters" yaml:"clusters"`
+	Updated   string `json:"updated,omitempty" yaml:"updated,omitempty"`
+}
+
+type configViews []configView
+
+func newConfigViews(cfgs []*spec.Config) configViews {
+	out := configViews{}
+	for _, cfg := range cfgs {
+		out = append(out, configView{
+			Name:      cfg.GetName(),
+			Namespace: cfg.GetK8SCtx().GetNamespace(),
+			State:     cfg.GetManifest().GetState().String(),
+			Version:   cfg.GetVersion(),
+			Clusters:  len(cfg.GetClusters()),
+			Updated:   timestamp(cfg.GetManifest().GetStateTimestamp()),
+		})
+	}
+	return out
+}
+
+func (v configViews) header() []string {
+	return []string{"NAME", "NAMESPACE", "STATE", "VERSION", "CLUSTERS", "UPDATED"}
+}
+
+func (v configViews) rows() [][]string {
+	var out [][]string
+	for _, c := range v {
+		out = append(out, []string{c.Name, c.Namespace, c.State, strconv.FormatUint(c.Version, 10), strconv.Itoa(c.Clusters), c.Updated})
+	}
+	return out
+}
+
+type clusterView struct {
+	Config        string   `json:"config" yaml:"config"`
+	Cluster       string   `json:"cluster" yaml:"cluster"`
+	Id            string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Kubernetes    string   `json:"kubernetes,omitempty" yaml:"kubernetes,omitempty"`
+	Status        string   `json:"status" yaml:"status"`
+	Description   string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Paused        bool     `json:"paused" yaml:"paused"`
+	Nodes         int      `json:"nodes" yaml:"nodes"`
+	LoadBalancers []string `json:"loadBalancers,omitempty" yaml:"loadBalancers,omitempty"`
+	InFlight      string   `json:"inFlight,omitempty" yaml:"inFlight,omitempty"`
+}
+
+type clusterViews []clusterView
+
+func newClusterViews(cfgs []*spec.Config) clusterViews {
+	out := clusterViews{}
+	for _, cfg := range cfgs {
+		for _, name := range sortedClusters(cfg) {
+			state := cfg.Clusters[name]
+			k8s := state.GetCurrent().GetK8S()
+
+			v := clusterView{
+				Config:      cfg.GetName(),
+				Cluster:     name,
+				Id:          k8s.GetClusterInfo().Id(),
+				Kubernetes:  k8s.GetKubernetes(),
+				Status:      state.GetState().GetStatus().String(),
+				Description: state.GetState().GetDescription(),
+				Paused:      state.GetPaused(),
+				Nodes:       countNodes(k8s.GetClusterInfo()),
+				InFlight:    state.GetInFlight().GetId(),
+			}
+			for _, lb := range state.GetCurrent().GetLoadBalancers().GetClusters() {
+				v.LoadBalancers = append(v.LoadBalancers, lb.GetClusterInfo().Id())
+			}
+
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (v clusterViews) header() []string {
+	return []string{"CONFIG", "CLUSTER", "KUBERNETES", "STATUS", "PAUSED", "NODES", "LOADBALANCERS", "INFLIGHT", "DESCRIPTION"}
+}
+
+func (v clusterViews) rows() [][]string {
+	var out [][]string
+	for _, c := range v {
+		out = append(out, []string{
+			c.Config,
+			c.Cluster,
+			c.Kubernetes,
+			c.Status,
+			strconv.FormatBool(c.Paused),
+			strconv.Itoa(c.Nodes),
+			strconv.Itoa(len(c.LoadBalancers)),
+			c.InFlight,
+			c.Description,
+		})
+	}
+	return out
+}
+
+type stageView struct {
+	Kind        string `json:"kind" yaml:"kind"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type taskView struct {
+	Config      string      `json:"config" yaml:"config"`
+	Cluster     string      `json:"cluster" yaml:"cluster"`
+	Id          string      `json:"id" yaml:"id"`
+	Event       string      `json:"event" yaml:"event"`
+	Status      string      `json:"status" yaml:"status"`
+	Created     string      `json:"created,omitempty" yaml:"created,omitempty"`
+	Description string      `json:"description,omitempty" yaml:"description,omitempty"`
+	Stage       int         `json:"stage" yaml:"stage"`
+	Pipeline    []stageView `json:"pipeline" yaml:"pipeline"`
+	// Number of the lower priority tasks that follow after this task.
+	Queued int `json:"queued" yaml:"queued"`
+}
+
+type taskViews []taskView
+
+// newTaskViews returns the views of the tasks that are currently
+// worked on, for clusters without a task in flight nothing is returned.
+func newTaskViews(cfgs []*spec.Config, cluster string) taskViews {
+	out := taskViews{}
+	for _, cfg := range cfgs {
+		for _, name := range sortedClusters(cfg) {
+			if cluster != "" && name != cluster {
+				continue
+			}
+
+			state := cfg.Clusters[name]
+			te := state.GetInFlight()
+			if te == nil {
+				continue
+			}
+
+			v := taskView{
+				Config:      cfg.GetName(),
+				Cluster:     name,
+				Id:          te.GetId(),
+				Event:       te.GetEvent().String(),
+				Status:      state.GetState().GetStatus().String(),
+				Created:     timestamp(te.GetTimestamp()),
+				Description: te.GetDescription(),
+				Stage:       int(te.GetCurrentStage()),
+			}
+			for _, s := range te.GetPipeline() {
+				kind, about := stage(s)
+				v.Pipeline = append(v.Pipeline, stageView{Kind: kind, Description: about})
+			}
+			for lp := te.GetLowerPriority(); lp != nil; lp = lp.GetLowerPriority() {
+				v.Queued++
+			}
+
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (v taskViews) header() []string {
+	return []string{"CONFIG", "CLUSTER", "TASK", "EVENT", "STATUS", "STAGE", "KIND", "QUEUED", "DESCRIPTION"}
+}
+
+func (v taskViews) rows() [][]string {
+	var out [][]string
+	for _, t := range v {
+		var kind, about string
+		if t.Stage < len(t.Pipeline) {
+			kind, about = t.Pipeline[t.Stage].Kind, t.Pipeline[t.Stage].Description
+		}
+		out = append(out, []string{
+			t.Config,
+			t.Cluster,
+			t.Id,
+			t.Event,
+			t.Status,
+			fmt.Sprintf("%d/%d", min(t.Stage+1, len(t.Pipeline)), len(t.Pipeline)),
+			kind,
+			strconv.Itoa(t.Queued),
+			about,
+		})
+	}
+	return out
+}
+
+type nodeView struct {
+	Cluster  string `json:"cluster" yaml:"cluster"`
+	NodePool string `json:"nodePool" yaml:"nodePool"`
+	Provider string `json:"provider" yaml:"provider"`
+	Name     string `json:"name" yaml:"name"`
+	Type     string `json:"type" yaml:"type"`
+	Status   string `json:"status" yaml:"status"`
+	Public   string `json:"public,omitempty" yaml:"public,omitempty"`
+	Private  string `json:"private,omitempty" yaml:"private,omitempty"`
+}
+
+type nodeViews []nodeView
+
+// newNodeViews returns the views of the nodes of the kubernetes
+// cluster followed by the nodes of the attached load balancers.
+func newNodeViews(state *spec.ClusterState) nodeViews {
+	out := nodeViews{}
+
+	infos := []*spec.ClusterInfo{state.GetCurrent().GetK8S().GetClusterInfo()}
+	for _, lb := range state.GetCurrent().GetLoadBalancers().GetClusters() {
+		infos = append(infos, lb.GetClusterInfo())
+	}
+
+	for _, info := range infos {
+		for _, np := range info.GetNodePools() {
+			provider := "static"
+			if dyn := np.GetDynamicNodePool(); dyn != nil {
+				provider = dyn.GetProvider().GetCloudProviderName()
+			}
+			for _, n := range np.GetNodes() {
+				out = append(out, nodeView{
+					Cluster:  info.GetName(),
+					NodePool: np.GetName(),
+					Provider: provider,
+					Name:     n.GetName(),
+					Type:     n.GetNodeType().String(),
+					Status:   n.GetStatus().String(),
+					Public:   n.GetPublic(),
+					Private:  n.GetPrivate(),
+				})
+			}
+		}
+	}
+	return out
+}
+
+func (v nodeViews) header() []string {
+	return []string{"CLUSTER", "NODEPOOL", "PROVIDER", "NODE", "TYPE", "STATUS", "PUBLIC", "PRIVATE"}
+}
+
+func (v nodeViews) rows() [][]string {
+	var out [][]string
+	for _, n := range v {
+		out = append(out, []string{n.Cluster, n.NodePool, n.Provider, n.Name, n.Type, n.Status, n.Public, n.Private})
+	}
+	return out
+}
+
+type historyView struct {
+	Config      string `json:"config" yaml:"config"`
+	Cluster     string `json:"cluster" yaml:"cluster"`
+	Finished    string `json:"finished" yaml:"finished"`
+	Status      string `json:"status" yaml:"status"`
+	Stage       string `json:"stage,omitempty" yaml:"stage,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type historyViews []historyView
+
+func newHistoryViews(cfgs []*spec.Config, cluster string) historyViews {
+	out := historyViews{}
+	for _, cfg := range cfgs {
+		for _, name := range sortedClusters(cfg) {
+			if cluster != "" && name != cluster {
+				continue
+			}
+			for _, w := range cfg.Clusters[name].GetState().GetPrevious() {
+				out = append(out, historyView{
+					Config:      cfg.GetName(),
+					Cluster:     name,
+					Finished:    timestamp(w.GetTimestamp()),
+					Status:      w.GetStatus().String(),
+					Stage:       w.GetStage(),
+					Description: w.GetTaskDescription(),
+				})
+			}
+		}
+	}
+	return out
+}
+
+func (v historyViews) header() []string {
+	return []string{"CONFIG", "CLUSTER", "FINISHED", "STATUS", "STAGE", "DESCRIPTION"}
+}
+
+func (v historyViews) rows() [][]string {
+	var out [][]string
+	for _, h := range v {
+		out = append(out, []string{h.Config, h.Cluster, h.Finished, h.Status, h.Stage, h.Description})
+	}
+	return out
+}
+
+func sortedClusters(cfg *spec.Config) []string { return slices.Sorted(maps.Keys(cfg.GetClusters())) }
+
+func countNodes(info *spec.ClusterInfo) int {
+	var n int
+	for _, np := range info.GetNodePools() {
+		n += len(np.GetNodes())
+	}
+	return n
+}
+
+func stage(s *spec.Stage) (kind, about string) {
+	switch k := s.GetStageKind().(type) {
+	case *spec.Stage_Terraformer:
+		return "Terraformer", k.Terraformer.GetDescription().GetAbout()
+	case *spec.Stage_Ansibler:
+		return "Ansibler", k.Ansibler.GetDescription().GetAbout()
+	case *spec.Stage_KubeEleven:
+		return "KubeEleven", k.KubeEleven.GetDescription().GetAbout()
+	case *spec.Stage_Kuber:
+		return "Kuber", k.Kuber.GetDescription().GetAbout()
+	default:
+		return "Unknown", ""
+	}
+}
+
+func timestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().Format(time.RFC3339)
+}
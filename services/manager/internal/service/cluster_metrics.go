@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/service/metrics"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// MetricsTick is the interval at which the per cluster metrics are refreshed from the stored configs.
+	MetricsTick = 15 * time.Second
+
+	// stageStartTTL is how long the start of a stage is remembered, stages that
+	// never finish under the same task ID, i.e. rescheduled tasks, are forgotten.
+	stageStartTTL = 24 * time.Hour
+)
+
+// clusterStats tracks the state needed for the per cluster metrics that is not
+// part of the stored configs. The state is kept only in memory, thus after
+// a restart of the manager the time spent within the workflow statuses is
+// counted from the restart and the stages in progress are not observed.
+var clusterStats = newClusterMetrics()
+
+type clusterKey struct{ config, cluster string }
+
+type stageKey struct {
+	task  string
+	stage uint32
+}
+
+type workflowStatus struct {
+	status string
+	since  time.Time
+}
+
+type clusterMetrics struct {
+	lock sync.Mutex
+
+	statuses map[clusterKey]workflowStatus
+	// loadbalancers are the loadbalancers of the clusters that
+	// have their node counts exported by the last refresh.
+	loadbalancers map[clusterKey][]string
+	stages        map[stageKey]time.Time
+}
+
+func newClusterMetrics() *clusterMetrics {
+	return &clusterMetrics{
+		statuses:      make(map[clusterKey]workflowStatus),
+		loadbalancers: make(map[clusterKey][]string),
+		stages:        make(map[stageKey]time.Time),
+	}
+}
+
+// stageStarted records the start of the stage of the task.
+func (m *clusterMetrics) stageStarted(task string, stage uint32, now time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.stages[stageKey{task: task, stage: stage}] = now
+}
+
+// stageFinished observes the duration of the stage, if its start was recorded,
+// and counts the sub-passes the stage was made of.
+func (m *clusterMetrics) stageFinished(work Work, taskType string, stage store.Stage, result string, now time.Time) {
+	m.lock.Lock()
+	key := stageKey{task: work.TaskID, stage: work.TaskStage}
+	started, ok := m.stages[key]
+	delete(m.stages, key)
+	m.lock.Unlock()
+
+	if ok {
+		metrics.StageDuration.
+			WithLabelValues(work.InputManifest, work.Cluster, taskType, string(stage.Kind), result).
+			Observe(now.Sub(started).Seconds())
+	}
+
+	for _, p := range stage.SubPasses {
+		metrics.StageSubPasses.
+			WithLabelValues(work.InputManifest, work.Cluster, taskType, string(stage.Kind), p.Kind, result).
+			Inc()
+	}
+}
+
+// refresh updates the per cluster metrics from the configs. Metrics
+// of the clusters that are no longer present are deleted.
+func (m *clusterMetrics) refresh(cfgs []*store.Config, now time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	seen := make(map[clusterKey]struct{})
+
+	for _, cfg := range cfgs {
+		for cluster, state := range cfg.Clusters {
+			key := clusterKey{config: cfg.Name, cluster: cluster}
+			seen[key] = struct{}{}
+
+			prev, ok := m.statuses[key]
+			if !ok || prev.status != state.State.Status {
+				if ok {
+					metrics.ClusterWorkflowStatusSeconds.DeleteLabelValues(cfg.Name, cluster, prev.status)
+				}
+				prev = workflowStatus{status: state.State.Status, since: now}
+				m.statuses[key] = prev
+			}
+			metrics.ClusterWorkflowStatusSeconds.
+				WithLabelValues(cfg.Name, cluster, prev.status).
+				Set(now.Sub(prev.since).Seconds())
+
+			current, err := store.ConvertToGRPCClusters(state.Current)
+			if err != nil {
+				log.Err(err).Msgf("Failed to convert current state of cluster %q within config %q for metrics", cluster, cfg.Name)
+				continue
+			}
+			m.setNodes(key, current)
+		}
+	}
+
+	for key, status := range m.statuses {
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		labels := prometheus.Labels{metrics.InputManifestLabel: key.config, metrics.ClusterLabel: key.cluster}
+		metrics.ClusterWorkflowStatusSeconds.DeleteLabelValues(key.config, key.cluster, status.status)
+		metrics.ClusterNodes.DeletePartialMatch(labels)
+		metrics.ClusterUnreachableNodes.DeletePartialMatch(labels)
+		delete(m.statuses, key)
+		delete(m.loadbalancers, key)
+	}
+
+	for key, started := range m.stages {
+		if now.Sub(started) > stageStartTTL {
+			delete(m.stages, key)
+		}
+	}
+}
+
+// setNodes exports the number of nodes by their status for the kubernetes
+// cluster and each of its loadbalancers, including the statuses with no nodes.
+func (m *clusterMetrics) setNodes(key clusterKey, current *spec.Clusters) {
+	set := func(lb string, info *spec.ClusterInfo) {
+		counts := make(map[spec.NodeStatus]int)
+		for _, np := range info.GetNodePools() {
+			for _, n := range np.GetNodes() {
+				counts[n.GetStatus()]++
+			}
+		}
+		for status := range spec.NodeStatus_name {
+			s := spec.NodeStatus(status)
+			metrics.ClusterNodes.WithLabelValues(key.config, key.cluster, lb, s.String()).Set(float64(counts[s]))
+		}
+	}
+
+	set("", current.GetK8S().GetClusterInfo())
+
+	var lbs []string
+	for _, lb := range current.GetLoadBalancers().GetClusters() {
+		name := lb.GetClusterInfo().GetName()
+		lbs = append(lbs, name)
+		set(name, lb.GetClusterInfo())
+	}
+
+	for _, old := range m.loadbalancers[key] {
+		if !slices.Contains(lbs, old) {
+			metrics.ClusterNodes.DeletePartialMatch(prometheus.Labels{
+				metrics.InputManifestLabel: key.config,
+				metrics.ClusterLabel:       key.cluster,
+				metrics.LoadBalancerLabel:  old,
+			})
+		}
+	}
+	m.loadbalancers[key] = lbs
+}
+
+// setUnreachableNodes exports the number of unreachable nodes found by [CheckNodesStatus].
+func setUnreachableNodes(config, cluster string, current *spec.Clusters, status UnknownNodeStatus) {
+	metrics.ClusterUnreachableNodes.DeletePartialMatch(prometheus.Labels{
+		metrics.InputManifestLabel: config,
+		metrics.ClusterLabel:       cluster,
+	})
+
+	var k8s int
+	for _, nodes := range status.UnknownKubernetesNodes {
+		k8s += len(nodes)
+	}
+	metrics.ClusterUnreachableNodes.WithLabelValues(config, cluster, "").Set(float64(k8s))
+
+	for _, lb := range current.GetLoadBalancers().GetClusters() {
+		var unreachable int
+		for _, ips := range status.UnknownLoadBalancersNodes[lb.GetClusterInfo().Id()] {
+			unreachable += len(ips)
+		}
+		metrics.ClusterUnreachableNodes.WithLabelValues(config, cluster, lb.GetClusterInfo().GetName()).Set(float64(unreachable))
+	}
+}
+
+func (s *Service) WatchForClusterMetrics(ctx context.Context) error {
+	cfgs, err := s.store.ListConfigs(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list configs: %w", err)
+	}
+	clusterStats.refresh(cfgs, time.Now())
+	return nil
+}
+
+func (s *Service) watchClusterMetrics() {
+	for {
+		select {
+		case <-s.done:
+			log.Info().Msg("Exited worker loop running WatchForClusterMetrics")
+			return
+		case <-time.After(MetricsTick):
+			if err := s.WatchForClusterMetrics(context.Background()); err != nil {
+				log.Err(err).Msg("Watch for cluster metrics failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/service/metrics"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClusterMetricsRefresh(t *testing.T) {
+	t.Parallel()
+
+	const config = "metrics-refresh"
+
+	current, err := store.ConvertFromGRPCClusters(&spec.Clusters{
+		K8S: &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{
+			Name: "k8s",
+			Hash: "hash",
+			NodePools: []*spec.NodePool{{Nodes: []*spec.Node{
+				{Name: "a", Status: spec.NodeStatus_Joined},
+				{Name: "b", Status: spec.NodeStatus_Joined},
+				{Name: "c", Status: spec.NodeStatus_MarkedForDeletion},
+			}}},
+		}},
+		LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{{ClusterInfo: &spec.ClusterInfo{
+			Name:      "lb",
+			Hash:      "hash",
+			NodePools: []*spec.NodePool{{Nodes: []*spec.Node{{Name: "d", Status: spec.NodeStatus_Preparing}}}},
+		}}}},
+	})
+	require.NoError(t, err)
+
+	cfg := &store.Config{
+		Name: config,
+		Clusters: map[string]*store.ClusterState{
+			"k8s": {Current: current, State: store.Workflow{Status: spec.Workflow_WAIT_FOR_PICKUP.String()}},
+		},
+	}
+
+	var (
+		m   = newClusterMetrics()
+		now = time.Now()
+	)
+
+	m.refresh([]*store.Config{cfg}, now)
+	m.refresh([]*store.Config{cfg}, now.Add(30*time.Second))
+	assert.Equal(t, 30.0, testutil.ToFloat64(metrics.ClusterWorkflowStatusSeconds.WithLabelValues(config, "k8s", "WAIT_FOR_PICKUP")))
+
+	cfg.Clusters["k8s"].State.Status = spec.Workflow_ERROR.String()
+	m.refresh([]*store.Config{cfg}, now.Add(time.Minute))
+	m.refresh([]*store.Config{cfg}, now.Add(31*time.Minute))
+	assert.Equal(t, 1800.0, testutil.ToFloat64(metrics.ClusterWorkflowStatusSeconds.WithLabelValues(config, "k8s", "ERROR")))
+
+	// the series of the previous status is removed.
+	assert.False(t, metrics.ClusterWorkflowStatusSeconds.DeleteLabelValues(config, "k8s", "WAIT_FOR_PICKUP"))
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(metrics.ClusterNodes.WithLabelValues(config, "k8s", "", "Joined")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ClusterNodes.WithLabelValues(config, "k8s", "", "MarkedForDeletion")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(metrics.ClusterNodes.WithLabelValues(config, "k8s", "", "Preparing")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ClusterNodes.WithLabelValues(config, "k8s", "lb", "Preparing")))
+
+	// once the config is gone, all of its series are removed.
+	m.refresh(nil, now.Add(32*time.Minute))
+	assert.False(t, metrics.ClusterWorkflowStatusSeconds.DeleteLabelValues(config, "k8s", "ERROR"))
+	assert.False(t, metrics.ClusterNodes.DeleteLabelValues(config, "k8s", "", "Joined"))
+	assert.False(t, metrics.ClusterNodes.DeleteLabelValues(config, "k8s", "lb", "Preparing"))
+	assert.Empty(t, m.statuses)
+}
+
+func TestClusterMetricsStages(t *testing.T) {
+	t.Parallel()
+
+	const config = "metrics-stages"
+
+	var (
+		m     = newClusterMetrics()
+		now   = time.Now()
+		work  = Work{InputManifest: config, Cluster: "k8s", TaskID: "task", TaskStage: 1}
+		stage = store.Stage{
+			Kind: store.Terraformer,
+			SubPasses: []store.SubPass{
+				{Kind: spec.StageTerraformer_UPDATE_INFRASTRUCTURE.String()},
+				{Kind: spec.StageTerraformer_BUILD_INFRASTRUCTURE.String()},
+			},
+		}
+	)
+
+	m.stageStarted("task", 1, now)
+	m.stageStarted("stale", 0, now.Add(-25*time.Hour))
+
+	m.stageFinished(work, spec.Event_UPDATE.String(), stage, metrics.ResultOk, now.Add(time.Minute))
+	assert.NotContains(t, m.stages, stageKey{task: "task", stage: 1})
+
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics.StageDuration.MustCurryWith(map[string]string{
+		metrics.InputManifestLabel: config,
+	})))
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.StageSubPasses.WithLabelValues(
+		config, "k8s", "UPDATE", "TERRAFORMER", spec.StageTerraformer_UPDATE_INFRASTRUCTURE.String(), metrics.ResultOk,
+	)))
+
+	// starts of stages that never finished are eventually forgotten.
+	m.refresh(nil, now)
+	assert.Empty(t, m.stages)
+}
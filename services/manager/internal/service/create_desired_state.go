@@ -1,6 +1,7 @@
 package service
 
 import (
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"net"
@@ -176,9 +177,12 @@ func createK8sClustersFromManifest(from *manifest.Manifest, into *map[string]*sp
 				Name: strings.ToLower(cluster.Name),
 				Hash: hash.Create(hash.Length),
 			},
-			Kubernetes:        cluster.Version,
-			Network:           cluster.Network,
-			InstallationProxy: useInstallationProxy,
+			Kubernetes:         cluster.Version,
+			Network:            cluster.Network,
+			NetworkIPv6:        cluster.NetworkIPv6,
+			InstallationProxy:  useInstallationProxy,
+			MaintenanceWindows: cluster.CreateMaintenanceWindows(),
+			NodeHooks:          cluster.CreateNodeHooks(),
 		}
 
 		controlNodePools, err := from.CreateNodepools(cluster.Pools.Control, true)
@@ -228,9 +232,11 @@ func createLBClustersFromManifest(from *manifest.Manifest, into *map[string]*spe
 				Name: lbCluster.Name,
 				Hash: hash.Create(hash.Length),
 			},
-			Roles:       attachedRoles,
-			Dns:         dns,
-			TargetedK8S: lbCluster.TargetedK8s,
+			Roles:               attachedRoles,
+			Dns:                 dns,
+			TargetedK8S:         lbCluster.TargetedK8s,
+			ServiceLoadBalancer: from.CreateServiceLoadBalancer(&lbCluster),
+			VirtualIP:           from.CreateVirtualIP(&lbCluster),
 		}
 
 		nodes, err := from.CreateNodepools(lbCluster.Pools, false)
@@ -239,6 +245,12 @@ func createLBClustersFromManifest(from *manifest.Manifest, into *map[string]*spe
 		}
 		newLbCluster.ClusterInfo.NodePools = nodes
 
+		if slb := newLbCluster.ServiceLoadBalancer; slb != nil {
+			// Token authenticating the service controller, replaced by
+			// the one from the current state once the loadbalancer is built.
+			slb.Token = rand.Text()
+		}
+
 		// NOTE:
 		// the CIDR,SSH keys and DNS hostname (if not set) are not populated at this point
 		// in the pipeline. Here only the parsed skeleton of the passed in [manifest.Manifest]
@@ -262,8 +274,13 @@ func createLBClustersFromManifest(from *manifest.Manifest, into *map[string]*spe
 	return nil
 }
 
-// getDNS parses the manifest for the DNS specification.
-func getDNS(dns manifest.DNS, from *manifest.Manifest) (*spec.DNS, error) {
+// getDNS parses the manifest for the DNS specification. Returns nil if the DNS
+// is not set, in which case the loadbalancer is reachable only at its virtual IP.
+func getDNS(dns *manifest.DNS, from *manifest.Manifest) (*spec.DNS, error) {
+	if dns == nil {
+		return nil, nil
+	}
+
 	if dns.DNSZone == "" {
 		return nil, fmt.Errorf("DNS zone not provided in manifest %s", from.Name)
 	}
@@ -313,6 +330,8 @@ func getRolesAttachedToLBCluster(roles []manifest.Role, roleNames []string) []*s
 					}
 				}
 
+				algorithm := role.Settings.CreateAlgorithm()
+
 				newRole := &spec.Role{
 					Name:        role.Name,
 					Protocol:    strings.ToLower(role.Protocol),
@@ -320,9 +339,21 @@ func getRolesAttachedToLBCluster(roles []manifest.Role, roleNames []string) []*s
 					TargetPort:  role.TargetPort,
 					TargetPools: role.TargetPools,
 					RoleType:    roleType,
+					Routes:      role.CreateRoutes(),
+					// enforced by the firewall, thus changes are
+					// handled as changes of the external settings.
+					AllowedSourceRanges: role.Settings.CreateAllowedSourceRanges(),
 					Settings: &spec.Role_Settings{
-						ProxyProtocol:  role.Settings.ProxyProtocol,
-						StickySessions: role.Settings.StickySessions,
+						ProxyProtocol: role.Settings.ProxyProtocol,
+						// the hash based algorithms are sticky by definition.
+						StickySessions:   role.Settings.StickySessions || algorithm == spec.Role_RING_HASH || algorithm == spec.Role_MAGLEV,
+						HealthCheck:      role.Settings.CreateHealthCheck(),
+						OutlierDetection: role.Settings.CreateOutlierDetection(),
+						Tls:              role.CreateTLS(),
+						Algorithm:        algorithm,
+						Weights:          role.Settings.CreateWeights(),
+						MaxConnections:   uint32(role.Settings.MaxConnections),
+						RateLimit:        role.Settings.CreateRateLimit(),
 						// initially set as an invalid port, must be updated
 						// later, when merging with the existing state to avoid
 						// port duplication.
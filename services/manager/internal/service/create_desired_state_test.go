@@ -77,7 +77,7 @@ func Test_getRolesAttachedToLBCluster(t *testing.T) {
 
 func Test_getDNS(t *testing.T) {
 	type args struct {
-		dns  manifest.DNS
+		dns  *manifest.DNS
 		from *manifest.Manifest
 	}
 	tests := []struct {
@@ -88,14 +88,14 @@ func Test_getDNS(t *testing.T) {
 	}{
 		{
 			name:    "getDNS-no-zone",
-			args:    args{dns: manifest.DNS{}, from: &manifest.Manifest{Name: "test"}},
+			args:    args{dns: &manifest.DNS{}, from: &manifest.Manifest{Name: "test"}},
 			want:    nil,
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool { return assert.NotNil(t, err) },
 		},
 		{
 			name: "getDNS-ok",
 			args: args{
-				dns: manifest.DNS{
+				dns: &manifest.DNS{
 					DNSZone:  "test-zone",
 					Provider: "test-provider",
 					Hostname: "test-hostname",
@@ -232,7 +232,7 @@ func Test_createLBClustersFromManifest(t *testing.T) {
 							{
 								Name:        "test-lb-cluster",
 								Roles:       []string{"kubeapi"},
-								DNS:         manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
+								DNS:         &manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
 								TargetedK8s: "test-cluster",
 								Pools:       []string{"lb-pool"},
 							},
@@ -366,7 +366,7 @@ func Test_createLBClustersFromManifest(t *testing.T) {
 							{
 								Name:        "test-lb-cluster",
 								Roles:       []string{"kubeapi"},
-								DNS:         manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
+								DNS:         &manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
 								TargetedK8s: "test-k8s",
 								Pools:       []string{"lb-pool"},
 							},
@@ -496,7 +496,7 @@ func Test_createLBClustersFromManifest(t *testing.T) {
 							{
 								Name:        "test-lb-cluster",
 								Roles:       []string{"kubeapi"},
-								DNS:         manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
+								DNS:         &manifest.DNS{DNSZone: "test-zone", Provider: "test-provider", Hostname: "test-hostname"},
 								TargetedK8s: "test-k8s",
 								Pools:       []string{"lb-pool"},
 							},
@@ -107,8 +107,13 @@ type (
 		LabelsTaintsAnnotations LabelsTaintsAnnotationsDiffResult
 
 		// RollingUpdates are nodepools present in both states but
-		// having different templates commit hash.
+		// having different templates commit hash or infrastructure spec.
 		RollingUpdates PendingRollingUpdates
+
+		// RollingUpdatesInProgress are rolling updates for which the
+		// replacement nodepool is already part of the current state.
+		// Not filled by [KubernetesDiff], see [TrackRollingUpdatesInProgress].
+		RollingUpdatesInProgress RollingUpdatesInProgressViewType
 	}
 
 	ModifiedLoadBalancer struct {
@@ -143,6 +148,9 @@ type (
 			// with the name of the Pools that were deleted.
 			TargetPoolsDeleted TargetPoolsViewType
 
+			// Name of the roles for which the Routes have changed.
+			RoutesChanged []string
+
 			// Name of the roles for which internal settings have changed.
 			// Internal Settings are:
 			//  - Role.Settings
@@ -176,7 +184,7 @@ type (
 		// pending diff to be handled at some point in the future.
 		PendingStaticDeletions PendingDeletionsViewType
 
-		// Nodepools that have their templates changed.
+		// Nodepools that have their templates or infrastructure spec changed.
 		RollingUpdate PendingRollingUpdates
 	}
 
@@ -263,9 +271,31 @@ type (
 
 	// PendingRollingUpdates is an unordered view into nodepools which
 	// are present in both the current and desired state but have different
-	// templates versions, meaning that a rolling update is required for the
-	// infrastructure.
-	PendingRollingUpdates map[string]*spec.TemplateRepository
+	// templates versions or a different infrastructure spec (image, server type,
+	// disk size), meaning that a rolling update is required for the
+	// infrastructure. The value is the desired state of the nodepool.
+	PendingRollingUpdates map[string]*spec.DynamicNodePool
+
+	// RollingUpdatesInProgressViewType is an unordered view into the nodepools
+	// which are being replaced by a rolling update, keyed by the name of the
+	// nodepool that is being replaced.
+	RollingUpdatesInProgressViewType = map[string]RollingUpdateProgress
+
+	// RollingUpdateProgress describes the state of a rolling update of a nodepool.
+	RollingUpdateProgress struct {
+		// Name of the nodepool that replaces the old nodepool.
+		Replacement string
+
+		// Nodes of the replacement nodepool, from the desired
+		// state, that are yet to be added.
+		Pending []string
+
+		// Count of the replacement nodepool in the desired state.
+		Target int32
+
+		// Strategy of the replacement nodepool in the desired state.
+		Strategy *spec.RollingUpdateStrategy
+	}
 
 	// TargetPoolsViewType is an unordered view into the diff for target pools
 	// that are from a [spec.Role].
@@ -425,8 +455,8 @@ func KubernetesDiff(old, desired *spec.K8Scluster) KubernetesDiffResult {
 				cdyn := cnp.GetDynamicNodePool()
 				ddyn := dnp.GetDynamicNodePool()
 
-				if cdyn.Provider.Templates.CommitHash != ddyn.Provider.Templates.CommitHash {
-					result.RollingUpdates[dnp.Name] = proto.Clone(ddyn.Provider.Templates).(*spec.TemplateRepository)
+				if RequiresRollingUpdate(cdyn, ddyn) {
+					result.RollingUpdates[dnp.Name] = proto.Clone(ddyn).(*spec.DynamicNodePool)
 				}
 				if cdyn.AutoscalerConfig == nil && ddyn.AutoscalerConfig != nil {
 					result.ChangedToAutoscaled[dnp.Name] = proto.Clone(ddyn.AutoscalerConfig).(*spec.AutoscalerConf)
@@ -673,6 +703,7 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 			rolesDeleted            []string
 			targetPoolsAdded        = make(TargetPoolsViewType)
 			targetPoolsDeleted      = make(TargetPoolsViewType)
+			routesChanged           []string
 		)
 
 		for _, o := range old.Roles {
@@ -711,10 +742,15 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 
 			hasExternalChanges := o.Port != newRole.Port
 			hasExternalChanges = hasExternalChanges || o.Protocol != newRole.Protocol
+			hasExternalChanges = hasExternalChanges || !slices.Equal(o.AllowedSourceRanges, newRole.AllowedSourceRanges)
 			if hasExternalChanges {
 				externalSettingsChanged = append(externalSettingsChanged, newRole.Name)
 			}
 
+			if !slices.EqualFunc(o.Routes, newRole.Routes, func(a, b *spec.Role_Route) bool { return proto.Equal(a, b) }) {
+				routesChanged = append(routesChanged, newRole.Name)
+			}
+
 			// TargetPools deleted.
 			for _, old := range o.TargetPools {
 				found := slices.Contains(newRole.TargetPools, old)
@@ -770,8 +806,8 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 					cdyn := cnp.GetDynamicNodePool()
 					ddyn := dnp.GetDynamicNodePool()
 
-					if cdyn.Provider.Templates.CommitHash != ddyn.Provider.Templates.CommitHash {
-						rollingUpdates[dnp.Name] = proto.Clone(ddyn.Provider.Templates).(*spec.TemplateRepository)
+					if RequiresRollingUpdate(cdyn, ddyn) {
+						rollingUpdates[dnp.Name] = proto.Clone(ddyn).(*spec.DynamicNodePool)
 					}
 				}
 
@@ -805,6 +841,7 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 		modified := len(rolesAdded) > 0 || len(rolesDeleted) > 0
 		modified = modified || len(internalSettingsChanged) > 0 || len(externalSettingsChanged) > 0
 		modified = modified || len(targetPoolsAdded) > 0 || len(targetPoolsDeleted) > 0
+		modified = modified || len(routesChanged) > 0
 		modified = modified || dnsChanged
 		modified = modified || len(pendingDynamicDeletions) > 0 || len(pendingStaticDeletions) > 0
 		modified = modified || len(rollingUpdates) > 0
@@ -819,6 +856,7 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 					Deleted                 []string
 					TargetPoolsAdded        TargetPoolsViewType
 					TargetPoolsDeleted      TargetPoolsViewType
+					RoutesChanged           []string
 					InternalSettingsChanged []string
 					ExternalSettingsChanged []string
 				}
@@ -838,6 +876,7 @@ func LoadBalancersDiff(old, desired *spec.LoadBalancers) LoadBalancersDiffResult
 			entry.Roles.Deleted = rolesDeleted
 			entry.Roles.TargetPoolsAdded = targetPoolsAdded
 			entry.Roles.TargetPoolsDeleted = targetPoolsDeleted
+			entry.Roles.RoutesChanged = routesChanged
 			entry.Roles.InternalSettingsChanged = internalSettingsChanged
 			entry.Roles.ExternalSettingsChanged = externalSettingsChanged
 
@@ -877,7 +916,7 @@ func determineLBApiEndpointChange(
 	)
 
 	for _, lb := range desiredLbs {
-		if lb.HasApiRole() && lb.Dns != nil {
+		if lb.HasApiRole() && hasEndpoint(lb) {
 			desired[lb.ClusterInfo.Id()] = lb
 			if first == nil {
 				first = lb
@@ -886,14 +925,14 @@ func determineLBApiEndpointChange(
 	}
 
 	if current := clusters.FindAssignedLbApiEndpoint(currentLbs); current != nil {
-		if len(desired) == 0 && current.Dns == nil { // current state has no dns, but lb was deleted.
+		if len(desired) == 0 && !hasEndpoint(current) { // current state has no dns, but lb was deleted.
 			return none, none, spec.ApiEndpointChangeState_NoChange
 		}
 
 		if len(desired) == 0 {
 			return current.ClusterInfo.Id(), none, spec.ApiEndpointChangeState_DetachingLoadBalancer
 		}
-		if current.Dns == nil { // current state has no dns but there is at least one cluster in desired state.
+		if !hasEndpoint(current) { // current state has no dns but there is at least one cluster in desired state.
 			// If it is the same cluster with the api server but the dns changed, schedule it.
 			if desired, ok := desired[current.ClusterInfo.Id()]; ok {
 				return current.ClusterInfo.Id(), desired.ClusterInfo.Id(), spec.ApiEndpointChangeState_EndpointRenamed
@@ -901,7 +940,7 @@ func determineLBApiEndpointChange(
 			return none, first.ClusterInfo.Id(), spec.ApiEndpointChangeState_AttachingLoadBalancer
 		}
 		if desired, ok := desired[current.ClusterInfo.Id()]; ok {
-			if current.Dns.Endpoint != desired.Dns.Endpoint {
+			if current.Endpoint() != desired.Endpoint() {
 				return current.ClusterInfo.Id(), desired.ClusterInfo.Id(), spec.ApiEndpointChangeState_EndpointRenamed
 			}
 			return none, none, spec.ApiEndpointChangeState_NoChange
@@ -916,6 +955,12 @@ func determineLBApiEndpointChange(
 	}
 }
 
+// hasEndpoint reports whether the loadbalancer can be used as
+// the api endpoint, which requires either its DNS or its virtual IP.
+func hasEndpoint(lb *spec.LBcluster) bool {
+	return lb.Dns != nil || lb.GetVirtualIP().GetIp() != ""
+}
+
 func newAPIEndpointNodeCandidate(desired []*spec.NodePool) (string, string) {
 	for _, np := range desired {
 		if np.IsControl {
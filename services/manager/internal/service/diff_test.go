@@ -645,3 +645,223 @@ func findCommonStaticNodes(left, right *spec.ClusterInfo) map[string][]string {
 
 	return result
 }
+
+func TestLoadBalancersDiff_Routes(t *testing.T) {
+	current := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "lb", Hash: "hash"},
+		Roles: []*spec.Role{{
+			Name:        "web",
+			Protocol:    "http",
+			Port:        80,
+			TargetPort:  8080,
+			TargetPools: []string{"np-1"},
+			Settings:    &spec.Role_Settings{EnvoyAdminPort: 1024},
+			Routes: []*spec.Role_Route{
+				{Host: "app.example.com", PathPrefix: "/", TargetPools: []string{"np-1"}, TargetPort: 8081},
+			},
+		}},
+	}
+
+	desired := proto.Clone(current).(*spec.LBcluster)
+	desired.Roles[0].Routes[0].TargetPools = []string{"np-2"}
+	desired.Roles[0].Routes = append(desired.Roles[0].Routes, &spec.Role_Route{PathPrefix: "/api", TargetPools: []string{"np-1"}, TargetPort: 9090})
+
+	diff := LoadBalancersDiff(
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{current}},
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}},
+	)
+
+	modified, ok := diff.Modified[current.ClusterInfo.Id()]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"web"}, modified.Roles.RoutesChanged)
+	assert.Empty(t, modified.Roles.TargetPoolsAdded)
+	assert.Empty(t, modified.Roles.TargetPoolsDeleted)
+	assert.Empty(t, modified.Roles.InternalSettingsChanged)
+	assert.Empty(t, modified.Roles.ExternalSettingsChanged)
+	assert.Empty(t, modified.Roles.Added)
+	assert.Empty(t, modified.Roles.Deleted)
+
+	event := ScheduleReconcileRoleTargetPools(
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{current}}},
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}}},
+		LoadBalancerIdentifier{Id: current.ClusterInfo.Id(), Index: 0},
+		LoadBalancerIdentifier{Id: desired.ClusterInfo.Id(), Index: 0},
+	)
+
+	replace := event.GetTask().GetUpdate().GetAnsReplaceTargetPools()
+	assert.Equal(t, []string{"np-1"}, replace.GetRoles()["web"].GetPools())
+	assert.Len(t, replace.GetRoles()["web"].GetRoutes(), 2)
+	for i, r := range replace.GetRoles()["web"].GetRoutes() {
+		assert.True(t, proto.Equal(desired.Roles[0].Routes[i], r))
+		assert.NotSame(t, desired.Roles[0].Routes[i], r)
+	}
+
+	// unchanged routes are not reported.
+	diff = LoadBalancersDiff(
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{current}},
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{proto.Clone(current).(*spec.LBcluster)}},
+	)
+	assert.Empty(t, diff.Modified)
+}
+
+func TestLoadBalancersDiff_AlgorithmAndWeights(t *testing.T) {
+	current := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "lb", Hash: "hash"},
+		Roles: []*spec.Role{{
+			Name:        "web",
+			Protocol:    "tcp",
+			Port:        80,
+			TargetPort:  8080,
+			TargetPools: []string{"old", "new"},
+			Settings:    &spec.Role_Settings{EnvoyAdminPort: 1024},
+		}},
+	}
+
+	desired := proto.Clone(current).(*spec.LBcluster)
+	desired.Roles[0].Settings.Algorithm = spec.Role_LEAST_REQUEST
+	desired.Roles[0].Settings.Weights = map[string]uint32{"old": 90, "new": 10}
+
+	diff := LoadBalancersDiff(
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{current}},
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}},
+	)
+
+	// only the settings of the role are refreshed, without any node changes.
+	modified, ok := diff.Modified[current.ClusterInfo.Id()]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"web"}, modified.Roles.InternalSettingsChanged)
+	assert.Empty(t, modified.Roles.ExternalSettingsChanged)
+	assert.Empty(t, modified.Roles.TargetPoolsAdded)
+	assert.Empty(t, modified.Roles.TargetPoolsDeleted)
+	assert.True(t, modified.Dynamic.IsEmpty())
+	assert.True(t, modified.Static.IsEmpty())
+
+	event := ScheduleRefreshLoadBalancerRoleInternalSettings(
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{current}}},
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}}},
+		LoadBalancerIdentifier{Id: current.ClusterInfo.Id(), Index: 0},
+		LoadBalancerIdentifier{Id: desired.ClusterInfo.Id(), Index: 0},
+		"web",
+	)
+
+	settings := event.GetTask().GetUpdate().GetAnsReplaceRoleInternalSettings().GetSettings()
+	assert.True(t, proto.Equal(desired.Roles[0].Settings, settings))
+	assert.NotSame(t, desired.Roles[0].Settings, settings)
+}
+
+func TestLoadBalancersDiff_AllowedSourceRanges(t *testing.T) {
+	current := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "lb", Hash: "hash"},
+		Roles: []*spec.Role{{
+			Name:        "web",
+			Protocol:    "tcp",
+			Port:        80,
+			TargetPort:  8080,
+			TargetPools: []string{"pool"},
+			Settings:    &spec.Role_Settings{EnvoyAdminPort: 1024},
+		}},
+	}
+
+	desired := proto.Clone(current).(*spec.LBcluster)
+	desired.Roles[0].AllowedSourceRanges = []string{"10.0.0.0/8"}
+
+	diff := LoadBalancersDiff(
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{current}},
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}},
+	)
+
+	// the firewalls are updated in place, without any node changes.
+	modified, ok := diff.Modified[current.ClusterInfo.Id()]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"web"}, modified.Roles.ExternalSettingsChanged)
+	assert.Empty(t, modified.Roles.InternalSettingsChanged)
+	assert.True(t, modified.Dynamic.IsEmpty())
+	assert.True(t, modified.Static.IsEmpty())
+
+	event := ScheduleRefreshLoadBalancerRoleExternalSettings(
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{current}}},
+		&spec.Clusters{LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}}},
+		LoadBalancerIdentifier{Id: current.ClusterInfo.Id(), Index: 0},
+		LoadBalancerIdentifier{Id: desired.ClusterInfo.Id(), Index: 0},
+		"web",
+	)
+
+	replace := event.GetTask().GetUpdate().GetTfReplaceRoleExternalSettings()
+	assert.Equal(t, []string{"10.0.0.0/8"}, replace.GetAllowedSourceRanges())
+
+	// limits are applied by envoy only.
+	desired = proto.Clone(current).(*spec.LBcluster)
+	desired.Roles[0].Settings.MaxConnections = 100
+	desired.Roles[0].Settings.RateLimit = &spec.Role_RateLimit{ConnectionsPerSecond: 10, Burst: 10}
+
+	diff = LoadBalancersDiff(
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{current}},
+		&spec.LoadBalancers{Clusters: []*spec.LBcluster{desired}},
+	)
+	modified = diff.Modified[current.ClusterInfo.Id()]
+	assert.Equal(t, []string{"web"}, modified.Roles.InternalSettingsChanged)
+	assert.Empty(t, modified.Roles.ExternalSettingsChanged)
+}
+
+func TestDetermineLBApiEndpointChange_VirtualIP(t *testing.T) {
+	lb := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "lb", Hash: "hash"},
+		Roles:       []*spec.Role{{Name: "api", RoleType: spec.RoleType_ApiServer}},
+		VirtualIP:   &spec.VirtualIP{Ip: "192.168.1.100"},
+	}
+
+	// a loadbalancer without dns is used as the api endpoint via its virtual IP.
+	_, desired, state := determineLBApiEndpointChange(nil, []*spec.LBcluster{lb})
+	assert.Equal(t, spec.ApiEndpointChangeState_AttachingLoadBalancer, state)
+	assert.Equal(t, lb.ClusterInfo.Id(), desired)
+
+	current := proto.Clone(lb).(*spec.LBcluster)
+	current.UsedApiEndpoint = true
+	_, _, state = determineLBApiEndpointChange([]*spec.LBcluster{current}, []*spec.LBcluster{lb})
+	assert.Equal(t, spec.ApiEndpointChangeState_NoChange, state)
+
+	lb.VirtualIP = nil
+	_, _, state = determineLBApiEndpointChange(nil, []*spec.LBcluster{lb})
+	assert.Equal(t, spec.ApiEndpointChangeState_NoChange, state)
+}
+
+func TestKubernetesDiff_RollingUpdateOnSpecChange(t *testing.T) {
+	current := &spec.K8Scluster{InstallationProxy: &spec.InstallationProxy{Mode: ProxyOffMode}, ClusterInfo: &spec.ClusterInfo{
+		Name: "k8s",
+		Hash: "hash",
+		NodePools: []*spec.NodePool{{
+			Name: "np0-hash",
+			Type: &spec.NodePool_DynamicNodePool{DynamicNodePool: &spec.DynamicNodePool{
+				ServerType:      "cpx22",
+				Image:           "ubuntu-22.04",
+				StorageDiskSize: 50,
+				Count:           1,
+				Provider:        &spec.Provider{Templates: &spec.TemplateRepository{CommitHash: "commit"}},
+			}},
+		}},
+	}}
+
+	diff := KubernetesDiff(current, proto.Clone(current).(*spec.K8Scluster))
+	assert.Empty(t, diff.RollingUpdates)
+
+	// changing the count is done in-place.
+	desired := proto.Clone(current).(*spec.K8Scluster)
+	desired.ClusterInfo.NodePools[0].GetDynamicNodePool().Count = 3
+	diff = KubernetesDiff(current, desired)
+	assert.Empty(t, diff.RollingUpdates)
+
+	for _, change := range []func(np *spec.DynamicNodePool){
+		func(np *spec.DynamicNodePool) { np.Image = "ubuntu-24.04" },
+		func(np *spec.DynamicNodePool) { np.ServerType = "cpx32" },
+		func(np *spec.DynamicNodePool) { np.StorageDiskSize = 100 },
+		func(np *spec.DynamicNodePool) { np.MachineSpec = &spec.MachineSpec{CpuCount: 4} },
+		func(np *spec.DynamicNodePool) { np.Provider.Templates.CommitHash = "other" },
+	} {
+		desired := proto.Clone(current).(*spec.K8Scluster)
+		change(desired.ClusterInfo.NodePools[0].GetDynamicNodePool())
+
+		diff := KubernetesDiff(current, desired)
+		assert.Len(t, diff.RollingUpdates, 1)
+		assert.True(t, proto.Equal(desired.ClusterInfo.NodePools[0].GetDynamicNodePool(), diff.RollingUpdates["np0-hash"]))
+	}
+}
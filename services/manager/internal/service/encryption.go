@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/berops/claudie/internal/envs"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	KMSProviderLocal = "local"
+	KMSProviderVault = "vault"
+)
+
+// EncryptionTick is the interval at which the configs are checked for clusters
+// and tasks that are not yet encrypted, or are encrypted with a rotated key.
+const EncryptionTick = 5 * time.Minute
+
+var (
+	// KMS used for the encryption at rest of the clusters and tasks within the configs,
+	// either "local" or "vault". If empty the encryption at rest is disabled.
+	KMSProvider = envs.GetOrDefault("MANAGER_KMS_PROVIDER", "")
+
+	// File with the keys for the "local" KMS provider.
+	KMSLocalKeysFile = envs.GetOrDefault("MANAGER_KMS_LOCAL_KEYS_FILE", "/etc/claudie/kms/keys")
+
+	// Address and token of the Vault for the "vault" KMS provider.
+	VaultAddr  = envs.GetOrDefault("VAULT_ADDR", "")
+	VaultToken = envs.GetOrDefault("VAULT_TOKEN", "")
+
+	// Mount path of the transit secrets engine and name of the transit key for the "vault" KMS provider.
+	VaultTransitMount = envs.GetOrDefault("MANAGER_KMS_VAULT_TRANSIT_MOUNT", "transit")
+	VaultTransitKey   = envs.GetOrDefault("MANAGER_KMS_VAULT_KEY", "claudie")
+)
+
+// newKMS returns the KMS for the configured provider, nil if the encryption at rest is disabled.
+func newKMS() (store.KMS, error) {
+	switch KMSProvider {
+	case "":
+		return nil, nil
+	case KMSProviderLocal:
+		return store.NewLocalKMS(KMSLocalKeysFile)
+	case KMSProviderVault:
+		return store.NewVaultKMS(VaultAddr, VaultToken, VaultTransitMount, VaultTransitKey)
+	default:
+		return nil, fmt.Errorf("unsupported KMS provider %q, expected one of %q, %q", KMSProvider, KMSProviderLocal, KMSProviderVault)
+	}
+}
+
+// WatchForUnencryptedDocuments encrypts the clusters and tasks of the configs that were
+// stored before the encryption at rest was enabled or before the key of the KMS was rotated.
+func (s *Service) WatchForUnencryptedDocuments(ctx context.Context) error {
+	cfgs, err := s.store.ListConfigs(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, cfg := range cfgs {
+		reencrypt, err := store.NeedsReencryption(cfg)
+		if err != nil {
+			log.Err(err).Msgf("Failed to check encryption of config %q", cfg.Name)
+			continue
+		}
+		if !reencrypt {
+			continue
+		}
+
+		if err := store.Reencrypt(cfg); err != nil {
+			log.Err(err).Msgf("Failed to re-encrypt config %q", cfg.Name)
+			continue
+		}
+
+		if err := s.store.UpdateConfig(ctx, cfg); err != nil {
+			if errors.Is(err, store.ErrNotFoundOrDirty) {
+				// The config was changed in the meantime, retry on the next tick.
+				log.Debug().Msgf("Couldn't store re-encrypted config %q with version %v, dirty write", cfg.Name, cfg.Version)
+				continue
+			}
+			return err
+		}
+
+		log.Info().Msgf("Re-encrypted clusters and tasks of config %q", cfg.Name)
+	}
+
+	return nil
+}
+
+func (s *Service) watchEncryption() {
+	for {
+		if err := s.WatchForUnencryptedDocuments(context.Background()); err != nil {
+			log.Err(err).Msg("Watch for unencrypted documents failed")
+		}
+
+		select {
+		case <-s.done:
+			log.Info().Msg("Exited worker loop running WatchForUnencryptedDocuments")
+			return
+		case <-time.After(EncryptionTick):
+		}
+	}
+}
@@ -3,8 +3,10 @@ package service
 import (
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/internal/certificates"
 	"github.com/berops/claudie/internal/hash"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
@@ -144,9 +146,9 @@ func resolveLbNodePoolReferences(
 				carryhash, idx := "", -1
 
 				for i, existing := range currentNodePools {
-					ep := existing.GetDynamicNodePool().GetProvider().GetTemplates()
-					rp := ref.GetDynamicNodePool().GetProvider().GetTemplates()
-					if proto.Equal(ep, rp) {
+					ep := existing.GetDynamicNodePool()
+					rp := ref.GetDynamicNodePool()
+					if proto.Equal(ep.GetProvider().GetTemplates(), rp.GetProvider().GetTemplates()) && sameInfrastructureSpec(ep, rp) {
 						_, hash := nodepools.MatchNameAndHashWithTemplate(nodepoolType, existing.Name)
 						carryhash, idx = hash, i
 						break
@@ -226,9 +228,10 @@ func resolveK8sNodePoolReferences(
 	// one of the current state nodepool, if one exists.
 	//
 	// What this means is that the matching should consider the templates
-	// of the nodepools for equality and should prefer to transfer nodepools
-	// that match the templates in the desired state if such nodepools exist
-	// in the current state, however.
+	// and the infrastructure spec (image, server type, disk size) of the
+	// nodepools for equality and should prefer to transfer nodepools that
+	// match the ones in the desired state if such nodepools exist in the
+	// current state, however.
 	//
 	// If nodepools in the current state do not match the templates of the
 	// desired state but there is still a reference in the desired state that
@@ -248,9 +251,9 @@ func resolveK8sNodePoolReferences(
 
 		// Try to find a reference with matching templates first.
 		for i, existing := range control {
-			ep := existing.GetDynamicNodePool().GetProvider().GetTemplates()
-			rp := ref.GetDynamicNodePool().GetProvider().GetTemplates()
-			if proto.Equal(ep, rp) {
+			ep := existing.GetDynamicNodePool()
+			rp := ref.GetDynamicNodePool()
+			if proto.Equal(ep.GetProvider().GetTemplates(), rp.GetProvider().GetTemplates()) && sameInfrastructureSpec(ep, rp) {
 				_, hash := nodepools.MatchNameAndHashWithTemplate(nodepoolType, existing.Name)
 				carryhash, idx = hash, i
 				break
@@ -278,9 +281,9 @@ func resolveK8sNodePoolReferences(
 		carryhash, idx := "", -1
 
 		for i, existing := range compute {
-			ep := existing.GetDynamicNodePool().GetProvider().GetTemplates()
-			rp := ref.GetDynamicNodePool().GetProvider().GetTemplates()
-			if proto.Equal(ep, rp) {
+			ep := existing.GetDynamicNodePool()
+			rp := ref.GetDynamicNodePool()
+			if proto.Equal(ep.GetProvider().GetTemplates(), rp.GetProvider().GetTemplates()) && sameInfrastructureSpec(ep, rp) {
 				_, hash := nodepools.MatchNameAndHashWithTemplate(nodepoolType, existing.Name)
 				carryhash, idx = hash, i
 				break
@@ -323,6 +326,7 @@ func transferK8sState(current, desired *spec.K8Scluster) {
 
 	// For now consider the network range for the VPN immutable as well, might change in the future.
 	desired.Network = current.Network
+	desired.NetworkIPv6 = current.NetworkIPv6
 }
 
 // transferDynamicNodePool transfers state that should be "Immutable" from the
@@ -541,28 +545,82 @@ func transferLBState(current, desired *spec.LoadBalancers) {
 
 			transferDns(current, desired)
 			transferClusterInfo(current.ClusterInfo, desired.ClusterInfo)
-			transferRoles(current.Roles, desired.Roles)
+			transferServiceLoadBalancer(current, desired)
+			transferRoles(current.Roles, desired.Roles, certificates.Names(desired), time.Now())
 			desired.UsedApiEndpoint = current.UsedApiEndpoint
+			// The virtual IP is immutable once the loadbalancer is built.
+			desired.VirtualIP = proto.CloneOf(current.VirtualIP)
 			break
 		}
 	}
 }
 
-func transferRoles(current, desired []*spec.Role) {
+func transferRoles(current, desired []*spec.Role, names []string, now time.Time) {
 	for _, current := range current {
 		for _, desired := range desired {
 			if current.Name == desired.Name {
 				desired.Settings.EnvoyAdminPort = current.Settings.EnvoyAdminPort
+				transferTLS(current.Settings.GetTls(), desired.Settings.GetTls(), names, now)
 			}
 		}
 	}
 }
 
+// transferServiceLoadBalancer transfers the token of the service controller and the ports allocated
+// for the services, if the loadbalancer is still designated for them, and adds the roles generated
+// for the ports. Ports that are no longer within the port range are dropped and are allocated again
+// on the next request of the services.
+func transferServiceLoadBalancer(current, desired *spec.LBcluster) {
+	if current.ServiceLoadBalancer == nil || desired.ServiceLoadBalancer == nil {
+		return
+	}
+
+	slb := desired.ServiceLoadBalancer
+	if current.ServiceLoadBalancer.Token != "" {
+		slb.Token = current.ServiceLoadBalancer.Token
+	}
+	for _, svc := range current.ServiceLoadBalancer.Services {
+		svc = proto.Clone(svc).(*spec.ServiceLoadBalancer_Service)
+		svc.Ports = slices.DeleteFunc(svc.Ports, func(p *spec.ServiceLoadBalancer_Port) bool {
+			return p.Port < slb.MinPort || p.Port > slb.MaxPort
+		})
+		if len(svc.Ports) > 0 {
+			slb.Services = append(slb.Services, svc)
+		}
+	}
+
+	desired.Roles = append(desired.Roles, slb.Roles()...)
+}
+
+// transferTLS transfers the obtained certificate and the ACME account, if they were obtained from
+// the same certificate authority. The certificate is not transferred if it needs to be renewed,
+// which results in a change of the role settings, during which the ansibler obtains a new one.
+func transferTLS(current, desired *spec.Role_Tls, names []string, now time.Time) {
+	if current == nil || desired == nil {
+		return
+	}
+
+	if current.Directory != desired.Directory || current.Email != desired.Email {
+		return
+	}
+
+	desired.AccountKey = current.AccountKey
+
+	if !certificates.NeedsRenewal(current, names, now) {
+		desired.Certificate = current.Certificate
+		desired.PrivateKey = current.PrivateKey
+	}
+}
+
 func transferDns(current, desired *spec.LBcluster) {
-	if current.Dns == nil {
+	if current.Dns == nil || desired.Dns == nil {
 		return
 	}
 
+	// The nodes are left out of the records until they're reachable
+	// again, regardless of any other changes to the DNS.
+	desired.Dns.UnreachableEndpoints = slices.Clone(current.Dns.UnreachableEndpoints)
+
 	if current.Dns.DnsZone != desired.Dns.DnsZone {
 		// DnsZones do not match nothing can be transferred.
 		return
@@ -5,6 +5,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/berops/claudie/internal/api/manifest"
 	"github.com/berops/claudie/internal/hash"
@@ -218,6 +219,28 @@ func Test_transferExistingDns(t *testing.T) {
 				assert.Empty(t, args.desired.Dns.AlternativeNames)
 			},
 		},
+		{
+			name: "unreachable-endpoints",
+			args: args{
+				current: &spec.LBcluster{
+					ClusterInfo: &spec.ClusterInfo{Name: "cluster-1"},
+					Dns: &spec.DNS{
+						DnsZone:              "zone-1",
+						Hostname:             "test-hostname",
+						Endpoint:             "test-endpoint",
+						UnreachableEndpoints: []string{"1.1.1.1"},
+					},
+				},
+				desired: &spec.LBcluster{
+					ClusterInfo: &spec.ClusterInfo{Name: "cluster-1"},
+					Dns:         &spec.DNS{DnsZone: "zone-2"},
+				},
+			},
+			validate: func(t *testing.T, args args) {
+				assert.Empty(t, args.desired.Dns.Endpoint)
+				assert.Equal(t, []string{"1.1.1.1"}, args.desired.Dns.UnreachableEndpoints)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -532,6 +555,42 @@ func Test_copyK8sNodePoolsNamesFromCurrentState(t *testing.T) {
 				assert.Equal(t, args.desired.ClusterInfo.NodePools[0].Name, "np-0")
 			},
 		},
+		{
+			name: "prefer-matching-infrastructure-spec",
+			args: args{
+				used:     map[string]struct{}{},
+				nodepool: "np0",
+				current: &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{
+					NodePools: []*spec.NodePool{
+						{
+							Type: &spec.NodePool_DynamicNodePool{
+								DynamicNodePool: &spec.DynamicNodePool{Image: "ubuntu-22.04"},
+							},
+							Name: fmt.Sprintf("np0-%s", hash.Create(hash.Length)),
+						},
+						{
+							Type: &spec.NodePool_DynamicNodePool{
+								DynamicNodePool: &spec.DynamicNodePool{Image: "ubuntu-24.04"},
+							},
+							Name: fmt.Sprintf("np0-%s", hash.Create(hash.Length)),
+						},
+					},
+				}},
+				desired: &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{
+					NodePools: []*spec.NodePool{
+						{
+							Type: &spec.NodePool_DynamicNodePool{
+								DynamicNodePool: &spec.DynamicNodePool{Image: "ubuntu-24.04"},
+							},
+							Name: "np0",
+						},
+					},
+				}},
+			},
+			validate: func(t *testing.T, args args) {
+				assert.Equal(t, args.current.ClusterInfo.NodePools[1].Name, args.desired.ClusterInfo.NodePools[0].Name)
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -965,7 +1024,7 @@ func Test_transferExistingRole(t *testing.T) {
 		},
 	}
 
-	transferRoles(current, desired)
+	transferRoles(current, desired, nil, time.Now())
 
 	assert.Equal(t, int32(2048), desired[0].Settings.EnvoyAdminPort)
 	assert.Equal(t, int32(1024), desired[3].Settings.EnvoyAdminPort)
@@ -973,3 +1032,147 @@ func Test_transferExistingRole(t *testing.T) {
 	assert.Equal(t, int32(-1), desired[1].Settings.EnvoyAdminPort)
 	assert.Equal(t, int32(-1), desired[2].Settings.EnvoyAdminPort)
 }
+
+func Test_transferTLS(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	names := []string{"api.example.com", "alt.example.com"}
+
+	valid, validKey := spectesting.GenerateFakeCertificate(names, now.Add(60*24*time.Hour))
+	expiring, expiringKey := spectesting.GenerateFakeCertificate(names, now.Add(10*24*time.Hour))
+
+	tests := []struct {
+		name    string
+		current *spec.Role_Tls
+		desired *spec.Role_Tls
+		names   []string
+		want    *spec.Role_Tls
+	}{
+		{
+			name:    "transfer-valid-certificate",
+			current: &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: valid, PrivateKey: validKey, AccountKey: "account"},
+			desired: &spec.Role_Tls{Email: "a@b.c", Directory: "d"},
+			names:   names,
+			want:    &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: valid, PrivateKey: validKey, AccountKey: "account"},
+		},
+		{
+			name:    "expiring-certificate",
+			current: &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: expiring, PrivateKey: expiringKey, AccountKey: "account"},
+			desired: &spec.Role_Tls{Email: "a@b.c", Directory: "d"},
+			names:   names,
+			want:    &spec.Role_Tls{Email: "a@b.c", Directory: "d", AccountKey: "account"},
+		},
+		{
+			name:    "names-changed",
+			current: &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: valid, PrivateKey: validKey, AccountKey: "account"},
+			desired: &spec.Role_Tls{Email: "a@b.c", Directory: "d"},
+			names:   []string{"api.example.com"},
+			want:    &spec.Role_Tls{Email: "a@b.c", Directory: "d", AccountKey: "account"},
+		},
+		{
+			name:    "directory-changed",
+			current: &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: valid, PrivateKey: validKey, AccountKey: "account"},
+			desired: &spec.Role_Tls{Email: "a@b.c", Directory: "e"},
+			names:   names,
+			want:    &spec.Role_Tls{Email: "a@b.c", Directory: "e"},
+		},
+		{
+			name:    "email-changed",
+			current: &spec.Role_Tls{Email: "a@b.c", Directory: "d", Certificate: valid, PrivateKey: validKey, AccountKey: "account"},
+			desired: &spec.Role_Tls{Email: "x@b.c", Directory: "d"},
+			names:   names,
+			want:    &spec.Role_Tls{Email: "x@b.c", Directory: "d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			transferTLS(tt.current, tt.desired, tt.names, now)
+			assert.True(t, proto.Equal(tt.want, tt.desired))
+		})
+	}
+}
+
+func Test_transferServiceLoadBalancer(t *testing.T) {
+	t.Parallel()
+
+	services := []*spec.ServiceLoadBalancer_Service{
+		{Namespace: "default", Name: "web", Ports: []*spec.ServiceLoadBalancer_Port{
+			{Name: "http", Protocol: "tcp", Port: 30080, NodePort: 31080},
+			{Name: "dns", Protocol: "udp", Port: 30053, NodePort: 31053},
+		}},
+		{Namespace: "default", Name: "db", Ports: []*spec.ServiceLoadBalancer_Port{
+			{Name: "sql", Protocol: "tcp", Port: 30432, NodePort: 31432},
+		}},
+	}
+
+	tests := []struct {
+		name      string
+		current   *spec.ServiceLoadBalancer
+		desired   *spec.ServiceLoadBalancer
+		wantRoles []string
+		wantPorts int
+	}{
+		{
+			name:      "transfer-services",
+			current:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30500, Services: services},
+			desired:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30500, TargetPools: []string{"compute"}},
+			wantRoles: []string{"ingress", "svc-30080", "svc-30053", "svc-30432"},
+			wantPorts: 3,
+		},
+		{
+			name:      "port-range-shrunk",
+			current:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30500, Services: services},
+			desired:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30100, TargetPools: []string{"compute"}},
+			wantRoles: []string{"ingress", "svc-30080", "svc-30053"},
+			wantPorts: 2,
+		},
+		{
+			name:      "no-longer-designated",
+			current:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30500, Services: services},
+			wantRoles: []string{"ingress"},
+		},
+		{
+			name:      "newly-designated",
+			desired:   &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30500, TargetPools: []string{"compute"}},
+			wantRoles: []string{"ingress"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := &spec.LBcluster{ServiceLoadBalancer: tt.current}
+			desired := &spec.LBcluster{
+				Roles:               []*spec.Role{{Name: "ingress", Settings: &spec.Role_Settings{}}},
+				ServiceLoadBalancer: tt.desired,
+			}
+
+			transferServiceLoadBalancer(current, desired)
+
+			var roles []string
+			for _, r := range desired.Roles {
+				roles = append(roles, r.Name)
+			}
+			assert.Equal(t, tt.wantRoles, roles)
+
+			var ports int
+			for _, svc := range desired.GetServiceLoadBalancer().GetServices() {
+				ports += len(svc.Ports)
+			}
+			assert.Equal(t, tt.wantPorts, ports)
+
+			for _, r := range desired.Roles[1:] {
+				assert.Equal(t, []string{"compute"}, r.TargetPools)
+				assert.Equal(t, int32(-1), r.Settings.EnvoyAdminPort)
+			}
+
+			// the current state is left untouched.
+			if tt.current != nil {
+				assert.Len(t, tt.current.Services, 2)
+				assert.Len(t, tt.current.Services[0].Ports, 2)
+			}
+		})
+	}
+}
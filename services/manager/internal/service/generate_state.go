@@ -27,7 +27,7 @@ const HostnameHashLength = 17
 // [HostnameHashLength]
 func PopulateDnsHostName(state *spec.Clusters) {
 	for _, lb := range state.GetLoadBalancers().GetClusters() {
-		if lb.Dns.Hostname == "" {
+		if lb.Dns != nil && lb.Dns.Hostname == "" {
 			lb.Dns.Hostname = hash.Create(HostnameHashLength)
 		}
 	}
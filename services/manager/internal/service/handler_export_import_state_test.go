@@ -0,0 +1,222 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	out bytes.Buffer
+}
+
+func (e *exportStream) Context() context.Context { return context.Background() }
+
+func (e *exportStream) Send(resp *pb.ExportStateResponse) error {
+	e.out.Write(resp.Chunk)
+	return nil
+}
+
+type importStream struct {
+	grpc.ServerStream
+	requests []*pb.ImportStateRequest
+	resp     *pb.ImportStateResponse
+}
+
+func (i *importStream) Context() context.Context { return context.Background() }
+
+func (i *importStream) Recv() (*pb.ImportStateRequest, error) {
+	if len(i.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := i.requests[0]
+	i.requests = i.requests[1:]
+	return req, nil
+}
+
+func (i *importStream) SendAndClose(resp *pb.ImportStateResponse) error {
+	i.resp = resp
+	return nil
+}
+
+func TestExportImportState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	source := &Service{store: store.NewInMemoryStore(), stateFiles: store.NewInMemoryStateFiles()}
+
+	cfg := &spec.Config{
+		Name: "config",
+		Manifest: &spec.Manifest{
+			Raw:                 "raw",
+			Checksum:            []byte("checksum"),
+			LastAppliedChecksum: []byte("checksum"),
+		},
+		Clusters: map[string]*spec.ClusterState{
+			"k8s-abc": {
+				Current: rollbackTestClusters("control"),
+				State:   &spec.Workflow{Status: spec.Workflow_IN_PROGRESS},
+				InFlight: &spec.TaskEvent{
+					Id:          "task",
+					Event:       spec.Event_UPDATE,
+					Description: "adding nodes",
+					Task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+						State: &spec.Update_State{K8S: rollbackTestClusters("control", "compute").K8S},
+						Delta: &spec.Update_None_{None: &spec.Update_None{}},
+					}}},
+				},
+			},
+		},
+	}
+
+	db, err := store.ConvertFromGRPC(cfg)
+	require.NoError(t, err)
+	require.NoError(t, source.store.CreateConfig(ctx, db))
+
+	require.NoError(t, source.stateFiles.Put(ctx, "config/k8s-abc", []byte("tfstate")))
+	require.NoError(t, source.stateFiles.Put(ctx, "config/k8s-abc.tflock", []byte("lock")))
+	require.NoError(t, source.stateFiles.Put(ctx, "unrelated/k8s-def", []byte("tfstate")))
+
+	export := new(exportStream)
+	require.NoError(t, source.ExportState(&pb.ExportStateRequest{Passphrase: "secret"}, export))
+	require.True(t, isEncryptedStateArchive(export.out.Bytes()))
+
+	chunks := func(passphrase string) []*pb.ImportStateRequest {
+		var out []*pb.ImportStateRequest
+		for i, b := range bytes.SplitAfter(export.out.Bytes(), []byte{0}) {
+			req := &pb.ImportStateRequest{Chunk: b}
+			if i == 0 {
+				req.Passphrase = passphrase
+			}
+			out = append(out, req)
+		}
+		return out
+	}
+
+	target := &Service{store: store.NewInMemoryStore(), stateFiles: store.NewInMemoryStateFiles()}
+
+	err = target.ImportState(&importStream{requests: chunks("wrong")})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	imported := &importStream{requests: chunks("secret")}
+	require.NoError(t, target.ImportState(imported))
+	assert.Equal(t, []string{"config"}, imported.resp.Configs)
+	assert.Equal(t, int32(1), imported.resp.StateFiles)
+
+	b, err := target.stateFiles.Get(ctx, "config/k8s-abc")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("tfstate"), b)
+
+	keys, err := target.stateFiles.List(ctx, "unrelated")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	restored, err := target.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	assert.Equal(t, db.Manifest.Checksum, restored.Manifest.LastAppliedChecksum)
+	assert.Equal(t, db.Clusters["k8s-abc"].Current, restored.Clusters["k8s-abc"].Current)
+	require.NotNil(t, restored.Clusters["k8s-abc"].InFlight)
+	assert.Equal(t, "task", restored.Clusters["k8s-abc"].InFlight.Id)
+	assert.Equal(t, spec.Workflow_ERROR.String(), restored.Clusters["k8s-abc"].State.Status)
+
+	err = target.ImportState(&importStream{requests: chunks("secret")})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+// failingStore fails the creation of the config with the given name.
+type failingStore struct {
+	store.Store
+	fail string
+}
+
+func (f *failingStore) CreateConfig(ctx context.Context, cfg *store.Config) error {
+	if cfg.Name == f.fail {
+		return errors.New("failed")
+	}
+	return f.Store.CreateConfig(ctx, cfg)
+}
+
+func TestImportStateRevert(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	archive := stateArchive{
+		configs:    []*store.Config{{Name: "a"}, {Name: "b"}},
+		stateFiles: map[string][]byte{"a/k8s": []byte("state"), "b/k8s": []byte("state")},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.write(&buf))
+
+	target := &Service{
+		store:      &failingStore{Store: store.NewInMemoryStore(), fail: "b"},
+		stateFiles: store.NewInMemoryStateFiles(),
+	}
+
+	err := target.ImportState(&importStream{requests: []*pb.ImportStateRequest{{Chunk: buf.Bytes()}}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	for _, cfg := range []string{"a", "b"} {
+		_, err := target.store.GetConfig(ctx, cfg)
+		assert.ErrorIs(t, err, store.ErrNotFoundOrDirty)
+
+		keys, err := target.stateFiles.List(ctx, cfg)
+		require.NoError(t, err)
+		assert.Empty(t, keys)
+	}
+}
+
+func TestImportStateMaxSize(t *testing.T) {
+	defer func(size int) { MaxStateArchiveSize = size }(MaxStateArchiveSize)
+	MaxStateArchiveSize = 8
+
+	target := &Service{store: store.NewInMemoryStore(), stateFiles: store.NewInMemoryStateFiles()}
+	err := target.ImportState(&importStream{requests: []*pb.ImportStateRequest{
+		{Chunk: []byte("chunk")},
+		{Chunk: []byte("chunk")},
+	}})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestStateArchive(t *testing.T) {
+	t.Parallel()
+
+	archive := stateArchive{
+		configs:    []*store.Config{{Name: "a", Version: 3}, {Name: "b"}},
+		stateFiles: map[string][]byte{"a/k8s": []byte("state"), "a/lb-dns": []byte("dns")},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.write(&buf))
+
+	got, err := readStateArchive(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, archive.configs, got.configs)
+	assert.Equal(t, archive.stateFiles, got.stateFiles)
+
+	encrypted, err := encryptStateArchive("passphrase", buf.Bytes())
+	require.NoError(t, err)
+
+	_, err = decryptStateArchive("other", encrypted)
+	assert.Error(t, err)
+
+	decrypted, err := decryptStateArchive("passphrase", encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, buf.Bytes(), decrypted)
+
+	_, err = readStateArchive(bytes.NewReader([]byte("not an archive")))
+	assert.Error(t, err)
+}
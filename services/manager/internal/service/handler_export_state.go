@@ -0,0 +1,71 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ExportState(request *pb.ExportStateRequest, stream grpc.ServerStreamingServer[pb.ExportStateResponse]) error {
+	ctx := stream.Context()
+
+	log.Info().Msgf("Exporting state")
+
+	cfgs, err := s.store.ListConfigs(ctx, nil)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list configs: %v", err)
+	}
+
+	archive := stateArchive{
+		configs:    cfgs,
+		stateFiles: make(map[string][]byte),
+	}
+
+	for _, cfg := range cfgs {
+		keys, err := s.stateFiles.List(ctx, cfg.Name)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list state files of config %q: %v", cfg.Name, err)
+		}
+
+		for _, key := range keys {
+			b, err := s.stateFiles.Get(ctx, key)
+			if err != nil {
+				if errors.Is(err, store.ErrStateFileNotFound) {
+					// deleted in the meantime, by the destruction of the infrastructure.
+					continue
+				}
+				return status.Errorf(codes.Internal, "failed to read state file %q of config %q: %v", key, cfg.Name, err)
+			}
+			archive.stateFiles[key] = b
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := archive.write(&buf); err != nil {
+		return status.Errorf(codes.Internal, "failed to create archive: %v", err)
+	}
+
+	out := buf.Bytes()
+	if request.Passphrase != "" {
+		if out, err = encryptStateArchive(request.Passphrase, out); err != nil {
+			return status.Errorf(codes.Internal, "failed to encrypt archive: %v", err)
+		}
+	}
+
+	for chunk := range slices.Chunk(out, stateArchiveChunkSize) {
+		if err := stream.Send(&pb.ExportStateResponse{Chunk: chunk}); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Msgf("Exported %v configs and %v state files", len(archive.configs), len(archive.stateFiles))
+	return nil
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/berops/claudie/internal/envs"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxStateArchiveSize is the maximum size of the archive, in bytes, accepted by
+// the ImportState rpc. The whole archive is held in memory during the import.
+var MaxStateArchiveSize = envs.GetOrDefaultInt("MANAGER_IMPORT_STATE_MAX_SIZE_MB", 512) << 20
+
+func (s *Service) ImportState(stream grpc.ClientStreamingServer[pb.ImportStateRequest, pb.ImportStateResponse]) error {
+	ctx := stream.Context()
+
+	var (
+		buf        bytes.Buffer
+		passphrase string
+		first      = true
+	)
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			passphrase = req.Passphrase
+			first = false
+		}
+		if buf.Len()+len(req.Chunk) > MaxStateArchiveSize {
+			return status.Errorf(codes.ResourceExhausted, "archive exceeds the maximum size of %v bytes", MaxStateArchiveSize)
+		}
+		buf.Write(req.Chunk)
+	}
+
+	log.Info().Msgf("Importing state")
+
+	b := buf.Bytes()
+	if isEncryptedStateArchive(b) {
+		if passphrase == "" {
+			return status.Errorf(codes.InvalidArgument, "archive is encrypted, missing passphrase")
+		}
+
+		var err error
+		if b, err = decryptStateArchive(passphrase, b); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+	}
+
+	archive, err := readStateArchive(bytes.NewReader(b))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	for key := range archive.stateFiles {
+		if !stateFileOfAny(key, archive.configs) {
+			return status.Errorf(codes.InvalidArgument, "state file %q does not belong to any config within the archive", key)
+		}
+	}
+
+	// Check all of the configs upfront, so that
+	// nothing is imported if any of them exists.
+	for _, cfg := range archive.configs {
+		_, err := s.store.GetConfig(ctx, cfg.Name)
+		if err == nil {
+			return status.Errorf(codes.AlreadyExists, "config %q already exists", cfg.Name)
+		}
+		if !errors.Is(err, store.ErrNotFoundOrDirty) {
+			return status.Errorf(codes.Internal, "failed to check existence of config %q: %v", cfg.Name, err)
+		}
+	}
+
+	// The state files are restored first, so that once the configs are
+	// picked up by the reconciliation loop, the infrastructure is not
+	// recreated. On failure, everything restored so far is removed again,
+	// so that the import can be retried.
+	var (
+		restoredFiles   []string
+		restoredConfigs []string
+	)
+
+	for key, b := range archive.stateFiles {
+		if err := s.stateFiles.Put(ctx, key, b); err != nil {
+			s.revertImport(ctx, restoredConfigs, restoredFiles)
+			return status.Errorf(codes.Internal, "failed to restore state file %q: %v", key, err)
+		}
+		restoredFiles = append(restoredFiles, key)
+	}
+
+	for _, cfg := range archive.configs {
+		interruptInFlight(cfg)
+
+		if err := s.store.CreateConfig(ctx, cfg); err != nil {
+			s.revertImport(ctx, restoredConfigs, restoredFiles)
+			return status.Errorf(codes.Internal, "failed to restore config %q: %v", cfg.Name, err)
+		}
+		restoredConfigs = append(restoredConfigs, cfg.Name)
+	}
+
+	resp := &pb.ImportStateResponse{
+		Configs:    restoredConfigs,
+		StateFiles: int32(len(restoredFiles)),
+	}
+
+	log.Info().Msgf("Imported %v configs and %v state files", len(resp.Configs), resp.StateFiles)
+	return stream.SendAndClose(resp)
+}
+
+// revertImport deletes the configs and state files restored by a failed import. Whatever
+// could not be deleted is logged, and has to be removed manually before the import is retried,
+// the configs via the database and the state files via the MinIO bucket.
+func (s *Service) revertImport(ctx context.Context, configs, stateFiles []string) {
+	// The import may have failed due to the cancelled stream, proceed regardless.
+	ctx = context.WithoutCancel(ctx)
+
+	for _, name := range configs {
+		// Newly created configs always start with a version of 0.
+		if err := s.store.DeleteConfig(ctx, name, 0); err != nil {
+			log.Err(err).Msgf("Failed to delete config %q of the failed import, needs to be deleted manually", name)
+		}
+	}
+
+	for _, key := range stateFiles {
+		if err := s.stateFiles.Delete(ctx, key); err != nil {
+			log.Err(err).Msgf("Failed to delete state file %q of the failed import, needs to be deleted manually", key)
+		}
+	}
+}
+
+func stateFileOfAny(key string, cfgs []*store.Config) bool {
+	for _, cfg := range cfgs {
+		if strings.HasPrefix(key, cfg.Name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// interruptInFlight marks the tasks that were being worked on at the time of
+// the export as failed. The messages for them were lost along with the message
+// queue, which makes the reconciliation loop treat them as any other failed task.
+func interruptInFlight(cfg *store.Config) {
+	for _, state := range cfg.Clusters {
+		if state.InFlight == nil {
+			continue
+		}
+
+		switch state.State.Status {
+		case spec.Workflow_WAIT_FOR_PICKUP.String(), spec.Workflow_IN_PROGRESS.String():
+			state.State.Status = spec.Workflow_ERROR.String()
+			state.State.Description = fmt.Sprintf("Task %q was interrupted by the export of the state", state.InFlight.Description)
+		}
+	}
+}
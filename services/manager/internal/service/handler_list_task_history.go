@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// defaultHistoryPageSize is the number of entries returned if no page size was requested.
+	defaultHistoryPageSize = 100
+
+	// maxHistoryPageSize is the maximum number of entries returned in a single page.
+	maxHistoryPageSize = 1000
+)
+
+func (s *Service) ListTaskHistory(ctx context.Context, request *pb.ListTaskHistoryRequest) (*pb.ListTaskHistoryResponse, error) {
+	log.Debug().Msgf("Received request for task history of config: %q", request.Config)
+
+	if request.Config == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of config")
+	}
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be negative")
+	}
+
+	var offset int64
+	if request.PageToken != "" {
+		o, err := strconv.ParseInt(request.PageToken, 10, 64)
+		if err != nil || o < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", request.PageToken)
+		}
+		offset = o
+	}
+
+	pageSize := int64(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultHistoryPageSize
+	}
+	pageSize = min(pageSize, maxHistoryPageSize)
+
+	filter := store.HistoryFilter{
+		Config:  request.Config,
+		Cluster: request.GetCluster(),
+		Offset:  offset,
+		// query one more entry to determine if there is a next page.
+		Limit: pageSize + 1,
+	}
+	if request.Since != nil {
+		filter.Since = request.Since.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	entries, err := s.store.ListHistory(ctx, &filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list task history for config %q: %v", request.Config, err)
+	}
+
+	resp := &pb.ListTaskHistoryResponse{}
+	if int64(len(entries)) > pageSize {
+		entries = entries[:pageSize]
+		resp.NextPageToken = strconv.FormatInt(offset+pageSize, 10)
+	}
+
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, store.ConvertToGRPCHistoryEntry(e))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTaskHistory(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{store: store.NewInMemoryStore()}
+	ctx := context.Background()
+
+	for i, cluster := range []string{"a", "b", "a", "a", "b"} {
+		err := s.store.AppendHistory(ctx, &store.HistoryEntry{
+			Config:    "config",
+			Cluster:   cluster,
+			TaskId:    string(rune('0' + i)),
+			Kind:      spec.TaskHistoryEntry_TASK_SCHEDULED.String(),
+			Timestamp: time.Date(2024, 6, 15, 12, i, 0, 0, time.UTC).Format(time.RFC3339),
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, s.store.AppendHistory(ctx, &store.HistoryEntry{Config: "other", Cluster: "a", TaskId: "x"}))
+
+	var ids []string
+	req := &pb.ListTaskHistoryRequest{Config: "config", PageSize: 2}
+	for {
+		resp, err := s.ListTaskHistory(ctx, req)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(resp.Entries), 2)
+		for _, e := range resp.Entries {
+			ids = append(ids, e.TaskId)
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, ids)
+
+	cluster := "a"
+	resp, err := s.ListTaskHistory(ctx, &pb.ListTaskHistoryRequest{Config: "config", Cluster: &cluster})
+	require.NoError(t, err)
+	assert.Len(t, resp.Entries, 3)
+	assert.Empty(t, resp.NextPageToken)
+
+	_, err = s.ListTaskHistory(ctx, &pb.ListTaskHistoryRequest{Config: "config", PageToken: "invalid"})
+	assert.Error(t, err)
+
+	_, err = s.ListTaskHistory(ctx, &pb.ListTaskHistoryRequest{})
+	assert.Error(t, err)
+}
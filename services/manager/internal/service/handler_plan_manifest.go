@@ -0,0 +1,205 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/berops/claudie/internal/clusters"
+	"github.com/berops/claudie/internal/hash"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+)
+
+func (s *Service) PlanManifest(ctx context.Context, request *pb.PlanManifestRequest) (*pb.PlanManifestResponse, error) {
+	log.Debug().Msgf("Received request to plan config: %q", request.Name)
+
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of config")
+	}
+	if request.Manifest != nil && request.Manifest.Raw == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot plan manifest with empty string")
+	}
+
+	var pending *spec.Config
+
+	cfg, err := s.store.GetConfig(ctx, request.Name)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(codes.Internal, "failed to check existence for config %q: %v", request.Name, err)
+		}
+		if request.Manifest == nil {
+			return nil, status.Errorf(codes.NotFound, "no config with name %q found", request.Name)
+		}
+		pending = &spec.Config{
+			Name:     request.Name,
+			Manifest: &spec.Manifest{},
+		}
+	} else {
+		pending, err = store.ConvertToGRPC(cfg)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert database representation for config %q to grpc: %v", request.Name, err)
+		}
+	}
+
+	if request.Manifest != nil {
+		pending.Manifest.Raw = request.Manifest.Raw
+		pending.Manifest.Checksum = hash.Digest(request.Manifest.Raw)
+		pending.Manifest.State = spec.Manifest_Pending
+	}
+
+	var desiredState map[string]*spec.Clusters
+	if err := createDesiredState(pending, &desiredState); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create desired state for config %q: %v", request.Name, err)
+	}
+	keepRolledBack(pending, desiredState)
+
+	// keep a copy of the state before the reconciliation, as clusters
+	// that are already being worked on are reported as they are.
+	stored := proto.Clone(pending).(*spec.Config)
+
+	reconciliate(ctx, pending, desiredState, reconciliateOpts{dryRun: true})
+
+	resp := &pb.PlanManifestResponse{Name: pending.Name}
+
+	for cluster, state := range pending.Clusters {
+		plan := &pb.PlanManifestResponse_ClusterPlan{Cluster: cluster}
+
+		inFlight := state.InFlight
+		if prev := stored.Clusters[cluster]; prev.GetInFlight() != nil {
+			switch prev.GetState().GetStatus() {
+			case spec.Workflow_IN_PROGRESS, spec.Workflow_WAIT_FOR_PICKUP:
+				plan.InProgress = true
+				inFlight = prev.InFlight
+			}
+		}
+
+		if !plan.InProgress {
+			switch state.GetState().GetStatus() {
+			case spec.Workflow_ERROR:
+				plan.Error = state.State.Description
+			case spec.Workflow_PAUSED:
+				// nothing will be scheduled for a paused cluster.
+				plan.Error = state.State.Description
+				inFlight = nil
+			}
+		}
+
+		for te := inFlight; te != nil; te = te.LowerPriority {
+			plan.Tasks = append(plan.Tasks, &pb.PlanManifestResponse_PlannedTask{
+				Id:          te.Id,
+				Event:       te.Event,
+				Description: te.Description,
+				Pipeline:    te.Pipeline,
+				Affected:    affectedNodePools(te.Task),
+			})
+		}
+
+		resp.Clusters = append(resp.Clusters, plan)
+	}
+
+	slices.SortFunc(resp.Clusters, func(a, b *pb.PlanManifestResponse_ClusterPlan) int {
+		return cmp.Compare(a.Cluster, b.Cluster)
+	})
+
+	return resp, nil
+}
+
+// affectedNodePools returns the nodepools and its nodes that will be touched by the task.
+func affectedNodePools(task *spec.Task) []*pb.PlanManifestResponse_AffectedNodePool {
+	var affected []*pb.PlanManifestResponse_AffectedNodePool
+
+	whole := func(cluster string, nps []*spec.NodePool) {
+		for _, np := range nps {
+			a := &pb.PlanManifestResponse_AffectedNodePool{Cluster: cluster, Nodepool: np.Name}
+			for _, n := range np.Nodes {
+				a.Nodes = append(a.Nodes, n.Name)
+			}
+			affected = append(affected, a)
+		}
+	}
+
+	partial := func(cluster, np string, nodes ...string) {
+		affected = append(affected, &pb.PlanManifestResponse_AffectedNodePool{
+			Cluster:  cluster,
+			Nodepool: np,
+			Nodes:    nodes,
+		})
+	}
+
+	names := func(nodes []*spec.Node) []string {
+		var out []string
+		for _, n := range nodes {
+			out = append(out, n.Name)
+		}
+		return out
+	}
+
+	switch do := task.GetDo().(type) {
+	case *spec.Task_Create:
+		whole(do.Create.GetK8S().GetClusterInfo().Id(), do.Create.GetK8S().GetClusterInfo().GetNodePools())
+		for _, lb := range do.Create.GetLoadBalancers() {
+			whole(lb.GetClusterInfo().Id(), lb.GetClusterInfo().GetNodePools())
+		}
+	case *spec.Task_Delete:
+		whole(do.Delete.GetK8S().GetClusterInfo().Id(), do.Delete.GetK8S().GetClusterInfo().GetNodePools())
+		for _, lb := range do.Delete.GetLoadBalancers() {
+			whole(lb.GetClusterInfo().Id(), lb.GetClusterInfo().GetNodePools())
+		}
+	case *spec.Task_Update:
+		var (
+			u   = do.Update
+			k8s = u.GetState().GetK8S().GetClusterInfo().Id()
+			lbs = u.GetState().GetLoadBalancers()
+		)
+
+		switch {
+		case u.GetTfAddK8SNodes() != nil:
+			d := u.GetTfAddK8SNodes()
+			if e := d.GetExisting(); e != nil {
+				partial(k8s, e.Nodepool, names(e.Nodes)...)
+			}
+			if n := d.GetNew(); n != nil {
+				whole(k8s, []*spec.NodePool{n.Nodepool})
+			}
+		case u.GetKDeleteNodes() != nil:
+			d := u.GetKDeleteNodes()
+			partial(k8s, d.Nodepool, d.Nodes...)
+		case u.GetTfMoveNodePoolToAutoscaled() != nil:
+			partial(k8s, u.GetTfMoveNodePoolToAutoscaled().Nodepool)
+		case u.GetTfMoveNodePoolFromAutoscaled() != nil:
+			partial(k8s, u.GetTfMoveNodePoolFromAutoscaled().Nodepool)
+		case u.GetK8SApiEndpoint() != nil:
+			d := u.GetK8SApiEndpoint()
+			partial(k8s, d.Nodepool, d.Node)
+		case u.GetTfAddLoadBalancer() != nil:
+			lb := u.GetTfAddLoadBalancer().Handle
+			whole(lb.GetClusterInfo().Id(), lb.GetClusterInfo().GetNodePools())
+		case u.GetTfAddLoadBalancerNodes() != nil:
+			d := u.GetTfAddLoadBalancerNodes()
+			if e := d.GetExisting(); e != nil {
+				partial(d.Handle, e.Nodepool, names(e.Nodes)...)
+			}
+			if n := d.GetNew(); n != nil {
+				whole(d.Handle, []*spec.NodePool{n.Nodepool})
+			}
+		case u.GetTfDeleteLoadBalancerNodes() != nil:
+			d := u.GetTfDeleteLoadBalancerNodes()
+			partial(d.Handle, d.Nodepool, d.Nodes...)
+		case u.GetDeleteLoadBalancer() != nil:
+			handle := u.GetDeleteLoadBalancer().Handle
+			if idx := clusters.IndexLoadbalancerById(handle, lbs); idx >= 0 {
+				whole(handle, lbs[idx].GetClusterInfo().GetNodePools())
+			}
+		}
+	}
+
+	return affected
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestAffectedNodePools(t *testing.T) {
+	t.Parallel()
+
+	k8s := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "k8s",
+			Hash: "abc",
+			NodePools: []*spec.NodePool{
+				{Name: "control", Nodes: []*spec.Node{{Name: "control-1"}, {Name: "control-2"}}},
+				{Name: "compute", Nodes: []*spec.Node{{Name: "compute-1"}}},
+			},
+		},
+	}
+
+	lb := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "lb",
+			Hash: "def",
+			NodePools: []*spec.NodePool{
+				{Name: "lb-pool", Nodes: []*spec.Node{{Name: "lb-1"}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		task *spec.Task
+		want []*pb.PlanManifestResponse_AffectedNodePool
+	}{
+		{
+			name: "create-cluster",
+			task: &spec.Task{Do: &spec.Task_Create{Create: &spec.Create{K8S: k8s, LoadBalancers: []*spec.LBcluster{lb}}}},
+			want: []*pb.PlanManifestResponse_AffectedNodePool{
+				{Cluster: "k8s-abc", Nodepool: "control", Nodes: []string{"control-1", "control-2"}},
+				{Cluster: "k8s-abc", Nodepool: "compute", Nodes: []string{"compute-1"}},
+				{Cluster: "lb-def", Nodepool: "lb-pool", Nodes: []string{"lb-1"}},
+			},
+		},
+		{
+			name: "delete-k8s-nodes",
+			task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+				State: &spec.Update_State{K8S: k8s},
+				Delta: &spec.Update_KDeleteNodes{KDeleteNodes: &spec.Update_KuberDeleteK8SNodes{
+					Nodepool: "compute",
+					Nodes:    []string{"compute-1"},
+				}},
+			}}},
+			want: []*pb.PlanManifestResponse_AffectedNodePool{
+				{Cluster: "k8s-abc", Nodepool: "compute", Nodes: []string{"compute-1"}},
+			},
+		},
+		{
+			name: "delete-loadbalancer",
+			task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+				State: &spec.Update_State{K8S: k8s, LoadBalancers: []*spec.LBcluster{lb}},
+				Delta: &spec.Update_DeleteLoadBalancer_{DeleteLoadBalancer: &spec.Update_DeleteLoadBalancer{
+					Handle: "lb-def",
+				}},
+			}}},
+			want: []*pb.PlanManifestResponse_AffectedNodePool{
+				{Cluster: "lb-def", Nodepool: "lb-pool", Nodes: []string{"lb-1"}},
+			},
+		},
+		{
+			name: "no-nodepools-affected",
+			task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+				State: &spec.Update_State{K8S: k8s},
+				Delta: &spec.Update_None_{None: &spec.Update_None{}},
+			}}},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := affectedNodePools(tt.task)
+			assert.Empty(t, cmp.Diff(tt.want, got, protocmp.Transform()))
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/internal/loggerutils"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) RollbackCluster(ctx context.Context, request *pb.RollbackClusterRequest) (*pb.RollbackClusterResponse, error) {
+	if request.Config == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of config")
+	}
+	if request.Cluster == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of cluster")
+	}
+
+	log.Debug().Msgf("Received request to rollback cluster %q within config %q", request.Cluster, request.Config)
+
+	cfg, err := s.store.GetConfig(ctx, request.Config)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(codes.Internal, "failed to check existence of config %q: %v", request.Config, err)
+		}
+		return nil, status.Errorf(codes.NotFound, "no config with name %q exists", request.Config)
+	}
+
+	cs, ok := cfg.Clusters[request.Cluster]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no cluster %q found within config %q", request.Cluster, request.Config)
+	}
+
+	if cfg.Manifest.State == manifest.Pending.String() {
+		return nil, status.Errorf(codes.FailedPrecondition, "config %q has pending changes that were not yet scheduled, try again later", request.Config)
+	}
+
+	switch cs.State.Status {
+	case spec.Workflow_WAIT_FOR_PICKUP.String(), spec.Workflow_IN_PROGRESS.String():
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has on going changes, try again later", request.Cluster)
+	}
+
+	if cs.InFlight == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has no failed task to be rolled back", request.Cluster)
+	}
+
+	state, err := store.ConvertToGRPCClusterState(cs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert cluster state database representation to grpc: %v", err)
+	}
+
+	if state.InFlight.Task.GetUpdate() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "only failed update tasks can be rolled back, task %q is not an update", state.InFlight.Id)
+	}
+
+	if shouldRescheduleInFlight(state.InFlight) {
+		return nil, status.Errorf(codes.FailedPrecondition, "changes made by task %q cannot be rolled back, the task needs to be retried", state.InFlight.Id)
+	}
+
+	logger := loggerutils.WithProjectAndCluster(request.Config, request.Cluster)
+	resp := new(pb.RollbackClusterResponse)
+	failed := state.InFlight.Id
+
+	// The rollback is scheduled by the same path the reconciliation loop takes
+	// for a failed task that is not rescheduled, only with the current state as
+	// the desired state, instead of the one from the manifest. The failed task is
+	// replaced by the task that reconciliates its state back to the current state,
+	// unless nodes with unknown status have to be handled first, in which case it
+	// is kept as the lower priority task, same as within the loop.
+	//
+	// The cluster is then kept at the rolled back state by the reconciliation loop
+	// until the manifest changes, see [keepRolledBack].
+	state.Rollback = &spec.Rollback{
+		TaskId:   failed,
+		Checksum: cfg.Manifest.Checksum,
+	}
+	rollback := &spec.Config{
+		Name:     cfg.Name,
+		Clusters: map[string]*spec.ClusterState{request.Cluster: state},
+	}
+	desired := map[string]*spec.Clusters{
+		request.Cluster: proto.Clone(state.Current).(*spec.Clusters),
+	}
+	reconciliate(ctx, rollback, desired, reconciliateOpts{outsideLoop: true})
+
+	switch {
+	case state.InFlight == nil:
+		logger.Info().Msgf("Nothing left to rollback, discarding failed task %q", failed)
+
+		state.State.Status = spec.Workflow_DONE
+		state.State.Description = ""
+	case state.State.GetStatus() == spec.Workflow_WAIT_FOR_PICKUP:
+		if state.InFlight.LowerPriority == nil {
+			state.InFlight.Description = fmt.Sprintf("Rollback: %s", state.InFlight.Description)
+		}
+		logger.Info().Msgf("Scheduling task %q to rollback failed task %q", state.InFlight.Id, failed)
+
+		resp.TaskId = state.InFlight.Id
+		resp.Description = state.InFlight.Description
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to schedule rollback of task %q: %s", failed, state.State.GetDescription())
+	}
+
+	db, err := store.ConvertFromGRPCClusterState(state)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert grpc representation to database: %v", err)
+	}
+	cfg.Clusters[request.Cluster] = db
+
+	if resp.TaskId != "" {
+		ok, err := manifest.ValidStateTransitionString(cfg.Manifest.State, manifest.Scheduled)
+		if err != nil || !ok {
+			return nil, status.Errorf(codes.Internal, "cannot transition config %q from manifest state %q to %q", request.Config, cfg.Manifest.State, manifest.Scheduled)
+		}
+
+		cfg.Manifest.State = manifest.Scheduled.String()
+		cfg.Manifest.StateTimestamp = time.Now().UTC().Format(time.RFC3339)
+	}
+
+	if err := s.store.UpdateConfig(ctx, cfg); err != nil {
+		if errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(
+				codes.Aborted,
+				"couldn't update config %q with version %v, dirty write", request.Config, cfg.Version,
+			)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update config %q: %v", request.Config, err)
+	}
+
+	if resp.TaskId != "" {
+		recordHistory(ctx, logger, s.store, &store.HistoryEntry{
+			Config:      cfg.Name,
+			Cluster:     request.Cluster,
+			TaskId:      db.InFlight.Id,
+			Kind:        spec.TaskHistoryEntry_TASK_SCHEDULED.String(),
+			Timestamp:   time.Now().UTC().Format(time.RFC3339),
+			Type:        db.InFlight.Type,
+			Description: db.InFlight.Description,
+		})
+	}
+
+	return resp, nil
+}
+
+// keepRolledBack replaces the desired state of the clusters for which a failed task was
+// rolled back with their current state, until the manifest changes, as reconciling the
+// same manifest would only schedule the rolled back changes again. Once the manifest
+// changes the rollback is cleared and the cluster is reconciled with the manifest again.
+func keepRolledBack(pending *spec.Config, desired map[string]*spec.Clusters) {
+	for cluster, state := range pending.Clusters {
+		rb := state.GetRollback()
+		if rb == nil {
+			continue
+		}
+
+		if !bytes.Equal(rb.Checksum, pending.GetManifest().GetChecksum()) {
+			state.Rollback = nil
+			continue
+		}
+
+		if _, ok := desired[cluster]; ok && state.Current != nil {
+			desired[cluster] = proto.Clone(state.Current).(*spec.Clusters)
+		}
+	}
+}
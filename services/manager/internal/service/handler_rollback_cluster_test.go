@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func rollbackTestClusters(nps ...string) *spec.Clusters {
+	k8s := &spec.K8Scluster{
+		ClusterInfo:       &spec.ClusterInfo{Name: "k8s", Hash: "abc"},
+		Kubernetes:        "v1.31.0",
+		InstallationProxy: &spec.InstallationProxy{Mode: ProxyOffMode},
+	}
+	for _, np := range nps {
+		provider := awsProvider("a", "b")
+		provider.Templates = &spec.TemplateRepository{CommitHash: "hash"}
+		nodepool := dynamicNodePool(provider)
+		nodepool.Name = np
+		nodepool.Nodes = []*spec.Node{{Name: np + "-1", Public: "10.0.0.1"}}
+		k8s.ClusterInfo.NodePools = append(k8s.ClusterInfo.NodePools, nodepool)
+	}
+	return &spec.Clusters{K8S: k8s, LoadBalancers: &spec.LoadBalancers{}}
+}
+
+func TestRollbackCluster(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{store: store.NewInMemoryStore()}
+	ctx := context.Background()
+
+	current := rollbackTestClusters("control")
+	inFlight := rollbackTestClusters("control", "compute")
+
+	cfg := &spec.Config{
+		Name: "config",
+		Manifest: &spec.Manifest{
+			Raw:      "raw",
+			Checksum: []byte("checksum"),
+			State:    spec.Manifest_Error,
+		},
+		Clusters: map[string]*spec.ClusterState{
+			"k8s-abc": {
+				Current: current,
+				State:   &spec.Workflow{Status: spec.Workflow_ERROR, Description: "failed"},
+				InFlight: &spec.TaskEvent{
+					Id:          "failed",
+					Event:       spec.Event_UPDATE,
+					Description: "adding nodes",
+					Task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+						State: &spec.Update_State{K8S: inFlight.K8S},
+						Delta: &spec.Update_None_{None: &spec.Update_None{}},
+					}}},
+				},
+			},
+		},
+	}
+
+	db, err := store.ConvertFromGRPC(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.store.CreateConfig(ctx, db))
+
+	db.Manifest.State = manifest.Error.String()
+	require.NoError(t, s.store.UpdateConfig(ctx, db))
+
+	_, err = s.RollbackCluster(ctx, &pb.RollbackClusterRequest{Config: "config", Cluster: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := s.RollbackCluster(ctx, &pb.RollbackClusterRequest{Config: "config", Cluster: "k8s-abc"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.TaskId)
+
+	stored, err := s.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	assert.Equal(t, manifest.Scheduled.String(), stored.Manifest.State)
+	assert.Nil(t, stored.Manifest.LastAppliedChecksum)
+
+	state := stored.Clusters["k8s-abc"]
+	assert.Equal(t, &store.Rollback{TaskId: "failed", Checksum: []byte("checksum")}, state.Rollback)
+	assert.Equal(t, spec.Workflow_WAIT_FOR_PICKUP.String(), state.State.Status)
+	assert.Equal(t, resp.TaskId, state.InFlight.Id)
+	assert.Nil(t, state.InFlight.LowerPriority)
+	assert.Contains(t, resp.Description, "Rollback")
+
+	task, err := store.ConvertToGRPCTask(state.InFlight.Task)
+	require.NoError(t, err)
+
+	affected := affectedNodePools(task)
+	require.Len(t, affected, 1)
+	assert.Equal(t, "compute", affected[0].Nodepool)
+
+	_, err = s.RollbackCluster(ctx, &pb.RollbackClusterRequest{Config: "config", Cluster: "k8s-abc"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	history, err := s.store.ListHistory(ctx, &store.HistoryFilter{Config: "config"})
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, resp.TaskId, history[0].TaskId)
+}
+
+func TestKeepRolledBack(t *testing.T) {
+	t.Parallel()
+
+	current := rollbackTestClusters("control")
+	rollback := &spec.Rollback{TaskId: "failed", Checksum: []byte("checksum")}
+
+	pending := &spec.Config{
+		Manifest: &spec.Manifest{Checksum: []byte("checksum")},
+		Clusters: map[string]*spec.ClusterState{
+			"k8s-abc": {Current: current, Rollback: rollback},
+		},
+	}
+	desired := map[string]*spec.Clusters{"k8s-abc": rollbackTestClusters("control", "compute")}
+
+	keepRolledBack(pending, desired)
+	assert.True(t, proto.Equal(current, desired["k8s-abc"]))
+	assert.Equal(t, rollback, pending.Clusters["k8s-abc"].Rollback)
+
+	pending.Manifest.Checksum = []byte("changed")
+	desired = map[string]*spec.Clusters{"k8s-abc": rollbackTestClusters("control", "compute")}
+
+	keepRolledBack(pending, desired)
+	assert.Len(t, desired["k8s-abc"].K8S.ClusterInfo.NodePools, 2)
+	assert.Nil(t, pending.Clusters["k8s-abc"].Rollback)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) SetClusterPaused(ctx context.Context, request *pb.SetClusterPausedRequest) (*pb.SetClusterPausedResponse, error) {
+	if request.Config == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of config")
+	}
+	if request.Cluster == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of cluster")
+	}
+
+	log.Debug().Msgf("Setting paused to %v for cluster %q within config %q", request.Paused, request.Cluster, request.Config)
+
+	cfg, err := s.store.GetConfig(ctx, request.Config)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(codes.Internal, "failed to check existence of config %q: %v", request.Config, err)
+		}
+		return nil, status.Errorf(codes.NotFound, "no config with name %q exists", request.Config)
+	}
+
+	cs, ok := cfg.Clusters[request.Cluster]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no cluster %q found within config %q", request.Cluster, request.Config)
+	}
+
+	if cs.Paused == request.Paused {
+		return &pb.SetClusterPausedResponse{}, nil
+	}
+
+	cs.Paused = request.Paused
+
+	// The PAUSED state is set by the reconciliation loop once any on
+	// going task is finished. On resume the state is cleared right away
+	// so that the cluster is reconciliated again on the next iteration.
+	if !request.Paused && cs.State.Status == spec.Workflow_PAUSED.String() {
+		cs.State.Status = spec.Workflow_DONE.String()
+		cs.State.Description = ""
+	}
+
+	if err := s.store.UpdateConfig(ctx, cfg); err != nil {
+		if errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(
+				codes.Aborted,
+				"couldn't update config %q with version %v, dirty write", request.Config, cfg.Version,
+			)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update config %q: %v", request.Config, err)
+	}
+
+	return &pb.SetClusterPausedResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetClusterPaused(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{store: store.NewInMemoryStore()}
+	ctx := context.Background()
+
+	cfg := &spec.Config{
+		Name: "config",
+		Manifest: &spec.Manifest{
+			Raw:      "raw",
+			Checksum: []byte("checksum"),
+		},
+		Clusters: map[string]*spec.ClusterState{
+			"k8s-abc": {
+				Current: rollbackTestClusters("control"),
+				State:   &spec.Workflow{Status: spec.Workflow_DONE},
+			},
+		},
+	}
+
+	db, err := store.ConvertFromGRPC(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.store.CreateConfig(ctx, db))
+
+	_, err = s.SetClusterPaused(ctx, &pb.SetClusterPausedRequest{Config: "config"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.SetClusterPaused(ctx, &pb.SetClusterPausedRequest{Config: "config", Cluster: "missing", Paused: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.SetClusterPaused(ctx, &pb.SetClusterPausedRequest{Config: "config", Cluster: "k8s-abc", Paused: true})
+	require.NoError(t, err)
+
+	stored, err := s.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	assert.True(t, stored.Clusters["k8s-abc"].Paused)
+
+	// simulate the reconciliation loop picking up the pause.
+	stored.Clusters["k8s-abc"].State.Status = spec.Workflow_PAUSED.String()
+	stored.Clusters["k8s-abc"].State.Description = "paused"
+	require.NoError(t, s.store.UpdateConfig(ctx, stored))
+
+	_, err = s.SetClusterPaused(ctx, &pb.SetClusterPausedRequest{Config: "config", Cluster: "k8s-abc", Paused: false})
+	require.NoError(t, err)
+
+	stored, err = s.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	assert.False(t, stored.Clusters["k8s-abc"].Paused)
+	assert.Equal(t, spec.Workflow_DONE.String(), stored.Clusters["k8s-abc"].State.Status)
+	assert.Empty(t, stored.Clusters["k8s-abc"].State.Description)
+}
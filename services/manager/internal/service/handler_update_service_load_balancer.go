@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/internal/nodepools"
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog/log"
+	"go.yaml.in/yaml/v3"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Service) UpdateServiceLoadBalancer(ctx context.Context, request *pb.UpdateServiceLoadBalancerRequest) (*pb.UpdateServiceLoadBalancerResponse, error) {
+	if request.Config == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of config")
+	}
+	if request.Cluster == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing name of cluster")
+	}
+	if request.Namespace == "" || request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "missing namespace or name of the service")
+	}
+
+	names := make(map[string]struct{}, len(request.Ports))
+	for _, p := range request.Ports {
+		if _, ok := names[p.Name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "port %q specified more than once", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		p.Protocol = strings.ToLower(p.Protocol)
+		if p.Protocol != "tcp" && p.Protocol != "udp" {
+			return nil, status.Errorf(codes.InvalidArgument, "port %q uses unsupported protocol %q", p.Name, p.Protocol)
+		}
+		if p.NodePort <= 0 || p.NodePort > 65535 {
+			return nil, status.Errorf(codes.InvalidArgument, "port %q has invalid node port %v", p.Name, p.NodePort)
+		}
+	}
+
+	log.
+		Debug().
+		Msgf("Updating ports of service %s/%s of cluster %q within config %q", request.Namespace, request.Name, request.Cluster, request.Config)
+
+	cfg, err := s.store.GetConfig(ctx, request.Config)
+	if err != nil {
+		if !errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(
+				codes.Internal,
+				"failed to check existence of config %q: %v", request.Config, err,
+			)
+		}
+		return nil, status.Errorf(codes.NotFound, "no config with name %q exists", request.Config)
+	}
+
+	cs := cfg.Clusters[request.Cluster]
+	if !cs.Exists() {
+		return nil, status.Errorf(codes.NotFound, "no cluster %q found within config %q", request.Cluster, request.Config)
+	}
+
+	// The port range and the target pools are always read from the
+	// manifest, as the current state only holds the allocated ports.
+	var m manifest.Manifest
+	if err := yaml.Unmarshal([]byte(cfg.Manifest.Raw), &m); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal manifest of config %q: %v", request.Config, err)
+	}
+
+	idx := slices.IndexFunc(m.LoadBalancer.Clusters, func(lb manifest.LoadBalancerCluster) bool {
+		return lb.TargetedK8s == request.Cluster && lb.ServiceLoadBalancer != nil
+	})
+	if idx < 0 {
+		return nil, status.Errorf(
+			codes.FailedPrecondition,
+			"no loadbalancer is designated for the services of cluster %q, config %q", request.Cluster, request.Config,
+		)
+	}
+	designated := &m.LoadBalancer.Clusters[idx]
+
+	state, err := store.ConvertToGRPCClusterState(cs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert cluster state database representation to grpc: %v", err)
+	}
+
+	idx = slices.IndexFunc(state.GetCurrent().GetLoadBalancers().GetClusters(), func(lb *spec.LBcluster) bool {
+		return lb.ClusterInfo.Name == designated.Name
+	})
+	if idx < 0 {
+		return nil, status.Errorf(
+			codes.Unavailable,
+			"loadbalancer %q designated for the services of cluster %q is not yet built, try again later", designated.Name, request.Cluster,
+		)
+	}
+	lb := state.Current.LoadBalancers.Clusters[idx]
+
+	if err := authenticateServiceController(ctx, state, designated.Name); err != nil {
+		return nil, err
+	}
+
+	slb := m.CreateServiceLoadBalancer(designated)
+	slb.Services = proto.CloneOf(lb.GetServiceLoadBalancer()).GetServices()
+	slb.Token = lb.GetServiceLoadBalancer().GetToken()
+
+	allocated, err := allocateServicePorts(lb, slb, request)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.UpdateServiceLoadBalancerResponse{
+		Hostname: lb.GetDns().GetEndpoint(),
+		Ips:      nodepools.PublicEndpoints(lb.ClusterInfo.NodePools),
+	}
+	if vip := lb.GetVirtualIP().GetIp(); vip != "" {
+		// the traffic is served only by the node holding the virtual IP.
+		resp.Ips = []string{vip}
+	}
+	for _, p := range allocated {
+		resp.Ports = append(resp.Ports, &pb.UpdateServiceLoadBalancerResponse_Port{Name: p.Name, Port: p.Port})
+	}
+
+	// Nothing to persist if the allocated ports did not change.
+	prev := lb.GetServiceLoadBalancer().GetServices()
+	if slices.EqualFunc(prev, slb.Services, func(a, b *spec.ServiceLoadBalancer_Service) bool { return proto.Equal(a, b) }) {
+		return resp, nil
+	}
+
+	if cs.InFlight != nil {
+		return nil, status.Errorf(codes.Unavailable, "cluster has on going changes, try again later")
+	}
+
+	lb.ServiceLoadBalancer = slb
+
+	// Persist changes.
+	db, err := store.ConvertFromGRPCClusterState(state)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert grpc representation to database: %v", err)
+	}
+
+	cfg.Clusters[request.Cluster] = db
+	if err := s.store.UpdateConfig(ctx, cfg); err != nil {
+		if errors.Is(err, store.ErrNotFoundOrDirty) {
+			return nil, status.Errorf(
+				codes.Aborted,
+				"couldn't update config %q with version %v, dirty write", request.Config, cfg.Version,
+			)
+		}
+		return nil, status.Errorf(
+			codes.Internal,
+			"failed to update current state for cluster: %q config: %q", request.Cluster, request.Config,
+		)
+	}
+
+	return resp, nil
+}
+
+// authenticateServiceController checks that the request carries the token of the designated
+// loadbalancer within the "authorization" metadata. The token of the loadbalancer in the current
+// state is accepted as well as the one of the in-flight task, as the service controller is deployed
+// by the task before its changes are moved into the current state.
+func authenticateServiceController(ctx context.Context, state *spec.ClusterState, lb string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var token string
+	if v := md.Get("authorization"); len(v) > 0 {
+		token, _ = strings.CutPrefix(v[0], "Bearer ")
+	}
+	if token == "" {
+		return status.Errorf(codes.Unauthenticated, "missing token of the service controller")
+	}
+
+	lbs := slices.Clone(state.GetCurrent().GetLoadBalancers().GetClusters())
+	if task := state.GetInFlight().GetTask(); task != nil {
+		if clusters, err := task.MutableClusters(); err == nil {
+			lbs = append(lbs, clusters.GetLoadBalancers().GetClusters()...)
+		}
+	}
+
+	for _, c := range lbs {
+		expected := c.GetServiceLoadBalancer().GetToken()
+		if c.GetClusterInfo().GetName() != lb || expected == "" {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.Unauthenticated, "invalid token of the service controller for loadbalancer %q", lb)
+}
+
+// allocateServicePorts allocates the ports for the service of the request within the port range of
+// the [spec.ServiceLoadBalancer] and replaces the ports of the service with them. Ports previously
+// allocated for a port of the service with the same name are kept, otherwise the port of the service
+// itself is preferred, if free. The service is removed if the request contains no ports.
+func allocateServicePorts(lb *spec.LBcluster, slb *spec.ServiceLoadBalancer, request *pb.UpdateServiceLoadBalancerRequest) ([]*spec.ServiceLoadBalancer_Port, error) {
+	var previous []*spec.ServiceLoadBalancer_Port
+
+	used := make(map[int32]struct{})
+	for _, r := range lb.Roles {
+		if !strings.HasPrefix(r.Name, spec.ServiceRolePrefix) {
+			used[r.Port] = struct{}{}
+		}
+	}
+	for _, svc := range slb.Services {
+		if svc.Namespace == request.Namespace && svc.Name == request.Name {
+			previous = svc.Ports
+			continue
+		}
+		for _, p := range svc.Ports {
+			used[p.Port] = struct{}{}
+		}
+	}
+
+	free := func(port int32) bool {
+		_, ok := used[port]
+		return !ok && port >= slb.MinPort && port <= slb.MaxPort
+	}
+
+	// allocated keeps the order of the ports of the request.
+	allocated := make([]*spec.ServiceLoadBalancer_Port, len(request.Ports))
+	for i, p := range request.Ports {
+		idx := slices.IndexFunc(previous, func(prev *spec.ServiceLoadBalancer_Port) bool { return prev.Name == p.Name })
+		if idx >= 0 && free(previous[idx].Port) {
+			used[previous[idx].Port] = struct{}{}
+			allocated[i] = &spec.ServiceLoadBalancer_Port{
+				Name:     p.Name,
+				Protocol: p.Protocol,
+				Port:     previous[idx].Port,
+				NodePort: p.NodePort,
+			}
+		}
+	}
+
+	next := slb.MinPort
+	for i, p := range request.Ports {
+		if allocated[i] != nil {
+			continue
+		}
+
+		port := p.Port
+		if !free(port) {
+			for next <= slb.MaxPort && !free(next) {
+				next++
+			}
+			if next > slb.MaxPort {
+				return nil, status.Errorf(
+					codes.ResourceExhausted,
+					"no free port left in the range [%v, %v] of loadbalancer %q", slb.MinPort, slb.MaxPort, lb.ClusterInfo.Name,
+				)
+			}
+			port = next
+		}
+
+		used[port] = struct{}{}
+		allocated[i] = &spec.ServiceLoadBalancer_Port{
+			Name:     p.Name,
+			Protocol: p.Protocol,
+			Port:     port,
+			NodePort: p.NodePort,
+		}
+	}
+
+	idx := slices.IndexFunc(slb.Services, func(svc *spec.ServiceLoadBalancer_Service) bool {
+		return svc.Namespace == request.Namespace && svc.Name == request.Name
+	})
+
+	switch {
+	case len(allocated) == 0 && idx >= 0:
+		slb.Services = slices.Delete(slb.Services, idx, idx+1)
+	case len(allocated) == 0:
+	case idx >= 0:
+		slb.Services[idx].Ports = allocated
+	default:
+		slb.Services = append(slb.Services, &spec.ServiceLoadBalancer_Service{
+			Namespace: request.Namespace,
+			Name:      request.Name,
+			Ports:     allocated,
+		})
+	}
+
+	return allocated, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const serviceLoadBalancerManifest = `
+name: config
+kubernetes:
+  clusters:
+    - name: k8s
+      pools:
+        control: [control]
+        compute: [compute]
+loadBalancers:
+  clusters:
+    - name: lb
+      targetedK8s: k8s
+      serviceLoadBalancer:
+        portRange:
+          min: 30000
+          max: 30002
+`
+
+func TestUpdateServiceLoadBalancer(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{store: store.NewInMemoryStore()}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+
+	current := rollbackTestClusters("control", "compute")
+	current.LoadBalancers.Clusters = []*spec.LBcluster{{
+		ClusterInfo: &spec.ClusterInfo{Name: "lb", Hash: "def", NodePools: []*spec.NodePool{
+			{Name: "lb", Nodes: []*spec.Node{{Name: "lb-1", Public: "1.1.1.1"}}},
+		}},
+		Dns:         &spec.DNS{Endpoint: "lb.example.com"},
+		TargetedK8S: "k8s",
+		Roles:       []*spec.Role{{Name: "ingress", Port: 443, Settings: &spec.Role_Settings{}}},
+		ServiceLoadBalancer: &spec.ServiceLoadBalancer{
+			MinPort:     30000,
+			MaxPort:     30002,
+			TargetPools: []string{"compute"},
+			Token:       "token",
+		},
+	}}
+
+	cfg := &spec.Config{
+		Name: "config",
+		Manifest: &spec.Manifest{
+			Raw:      serviceLoadBalancerManifest,
+			Checksum: []byte("checksum"),
+		},
+		Clusters: map[string]*spec.ClusterState{
+			"k8s": {
+				Current: current,
+				State:   &spec.Workflow{Status: spec.Workflow_DONE},
+			},
+		},
+	}
+
+	db, err := store.ConvertFromGRPC(cfg)
+	require.NoError(t, err)
+	require.NoError(t, s.store.CreateConfig(ctx, db))
+
+	request := func(name string, ports ...*pb.UpdateServiceLoadBalancerRequest_Port) *pb.UpdateServiceLoadBalancerRequest {
+		return &pb.UpdateServiceLoadBalancerRequest{Config: "config", Cluster: "k8s", Namespace: "default", Name: name, Ports: ports}
+	}
+
+	allocated := func(resp *pb.UpdateServiceLoadBalancerResponse) map[string]int32 {
+		out := make(map[string]int32)
+		for _, p := range resp.Ports {
+			out[p.Name] = p.Port
+		}
+		return out
+	}
+
+	stored := func() *spec.ServiceLoadBalancer {
+		db, err := s.store.GetConfig(ctx, "config")
+		require.NoError(t, err)
+		state, err := store.ConvertToGRPCClusterState(db.Clusters["k8s"])
+		require.NoError(t, err)
+		return state.Current.LoadBalancers.Clusters[0].ServiceLoadBalancer
+	}
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, &pb.UpdateServiceLoadBalancerRequest{Config: "config", Cluster: "k8s"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, request("web", &pb.UpdateServiceLoadBalancerRequest_Port{Name: "sctp", Protocol: "SCTP", NodePort: 31000}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, &pb.UpdateServiceLoadBalancerRequest{Config: "config", Cluster: "missing", Namespace: "default", Name: "web"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// only the controller holding the token of the designated loadbalancer may allocate the ports.
+	_, err = s.UpdateServiceLoadBalancer(context.Background(), request("web"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	wrongToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer other"))
+	_, err = s.UpdateServiceLoadBalancer(wrongToken, request("web"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// the port of the service is preferred if it is within the range.
+	resp, err := s.UpdateServiceLoadBalancer(ctx, request("web",
+		&pb.UpdateServiceLoadBalancerRequest_Port{Name: "http", Protocol: "TCP", Port: 80, NodePort: 31080},
+		&pb.UpdateServiceLoadBalancerRequest_Port{Name: "https", Protocol: "TCP", Port: 30001, NodePort: 31443},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"http": 30000, "https": 30001}, allocated(resp))
+	assert.Equal(t, "lb.example.com", resp.Hostname)
+	assert.Equal(t, []string{"1.1.1.1"}, resp.Ips)
+
+	slb := stored()
+	require.NotNil(t, slb)
+	assert.Equal(t, int32(30000), slb.MinPort)
+	assert.Equal(t, []string{"compute"}, slb.TargetPools)
+	assert.Equal(t, "token", slb.Token)
+	require.Len(t, slb.Services, 1)
+	assert.Equal(t, "tcp", slb.Services[0].Ports[0].Protocol)
+
+	// allocated ports are kept, even if the node ports change.
+	resp, err = s.UpdateServiceLoadBalancer(ctx, request("web",
+		&pb.UpdateServiceLoadBalancerRequest_Port{Name: "https", Protocol: "TCP", Port: 443, NodePort: 32443},
+		&pb.UpdateServiceLoadBalancerRequest_Port{Name: "http", Protocol: "TCP", Port: 80, NodePort: 31080},
+	))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"http": 30000, "https": 30001}, allocated(resp))
+	assert.Equal(t, "https", resp.Ports[0].Name)
+	assert.Equal(t, int32(32443), stored().Services[0].Ports[0].NodePort)
+
+	resp, err = s.UpdateServiceLoadBalancer(ctx, request("dns", &pb.UpdateServiceLoadBalancerRequest_Port{Name: "dns", Protocol: "UDP", Port: 30000, NodePort: 31053}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"dns": 30002}, allocated(resp))
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, request("db", &pb.UpdateServiceLoadBalancerRequest_Port{Name: "sql", Protocol: "TCP", NodePort: 31432}))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// changes are refused while a task is being worked on, unless nothing changes.
+	db, err = s.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	db.Clusters["k8s"].InFlight = &store.TaskEvent{Id: "task", Timestamp: time.Now().UTC().Format(time.RFC3339)}
+	require.NoError(t, s.store.UpdateConfig(ctx, db))
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, request("web"))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = s.UpdateServiceLoadBalancer(ctx, request("dns", &pb.UpdateServiceLoadBalancerRequest_Port{Name: "dns", Protocol: "UDP", Port: 30000, NodePort: 31053}))
+	require.NoError(t, err)
+
+	db, err = s.store.GetConfig(ctx, "config")
+	require.NoError(t, err)
+	db.Clusters["k8s"].InFlight = nil
+	require.NoError(t, s.store.UpdateConfig(ctx, db))
+
+	// releasing the ports frees them for other services.
+	resp, err = s.UpdateServiceLoadBalancer(ctx, request("web"))
+	require.NoError(t, err)
+	assert.Empty(t, resp.Ports)
+	require.Len(t, stored().Services, 1)
+
+	resp, err = s.UpdateServiceLoadBalancer(ctx, request("db", &pb.UpdateServiceLoadBalancerRequest_Port{Name: "sql", Protocol: "TCP", NodePort: 31432}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int32{"sql": 30000}, allocated(resp))
+}
@@ -1,18 +1,19 @@
 package service
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"maps"
 	"net"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/berops/claudie/internal/api/manifest"
 	"github.com/berops/claudie/internal/clusters"
-	"github.com/berops/claudie/internal/kubectl"
+	"github.com/berops/claudie/internal/kube"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/rs/zerolog"
@@ -23,17 +24,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-type NodeOutput struct {
-	Kind string `json:"kind"`
-
-	Metadata struct {
-		Name string `json:"name"`
-	} `json:"metadata"`
-
-	Status struct {
-		Conditions []corev1.NodeCondition
-	}
-}
+// healthCheckTimeout is the timeout for retrieving the nodes of the cluster
+// during the health check, after which the API server is considered unreachable.
+const healthCheckTimeout = 15 * time.Second
 
 type NodeDescription struct {
 	K8sName string
@@ -80,7 +73,7 @@ type HealthCheckStatus struct {
 	// for the kubernetes cluster.
 	ApiEndpoint struct {
 		// Whether the API endpoint could be reached. The check is done
-		// by trying to reach the cluster via `kubectl`for the [spec.K8Scluster.Kubeconfig].
+		// by trying to reach the API server of the cluster via the [spec.K8Scluster.Kubeconfig].
 		//
 		// NOTE: This does not necessarily mean the cluster itself is down
 		// it could be the case that the managemenet cluster has network issues
@@ -89,7 +82,7 @@ type HealthCheckStatus struct {
 	}
 
 	Cluster struct {
-		// Nodes, as returned by kubectl get nodes.
+		// Nodes, as returned by the API server of the cluster.
 		//
 		// Note that some of the fields may be unset as
 		// the current state by claudie could be different
@@ -101,6 +94,10 @@ type HealthCheckStatus struct {
 
 		// Whether there is a drift in the number of wireguard peers.
 		VpnDrift bool
+
+		// Kubernetes names of the joined nodes for which the
+		// post-join hook of the cluster has not yet succeeded.
+		PostJoinHookPending map[string]struct{}
 	}
 }
 
@@ -213,78 +210,63 @@ func HealthCheck(logger zerolog.Logger, state *spec.Clusters) HealthCheckStatus
 	var result HealthCheckStatus
 	result.Cluster.Nodes = make(map[string]*NodeDescription)
 
-	kc := kubectl.Kubectl{
-		Kubeconfig:        state.K8S.Kubeconfig,
-		MaxKubectlRetries: kubectl.NoRetries,
+	var nodes []corev1.Node
+	client, err := kube.ForCluster(state.K8S.ClusterInfo.Id(), state.K8S.Kubeconfig, kube.Options{Timeout: healthCheckTimeout})
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+		nodes, err = client.Nodes(ctx)
+		cancel()
 	}
-
-	out, err := kc.KubectlGet("nodes", "-ojson")
 	if err != nil {
 		logger.
 			Warn().
-			Msgf("Failed to retrieve nodes of the cluster via `kubectl`: %v", err)
-
-		out = []byte{}
-		result.ApiEndpoint.Unreachable = true
-	}
-
-	var description struct {
-		Items []NodeOutput `json:"items"`
-	}
-
-	if err := json.Unmarshal(out, &description); err != nil {
-		logger.
-			Warn().
-			Msgf("failed to unmarshal json output from kubectl get nodes, ignoring: %v", err)
+			Msgf("Failed to retrieve nodes of the cluster: %v", err)
 
-		description.Items = nil
 		result.ApiEndpoint.Unreachable = true
 	}
 
-	if len(description.Items) == 0 {
+	if len(nodes) == 0 {
 		// Does not necessarily mean the cluster is down
 		// the management cluster could have network issues.
 		result.ApiEndpoint.Unreachable = true
 	}
 
-	for _, n := range description.Items {
-		if strings.ToLower(n.Kind) == "node" {
-			// By default assume node is ready.
-			//
-			// The not-ready status needs to be explicitly
-			// read from the output of kubectl.
-			isReady := true
-			transitionTime := (*metav1.Time)(nil)
-			for _, cond := range n.Status.Conditions {
-				if cond.Type == corev1.NodeReady {
-					transitionTime = cond.LastTransitionTime.DeepCopy()
-
-					t := time.Time{}
-					if transitionTime != nil {
-						t = transitionTime.Time
-					}
+	for _, n := range nodes {
+		// By default assume node is ready.
+		//
+		// The not-ready status needs to be explicitly
+		// read from the conditions of the node.
+		isReady := true
+		transitionTime := (*metav1.Time)(nil)
+		for _, cond := range n.Status.Conditions {
+			if cond.Type == corev1.NodeReady {
+				transitionTime = cond.LastTransitionTime.DeepCopy()
+
+				t := time.Time{}
+				if transitionTime != nil {
+					t = transitionTime.Time
+				}
 
-					if cond.Status != corev1.ConditionTrue {
-						logger.
-							Warn().
-							Msgf(
-								"Kubernetes node %q is unhealthy with status: %q, "+
-									"if the node does not become healthy, scheduling a reconciliation in %q",
-								n.Metadata.Name,
-								cond.Status,
-								max(TimeForNodeDeletion-time.Since(t), 0*time.Second),
-							)
-
-						isReady = false
-					}
+				if cond.Status != corev1.ConditionTrue {
+					logger.
+						Warn().
+						Msgf(
+							"Kubernetes node %q is unhealthy with status: %q, "+
+								"if the node does not become healthy, scheduling a reconciliation in %q",
+							n.Name,
+							cond.Status,
+							max(TimeForNodeDeletion-time.Since(t), 0*time.Second),
+						)
+
+					isReady = false
 				}
 			}
+		}
 
-			result.Cluster.Nodes[n.Metadata.Name] = &NodeDescription{
-				K8sName:            n.Metadata.Name,
-				Ready:              isReady,
-				LastTransitionTime: transitionTime,
-			}
+		result.Cluster.Nodes[n.Name] = &NodeDescription{
+			K8sName:            n.Name,
+			Ready:              isReady,
+			LastTransitionTime: transitionTime,
 		}
 	}
 
@@ -408,3 +390,112 @@ func healthCheckVPN(state *spec.Clusters) (bool, error) {
 	// exempt the connected to node.
 	return peers == nodeCount-1, nil
 }
+
+// healthChecks records the health status of the clusters as checked by the
+// last iteration of the reconciliation loop, which is reused when scheduling
+// tasks outside of the loop, so that the infrastructure is not probed by them.
+var healthChecks = healthRecords{records: make(map[string]healthRecord)}
+
+type healthRecord struct {
+	hc     HealthCheckStatus
+	status UnknownNodeStatus
+}
+
+type healthRecords struct {
+	lock    sync.Mutex
+	records map[string]healthRecord
+}
+
+// record stores the health status of the cluster.
+func (r *healthRecords) record(cluster string, hc HealthCheckStatus, status UnknownNodeStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.records[cluster] = healthRecord{hc: hc.clone(), status: status.clone()}
+}
+
+// forget drops the recorded health status of the cluster, to be called once the cluster is deleted.
+func (r *healthRecords) forget(cluster string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.records, cluster)
+}
+
+// last returns the last recorded health status of the passed in state. If there
+// is none recorded yet, all of the nodes of the state are assumed to be healthy.
+func (r *healthRecords) last(state *spec.Clusters) (HealthCheckStatus, UnknownNodeStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if rec, ok := r.records[state.GetK8S().GetClusterInfo().Id()]; ok {
+		return rec.hc.clone(), rec.status.clone()
+	}
+
+	var hc HealthCheckStatus
+	hc.Cluster.Nodes = make(map[string]*NodeDescription)
+	for _, np := range state.GetK8S().GetClusterInfo().GetNodePools() {
+		for _, n := range np.Nodes {
+			// kubernetes names have stripped cluster prefix.
+			strippedName := strings.TrimPrefix(n.Name, fmt.Sprintf("%s-", state.K8S.ClusterInfo.Id()))
+			hc.Cluster.Nodes[strippedName] = &NodeDescription{
+				K8sName:    strippedName,
+				Ready:      true,
+				IsStatic:   np.GetStaticNodePool() != nil,
+				NodePool:   np.Name,
+				PublicIPv4: n.Public,
+				IsControl:  np.IsControl,
+			}
+		}
+	}
+	// The port 6443 is closed on the control nodes once a loadbalancer is the API endpoint.
+	hc.Cluster.ControlNodesHave6443 = clusters.FindAssignedLbApiEndpoint(state.GetLoadBalancers().GetClusters()) == nil
+
+	status := UnknownNodeStatus{
+		UnknownKubernetesNodes:    make(map[string][]NodeDescription),
+		NotJoinedKubernetesNodes:  make(map[string][]NodeDescription),
+		UnknownLoadBalancersNodes: make(map[string]UnreachableIPv4Map),
+	}
+
+	return hc, status
+}
+
+func (d NodeDescription) clone() NodeDescription {
+	d.LastTransitionTime = d.LastTransitionTime.DeepCopy()
+	return d
+}
+
+func (hc HealthCheckStatus) clone() HealthCheckStatus {
+	out := hc
+	out.Cluster.Nodes = make(map[string]*NodeDescription, len(hc.Cluster.Nodes))
+	for k, n := range hc.Cluster.Nodes {
+		c := n.clone()
+		out.Cluster.Nodes[k] = &c
+	}
+	out.Cluster.PostJoinHookPending = maps.Clone(hc.Cluster.PostJoinHookPending)
+	return out
+}
+
+func (s UnknownNodeStatus) clone() UnknownNodeStatus {
+	nodes := func(m map[string][]NodeDescription) map[string][]NodeDescription {
+		out := make(map[string][]NodeDescription, len(m))
+		for np, descs := range m {
+			for _, d := range descs {
+				out[np] = append(out[np], d.clone())
+			}
+		}
+		return out
+	}
+
+	out := UnknownNodeStatus{
+		UnknownKubernetesNodes:    nodes(s.UnknownKubernetesNodes),
+		NotJoinedKubernetesNodes:  nodes(s.NotJoinedKubernetesNodes),
+		UnknownLoadBalancersNodes: make(map[string]UnreachableIPv4Map, len(s.UnknownLoadBalancersNodes)),
+	}
+	for lb, unreachable := range s.UnknownLoadBalancersNodes {
+		ips := make(UnreachableIPv4Map, len(unreachable))
+		for np, nodes := range unreachable {
+			ips[np] = slices.Clone(nodes)
+		}
+		out.UnknownLoadBalancersNodes[lb] = ips
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthRecords(t *testing.T) {
+	t.Parallel()
+
+	records := healthRecords{records: make(map[string]healthRecord)}
+
+	state := rollbackTestClusters("control", "compute")
+	id := state.K8S.ClusterInfo.Id()
+
+	// without a recorded health check, all of the nodes are assumed healthy.
+	hc, status := records.last(state)
+	assert.False(t, hc.ApiEndpoint.Unreachable)
+	assert.True(t, hc.Cluster.ControlNodesHave6443)
+	assert.Len(t, hc.Cluster.Nodes, 2)
+	for _, n := range hc.Cluster.Nodes {
+		assert.True(t, n.Ready)
+	}
+	assert.Empty(t, status.UnknownKubernetesNodes)
+	assert.Empty(t, status.NotJoinedKubernetesNodes)
+	assert.Empty(t, status.UnknownLoadBalancersNodes)
+
+	var recorded HealthCheckStatus
+	recorded.ApiEndpoint.Unreachable = true
+	records.record(id, recorded, UnknownNodeStatus{
+		UnknownLoadBalancersNodes: map[string]UnreachableIPv4Map{"lb": {"np": {"10.0.0.2"}}},
+	})
+
+	hc, status = records.last(state)
+	assert.True(t, hc.ApiEndpoint.Unreachable)
+	assert.Equal(t, []string{"10.0.0.2"}, status.UnknownLoadBalancersNodes["lb"]["np"])
+
+	// the returned status does not share memory with the record.
+	status.UnknownLoadBalancersNodes["lb"]["np"][0] = "modified"
+	_, status = records.last(state)
+	assert.Equal(t, []string{"10.0.0.2"}, status.UnknownLoadBalancersNodes["lb"]["np"])
+
+	records.forget(id)
+	hc, _ = records.last(&spec.Clusters{K8S: state.K8S, LoadBalancers: &spec.LoadBalancers{}})
+	assert.False(t, hc.ApiEndpoint.Unreachable)
+}
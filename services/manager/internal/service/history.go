@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/rs/zerolog"
+)
+
+// recordHistory appends the entries to the task history. Failing to record
+// the history is only logged, as the history is not needed for the reconciliation
+// of the clusters itself.
+func recordHistory(ctx context.Context, logger zerolog.Logger, s store.Store, entries ...*store.HistoryEntry) {
+	for _, e := range entries {
+		if err := s.AppendHistory(ctx, e); err != nil {
+			logger.Err(err).Msgf("Failed to record %s history entry for task %q", e.Kind, e.TaskId)
+		}
+	}
+}
+
+// scheduledHistory returns history entries for the tasks that were newly
+// scheduled in the modified config compared to the previous config.
+func scheduledHistory(previous, modified *store.Config) []*store.HistoryEntry {
+	var out []*store.HistoryEntry
+
+	for cluster, state := range modified.Clusters {
+		if state.InFlight == nil || state.State.Status != spec.Workflow_WAIT_FOR_PICKUP.String() {
+			continue
+		}
+
+		if prev, ok := previous.Clusters[cluster]; ok && prev.InFlight != nil && prev.InFlight.Id == state.InFlight.Id {
+			continue
+		}
+
+		out = append(out, &store.HistoryEntry{
+			Config:      modified.Name,
+			Cluster:     cluster,
+			TaskId:      state.InFlight.Id,
+			Kind:        spec.TaskHistoryEntry_TASK_SCHEDULED.String(),
+			Timestamp:   time.Now().UTC().Format(time.RFC3339),
+			Type:        state.InFlight.Type,
+			Description: state.InFlight.Description,
+		})
+	}
+
+	return out
+}
+
+// resultKind returns the kind of the result received for a stage of a task.
+func resultKind(result *spec.TaskResult) string {
+	switch result.GetResult().(type) {
+	case *spec.TaskResult_Update:
+		return "Update"
+	case *spec.TaskResult_Clear:
+		return "Clear"
+	case *spec.TaskResult_None_:
+		return "None"
+	default:
+		return ""
+	}
+}
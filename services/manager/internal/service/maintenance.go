@@ -0,0 +1,98 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+)
+
+// maintenanceGate decides whether disruptive changes to a cluster can be worked on
+// based on the maintenance windows of the cluster. Disruptive changes are Kubernetes
+// version upgrades, rolling updates of nodepools and moves of the API endpoint.
+type maintenanceGate struct {
+	// whether the cluster is within one of its maintenance windows.
+	open bool
+
+	// time at which the next maintenance window opens.
+	next time.Time
+
+	// description of the changes that were deferred.
+	deferred []string
+}
+
+func newMaintenanceGate(logger zerolog.Logger, windows []*spec.MaintenanceWindow, now time.Time) maintenanceGate {
+	open, next, err := spec.InMaintenanceWindow(windows, now)
+	if err != nil {
+		// Should be caught by the validation of the manifest, if not
+		// be conservative and consider the window to be closed.
+		logger.Err(err).Msg("Failed to evaluate maintenance windows, deferring disruptive changes")
+		return maintenanceGate{}
+	}
+	return maintenanceGate{open: open, next: next}
+}
+
+// deferDiff removes the disruptive changes from the diff, if outside of the maintenance
+// window, so that the remaining changes in the diff can still be scheduled.
+func (g *maintenanceGate) deferDiff(diff *DiffResult) {
+	if g.open {
+		return
+	}
+
+	if diff.Kubernetes.Version {
+		diff.Kubernetes.Version = false
+		g.deferred = append(g.deferred, "upgrade of the kubernetes version")
+	}
+
+	for np := range diff.Kubernetes.RollingUpdates {
+		g.deferred = append(g.deferred, fmt.Sprintf("rolling update of nodepool %q", np))
+	}
+	clear(diff.Kubernetes.RollingUpdates)
+
+	for np := range diff.Kubernetes.RollingUpdatesInProgress {
+		g.deferred = append(g.deferred, fmt.Sprintf("rolling update of nodepool %q", np))
+	}
+	clear(diff.Kubernetes.RollingUpdatesInProgress)
+
+	for lb, modified := range diff.LoadBalancers.Modified {
+		for np := range modified.RollingUpdate {
+			g.deferred = append(g.deferred, fmt.Sprintf("rolling update of nodepool %q of loadbalancer %q", np, lb))
+		}
+		clear(modified.RollingUpdate)
+	}
+}
+
+// deferTask returns nil if the task moves the API endpoint and is outside of the maintenance
+// window. As the changes scheduled after the move may depend on it, they are also deferred.
+func (g *maintenanceGate) deferTask(te *spec.TaskEvent) *spec.TaskEvent {
+	if g.open || te == nil {
+		return te
+	}
+
+	switch te.GetTask().GetUpdate().GetDelta().(type) {
+	case *spec.Update_ApiEndpoint_, *spec.Update_K8SApiEndpoint:
+		g.deferred = append(g.deferred, te.Description)
+		return nil
+	default:
+		return te
+	}
+}
+
+// reason describes the deferred changes, empty if none were deferred.
+func (g *maintenanceGate) reason() string {
+	if len(g.deferred) == 0 {
+		return ""
+	}
+
+	slices.Sort(g.deferred)
+
+	when := "the next maintenance window"
+	if !g.next.IsZero() {
+		when = fmt.Sprintf("%s at %s", when, g.next.UTC().Format(time.RFC3339))
+	}
+
+	return fmt.Sprintf("Deferred until %s: %s", when, strings.Join(g.deferred, ", "))
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceGate(t *testing.T) {
+	t.Parallel()
+
+	var (
+		// 2024-06-15 is a Saturday.
+		now     = time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+		windows = []*spec.MaintenanceWindow{{Days: []string{"Sat"}, Start: "02:00", Duration: "4h"}}
+
+		newDiff = func() DiffResult {
+			return DiffResult{
+				Kubernetes: KubernetesDiffResult{
+					Version:        true,
+					RollingUpdates: PendingRollingUpdates{"compute": &spec.DynamicNodePool{}},
+				},
+				LoadBalancers: LoadBalancersDiffResult{
+					Modified: map[string]ModifiedLoadBalancer{
+						"lb": {RollingUpdate: PendingRollingUpdates{"lb-pool": &spec.DynamicNodePool{}}},
+					},
+				},
+			}
+		}
+
+		apiMove = &spec.TaskEvent{
+			Description: "moving api endpoint",
+			Task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+				Delta: &spec.Update_K8SApiEndpoint{K8SApiEndpoint: &spec.Update_K8SOnlyApiEndpoint{}},
+			}}},
+		}
+
+		patch = &spec.TaskEvent{
+			Description: "patching nodes",
+			Task: &spec.Task{Do: &spec.Task_Update{Update: &spec.Update{
+				Delta: &spec.Update_KpatchNodes{KpatchNodes: &spec.Update_KuberPatchNodes{}},
+			}}},
+		}
+	)
+
+	t.Run("outside-window", func(t *testing.T) {
+		t.Parallel()
+
+		gate := newMaintenanceGate(zerolog.Nop(), windows, now)
+		diff := newDiff()
+		gate.deferDiff(&diff)
+
+		assert.False(t, diff.Kubernetes.Version)
+		assert.Empty(t, diff.Kubernetes.RollingUpdates)
+		assert.Empty(t, diff.LoadBalancers.Modified["lb"].RollingUpdate)
+
+		assert.Nil(t, gate.deferTask(apiMove))
+		assert.Equal(t, patch, gate.deferTask(patch))
+
+		assert.Equal(t,
+			`Deferred until the next maintenance window at 2024-06-22T02:00:00Z: moving api endpoint, `+
+				`rolling update of nodepool "compute", rolling update of nodepool "lb-pool" of loadbalancer "lb", `+
+				`upgrade of the kubernetes version`,
+			gate.reason(),
+		)
+	})
+
+	t.Run("within-window", func(t *testing.T) {
+		t.Parallel()
+
+		gate := newMaintenanceGate(zerolog.Nop(), windows, now.Add(-8*time.Hour))
+		diff := newDiff()
+		gate.deferDiff(&diff)
+
+		assert.Equal(t, newDiff(), diff)
+		assert.Equal(t, apiMove, gate.deferTask(apiMove))
+		assert.Empty(t, gate.reason())
+	})
+
+	t.Run("no-windows", func(t *testing.T) {
+		t.Parallel()
+
+		gate := newMaintenanceGate(zerolog.Nop(), nil, now)
+		assert.Equal(t, apiMove, gate.deferTask(apiMove))
+		assert.Empty(t, gate.reason())
+	})
+}
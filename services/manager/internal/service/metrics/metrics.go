@@ -4,6 +4,22 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+const (
+	InputManifestLabel = "inputmanifest"
+	ClusterLabel       = "cluster"
+	LoadBalancerLabel  = "loadbalancer"
+	StatusLabel        = "status"
+	TaskTypeLabel      = "task_type"
+	StageKindLabel     = "stage_kind"
+	SubPassKindLabel   = "sub_pass_kind"
+	ResultLabel        = "result"
+)
+
+const (
+	ResultOk    = "ok"
+	ResultError = "error"
+)
+
 var (
 	NatsDuplicateMessagesCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "claudie_nats_messages_duplicate",
@@ -61,6 +77,47 @@ var (
 		Name: "claudie_tasks_type_delete",
 		Help: "Total number of tasks processed with event type 'delete'",
 	})
+
+	ClusterWorkflowStatusSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "claudie_cluster_workflow_status_seconds",
+			Help: "Number of seconds the cluster has been in its current workflow status",
+		},
+		[]string{InputManifestLabel, ClusterLabel, StatusLabel},
+	)
+	ClusterNodes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "claudie_cluster_nodes",
+			Help: "Number of nodes of the cluster, or of its attached loadbalancer, by node status",
+		},
+		[]string{InputManifestLabel, ClusterLabel, LoadBalancerLabel, StatusLabel},
+	)
+	ClusterUnreachableNodes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "claudie_cluster_unreachable_nodes",
+			Help: "Number of nodes of the cluster, or of its attached loadbalancer, that were found unreachable during the last health check",
+		},
+		[]string{InputManifestLabel, ClusterLabel, LoadBalancerLabel},
+	)
+	StageDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "claudie_task_stage_duration_seconds",
+			Help: "Duration of the pipeline stages of the tasks, from publishing the stage to the NATS queue until its result is processed",
+			Buckets: []float64{
+				5, 10, 30, 60, 120, 300, 600, // up to 10 min
+				900, 1200, 1800, 2700, 3600, // up to 1 hour
+				5400, 7200, // up to 2 hours
+			},
+		},
+		[]string{InputManifestLabel, ClusterLabel, TaskTypeLabel, StageKindLabel, ResultLabel},
+	)
+	StageSubPasses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "claudie_task_stage_sub_passes",
+			Help: "Total number of sub-passes of the finished pipeline stages of the tasks",
+		},
+		[]string{InputManifestLabel, ClusterLabel, TaskTypeLabel, StageKindLabel, SubPassKindLabel, ResultLabel},
+	)
 )
 
 func MustRegisterCounters() {
@@ -78,4 +135,9 @@ func MustRegisterCounters() {
 	prometheus.MustRegister(TasksProcessedTypeCreateCounter)
 	prometheus.MustRegister(TasksProcessedTypeUpdateCounter)
 	prometheus.MustRegister(TasksProcessedTypeDeleteCounter)
+	prometheus.MustRegister(ClusterWorkflowStatusSeconds)
+	prometheus.MustRegister(ClusterNodes)
+	prometheus.MustRegister(ClusterUnreachableNodes)
+	prometheus.MustRegister(StageDuration)
+	prometheus.MustRegister(StageSubPasses)
 }
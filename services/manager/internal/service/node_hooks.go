@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/berops/claudie/internal/concurrent"
+	"github.com/berops/claudie/internal/hooks"
+	"github.com/berops/claudie/internal/nodepools"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+)
+
+// postJoinHooks tracks the nodes for which the post-join hook of their cluster
+// succeeded. The state is kept only in memory, thus after a restart of the manager
+// the hook is called again for all of the joined nodes, i.e. the hooks are delivered
+// at least once and are expected to be idempotent.
+var postJoinHooks = newPostJoinTracker()
+
+type postJoinTracker struct {
+	lock sync.Mutex
+
+	// approved nodes, by their kubernetes names, per cluster ID.
+	approved map[string]map[string]struct{}
+
+	// calling are the nodes, by their kubernetes names, per cluster ID
+	// for which the hook is being called.
+	calling map[string]map[string]struct{}
+}
+
+func newPostJoinTracker() *postJoinTracker {
+	return &postJoinTracker{
+		approved: make(map[string]map[string]struct{}),
+		calling:  make(map[string]map[string]struct{}),
+	}
+}
+
+// pending returns the kubernetes names of the joined and ready nodes of the
+// cluster for which the post-join hook of the cluster has not yet succeeded.
+func (t *postJoinTracker) pending(current *spec.K8Scluster, hc *HealthCheckStatus) map[string]struct{} {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	id := current.GetClusterInfo().Id()
+	if current.GetNodeHooks().GetPostJoin() == nil {
+		delete(t.approved, id)
+		delete(t.calling, id)
+		return nil
+	}
+
+	approved := t.approved[id]
+	pending := make(map[string]struct{})
+	remaining := make(map[string]struct{})
+
+	for np := range nodepools.All(current.ClusterInfo.NodePools) {
+		for _, n := range np.Nodes {
+			// kubernetes names have stripped cluster prefix.
+			k8sName := strings.TrimPrefix(n.Name, fmt.Sprintf("%s-", id))
+			if _, ok := approved[k8sName]; ok {
+				remaining[k8sName] = struct{}{}
+				continue
+			}
+			if d, ok := hc.Cluster.Nodes[k8sName]; n.Status == spec.NodeStatus_Joined && ok && d.Ready {
+				pending[k8sName] = struct{}{}
+			}
+		}
+	}
+
+	// forget the nodes that are no longer part of the cluster.
+	t.approved[id] = remaining
+	return pending
+}
+
+// dispatch calls the post-join hook of the cluster in the background for the
+// pending nodes, as returned by [postJoinTracker.pending], for which there is no
+// call in progress. The nodes approved by the hook are no longer pending in the
+// next iterations of the reconciliation. The calls are bounded by the passed in
+// context, which is expected to be cancelled once the reconciliation loop exits.
+func (t *postJoinTracker) dispatch(ctx context.Context, logger zerolog.Logger, current *spec.K8Scluster, pending map[string]struct{}) {
+	if len(pending) == 0 {
+		return
+	}
+
+	var (
+		id       = current.ClusterInfo.Id()
+		hook     = proto.Clone(current.NodeHooks.PostJoin).(*spec.NodeHook)
+		requests []hooks.Request
+	)
+
+	t.lock.Lock()
+	calling, ok := t.calling[id]
+	if !ok {
+		calling = make(map[string]struct{})
+		t.calling[id] = calling
+	}
+	for np := range nodepools.All(current.ClusterInfo.NodePools) {
+		for _, n := range np.Nodes {
+			k8sName := strings.TrimPrefix(n.Name, fmt.Sprintf("%s-", id))
+			if _, ok := pending[k8sName]; !ok {
+				continue
+			}
+			if _, ok := calling[k8sName]; ok {
+				continue
+			}
+			calling[k8sName] = struct{}{}
+			requests = append(requests, hooks.Request{
+				Event:    hooks.PostJoin,
+				Cluster:  id,
+				NodePool: np.Name,
+				Node:     k8sName,
+				Endpoint: n.Public,
+			})
+		}
+	}
+	t.lock.Unlock()
+
+	if len(requests) == 0 {
+		return
+	}
+
+	go func() {
+		_ = concurrent.Exec(requests, func(_ int, req hooks.Request) error {
+			err := hooks.Call(ctx, hook, req)
+			switch {
+			case err == nil:
+				logger.Info().Msgf("post-join hook approved node %s", req.Node)
+			case errors.Is(err, hooks.ErrRetryLater):
+				logger.Info().Msgf("post-join hook asked to retry later for node %s", req.Node)
+			default:
+				logger.Warn().Err(err).Msgf("post-join hook failed for node %s, will be retried", req.Node)
+			}
+
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			calling := t.calling[id]
+			if _, ok := calling[req.Node]; !ok {
+				// the hook was removed in the meantime.
+				return nil
+			}
+			delete(calling, req.Node)
+
+			if err == nil {
+				approved, ok := t.approved[id]
+				if !ok {
+					approved = make(map[string]struct{})
+					t.approved[id] = approved
+				}
+				approved[req.Node] = struct{}{}
+			}
+			return nil
+		})
+	}()
+}
+
+// updateNodeHooks updates the node hooks of the passed in current state
+// to the ones of the desired state. Returns true if there was any change.
+func updateNodeHooks(current, desired *spec.Clusters) bool {
+	if current.GetK8S() == nil || desired.GetK8S() == nil {
+		return false
+	}
+	if proto.Equal(current.K8S.NodeHooks, desired.K8S.NodeHooks) {
+		return false
+	}
+
+	current.K8S.NodeHooks = nil
+	if h := desired.K8S.NodeHooks; h != nil {
+		current.K8S.NodeHooks = proto.Clone(h).(*spec.NodeHooks)
+	}
+	return true
+}
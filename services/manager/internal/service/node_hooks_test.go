@@ -0,0 +1,148 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/internal/hooks"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostJoinTracker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		lock   sync.Mutex
+		called []string
+		locked = map[string]bool{"np-aaaaaaa-02": true}
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req hooks.Request
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, hooks.PostJoin, req.Event)
+		assert.Equal(t, "k8s-hash", req.Cluster)
+		assert.Equal(t, "np-aaaaaaa", req.NodePool)
+
+		lock.Lock()
+		defer lock.Unlock()
+		called = append(called, req.Node)
+		if locked[req.Node] {
+			w.WriteHeader(http.StatusLocked)
+		}
+	}))
+	defer srv.Close()
+
+	np := rollingUpdateNodePool("np-aaaaaaa", "ubuntu-24.04", 3, nil)
+	np.Nodes[2].Status = spec.NodeStatus_Preparing
+
+	current := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "k8s", Hash: "hash", NodePools: []*spec.NodePool{np}},
+		NodeHooks:   &spec.NodeHooks{PostJoin: &spec.NodeHook{Url: srv.URL}},
+	}
+
+	var hc HealthCheckStatus
+	hc.Cluster.Nodes = map[string]*NodeDescription{
+		"np-aaaaaaa-01": {K8sName: "np-aaaaaaa-01", Ready: true},
+		"np-aaaaaaa-02": {K8sName: "np-aaaaaaa-02", Ready: true},
+		"np-aaaaaaa-03": {K8sName: "np-aaaaaaa-03", Ready: true},
+	}
+
+	tracker := newPostJoinTracker()
+	calls := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return slices.Clone(called)
+	}
+
+	// nodes that are not joined are not passed to the hook.
+	pending := tracker.pending(current, &hc)
+	assert.Equal(t, map[string]struct{}{"np-aaaaaaa-01": {}, "np-aaaaaaa-02": {}}, pending)
+
+	tracker.dispatch(t.Context(), zerolog.Nop(), current, pending)
+	assert.Eventually(t, func() bool {
+		return len(tracker.pending(current, &hc)) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]struct{}{"np-aaaaaaa-02": {}}, tracker.pending(current, &hc))
+	assert.ElementsMatch(t, []string{"np-aaaaaaa-01", "np-aaaaaaa-02"}, calls())
+
+	// approved nodes are not passed to the hook again.
+	lock.Lock()
+	called = nil
+	delete(locked, "np-aaaaaaa-02")
+	lock.Unlock()
+
+	tracker.dispatch(t.Context(), zerolog.Nop(), current, tracker.pending(current, &hc))
+	assert.Eventually(t, func() bool {
+		return len(tracker.pending(current, &hc)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"np-aaaaaaa-02"}, calls())
+
+	// without the hook no nodes are pending.
+	current.NodeHooks = nil
+	assert.Empty(t, tracker.pending(current, &hc))
+}
+
+func TestPostJoinTrackerDispatchOnce(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls   atomic.Int32
+		release = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+	}))
+	defer srv.Close()
+
+	np := rollingUpdateNodePool("np-aaaaaaa", "ubuntu-24.04", 1, nil)
+	current := &spec.K8Scluster{
+		ClusterInfo: &spec.ClusterInfo{Name: "k8s", Hash: "hash", NodePools: []*spec.NodePool{np}},
+		NodeHooks:   &spec.NodeHooks{PostJoin: &spec.NodeHook{Url: srv.URL}},
+	}
+
+	var hc HealthCheckStatus
+	hc.Cluster.Nodes = map[string]*NodeDescription{
+		"np-aaaaaaa-01": {K8sName: "np-aaaaaaa-01", Ready: true},
+	}
+
+	tracker := newPostJoinTracker()
+
+	// a node with a call in progress is not passed to the hook again.
+	tracker.dispatch(t.Context(), zerolog.Nop(), current, tracker.pending(current, &hc))
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	tracker.dispatch(t.Context(), zerolog.Nop(), current, tracker.pending(current, &hc))
+	assert.Len(t, tracker.pending(current, &hc), 1)
+
+	close(release)
+	assert.Eventually(t, func() bool {
+		return len(tracker.pending(current, &hc)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestUpdateNodeHooks(t *testing.T) {
+	t.Parallel()
+
+	hook := &spec.NodeHooks{PreDrain: &spec.NodeHook{Url: "https://hooks.example.com", Timeout: "1m"}}
+	current := &spec.Clusters{K8S: &spec.K8Scluster{}}
+	desired := &spec.Clusters{K8S: &spec.K8Scluster{NodeHooks: hook}}
+
+	assert.True(t, updateNodeHooks(current, desired))
+	assert.Equal(t, "https://hooks.example.com", current.K8S.NodeHooks.PreDrain.Url)
+	assert.False(t, updateNodeHooks(current, desired))
+
+	desired.K8S.NodeHooks = nil
+	assert.True(t, updateNodeHooks(current, desired))
+	assert.Nil(t, current.K8S.NodeHooks)
+}
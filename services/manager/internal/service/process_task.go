@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/berops/claudie/internal/loggerutils"
@@ -97,9 +99,31 @@ func ProcessTask(ctx context.Context, stores Stores, work Work) (acknowledge boo
 		return
 	}
 
+	// Captured before processing the result, as the
+	// InFlight task may be replaced once it is finished.
+	finished := store.HistoryEntry{
+		Config:      work.InputManifest,
+		Cluster:     work.Cluster,
+		TaskId:      work.TaskID,
+		Kind:        spec.TaskHistoryEntry_STAGE_FINISHED.String(),
+		Type:        cluster.InFlight.Type,
+		Description: withWarnings(stage.Description.About, work.Result.GetWarnings()),
+		Stage:       stage.Kind,
+		StageIndex:  work.TaskStage,
+		Result:      resultKind(work.Result),
+	}
+
 	if err := work.Result.Error; err != nil {
 		acknowledge = processTaskWithError(ctx, logger, im, stores, work)
 		if acknowledge {
+			finished.Timestamp = time.Now().UTC().Format(time.RFC3339)
+			finished.Error = &store.HistoryError{
+				Kind:        err.Kind.String(),
+				Description: err.Description,
+			}
+			recordHistory(ctx, logger, stores.store, &finished)
+			clusterStats.stageFinished(work, finished.Type, stage, metrics.ResultError, time.Now())
+
 			metrics.NatsMsgsAcknowledged.Inc()
 			metrics.TasksFinishedErr.Inc()
 
@@ -173,6 +197,14 @@ func ProcessTask(ctx context.Context, stores Stores, work Work) (acknowledge boo
 			Info().
 			Msgf("Successfully rescheduled task under new ID %q", newUUID)
 
+		finished.Timestamp = time.Now().UTC().Format(time.RFC3339)
+		finished.Error = &store.HistoryError{
+			Kind:        spec.TaskResult_Error_FATAL.String(),
+			Description: fmt.Sprintf("failed to propagate result, rescheduled under new ID %q: %v", newUUID, err),
+		}
+		recordHistory(ctx, logger, stores.store, &finished)
+		clusterStats.stageFinished(work, finished.Type, stage, metrics.ResultError, time.Now())
+
 		// If the Ack messge fails here the task was rescheduling under a new ID thus processing
 		// the result again, will be discarded before reaching any processing of the message.
 		acknowledge = true
@@ -197,7 +229,7 @@ func ProcessTask(ctx context.Context, stores Stores, work Work) (acknowledge boo
 	// Store the result in the previously finished workflows.
 	previous := store.FinishedWorkflow{
 		Status:          spec.Workflow_DONE.String(),
-		TaskDescription: cluster.State.Description,
+		TaskDescription: withWarnings(cluster.State.Description, work.Result.GetWarnings()),
 		Timestamp:       time.Now().UTC().Format(time.RFC3339),
 		Stage:           cluster.InFlight.Pipeline[cluster.InFlight.CurrentStage].Kind,
 	}
@@ -230,6 +262,10 @@ func ProcessTask(ctx context.Context, stores Stores, work Work) (acknowledge boo
 		Info().
 		Msg("Successfully updated current state of the cluster. Moved the Task to the next stage")
 
+	finished.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	recordHistory(ctx, logger, stores.store, &finished)
+	clusterStats.stageFinished(work, finished.Type, stage, metrics.ResultOk, time.Now())
+
 	acknowledge = true
 	metrics.NatsMsgsAcknowledged.Inc()
 	metrics.TasksFinishedOk.Inc()
@@ -283,7 +319,7 @@ func processTaskWithError(
 	cluster.State.Previous = append(cluster.State.Previous, previous)
 
 	cluster.State.Status = spec.Workflow_ERROR.String()
-	cluster.State.Description = work.Result.Error.Description
+	cluster.State.Description = withWarnings(work.Result.Error.Description, work.Result.GetWarnings())
 
 	if isErrorPartial {
 		if err := propagateResult(logger, cluster, work.Result); err != nil {
@@ -369,6 +405,15 @@ func processTaskWithError(
 	return
 }
 
+// withWarnings appends the non-fatal diagnostics reported
+// along with the result of a task to the description.
+func withWarnings(description string, warnings []string) string {
+	if len(warnings) == 0 {
+		return description
+	}
+	return fmt.Sprintf("%s\nwarnings:\n- %s", description, strings.Join(warnings, "\n- "))
+}
+
 func advanceToNextStage(logger zerolog.Logger, state *store.ClusterState) error {
 	state.InFlight.CurrentStage += 1
 
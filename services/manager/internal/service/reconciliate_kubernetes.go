@@ -54,6 +54,28 @@ func KubernetesModifications(r KubernetesReconciliate) *spec.TaskEvent {
 		return ScheduleAdditionsInNodePools(r.Current, r.Desired, &r.Diff.Dynamic, opts)
 	}
 
+	for replaced, progress := range r.Diff.RollingUpdatesInProgress {
+		batch := nextRollingUpdateBatch(r.Hc, r.Current.K8S, replaced, progress)
+		if batch == nil || len(batch.PartiallyAdded) == 0 {
+			continue
+		}
+
+		opts := K8sNodeAdditionOptions{
+			UseProxy:     r.Diff.Proxy.CurrentUsed,
+			HasApiServer: r.Diff.ApiEndpoint.Current != "",
+			IsStatic:     false,
+		}
+
+		updated := len(nodepools.FindByName(progress.Replacement, r.Current.K8S.ClusterInfo.NodePools).Nodes)
+		return describeRollingUpdate(
+			ScheduleAdditionsInNodePools(r.Current, r.Desired, batch, opts),
+			replaced,
+			progress.Replacement,
+			updated,
+			int(progress.Target),
+		)
+	}
+
 	if len(r.Diff.Static.Added) > 0 || len(r.Diff.Static.PartiallyAdded) > 0 {
 		opts := K8sNodeAdditionOptions{
 			UseProxy:     r.Diff.Proxy.CurrentUsed,
@@ -83,6 +105,28 @@ func KubernetesDeletions(r KubernetesReconciliate) *spec.TaskEvent {
 		return ScheduleDeletionsInNodePools(r.Current, &r.Diff.Dynamic, opts)
 	}
 
+	for replaced, progress := range r.Diff.RollingUpdatesInProgress {
+		batch := nextRollingUpdateBatch(r.Hc, r.Current.K8S, replaced, progress)
+		if batch == nil || (len(batch.Deleted) == 0 && len(batch.PartiallyDeleted) == 0) {
+			continue
+		}
+
+		opts := K8sNodeDeletionOptions{
+			UseProxy:     r.Diff.Proxy.CurrentUsed,
+			HasApiServer: r.Diff.ApiEndpoint.Current != "",
+			IsStatic:     false,
+		}
+
+		updated := len(nodepools.FindByName(progress.Replacement, r.Current.K8S.ClusterInfo.NodePools).Nodes)
+		return describeRollingUpdate(
+			ScheduleDeletionsInNodePools(r.Current, batch, opts),
+			replaced,
+			progress.Replacement,
+			updated,
+			int(progress.Target),
+		)
+	}
+
 	if len(r.Diff.Static.Deleted) > 0 || len(r.Diff.Static.PartiallyDeleted) > 0 {
 		opts := K8sNodeDeletionOptions{
 			UseProxy:     r.Diff.Proxy.CurrentUsed,
@@ -185,7 +229,7 @@ func KubernetesLowPriority(r KubernetesReconciliate) *spec.TaskEvent {
 	}
 
 	if len(r.Diff.RollingUpdates) > 0 {
-		for np, tpl := range r.Diff.RollingUpdates {
+		for np, desired := range r.Diff.RollingUpdates {
 			opts := K8sNodeAdditionOptions{
 				UseProxy:     r.Diff.Proxy.CurrentUsed,
 				HasApiServer: r.Diff.ApiEndpoint.Current != "",
@@ -195,7 +239,7 @@ func KubernetesLowPriority(r KubernetesReconciliate) *spec.TaskEvent {
 			return ScheduleRollingUpdate(
 				r.Current,
 				np,
-				tpl,
+				desired,
 				opts,
 			)
 		}
@@ -797,7 +841,7 @@ func ScheduleAdditionsInNodePools(
 	lbrefresh2:
 		for _, lb := range current.LoadBalancers.Clusters {
 			for _, r := range lb.Roles {
-				for _, tg := range r.TargetPools {
+				for _, tg := range r.AllTargetPools() {
 					// Need to match against only the nodepool name without the hash.
 					dynamicNodePoolUpdate := nodepools.HasNodePoolTypeOf(tg, np)
 					// Static nodepools don't have any hashes.
@@ -931,7 +975,7 @@ func ScheduleAdditionsInNodePools(
 	lbrefresh1:
 		for _, lb := range current.LoadBalancers.Clusters {
 			for _, r := range lb.Roles {
-				for _, tg := range r.TargetPools {
+				for _, tg := range r.AllTargetPools() {
 					// Need to match against only the nodepool name without the hash.
 					dynamicNodePoolUpdate := nodepools.HasNodePoolTypeOf(tg, np)
 					// Static nodepools don't have any hashes.
@@ -1231,7 +1275,7 @@ func ScheduleDeletionsInNodePools(
 	lbrefresh1:
 		for _, lb := range current.LoadBalancers.Clusters {
 			for _, r := range lb.Roles {
-				for _, tg := range r.TargetPools {
+				for _, tg := range r.AllTargetPools() {
 					// Need to match against only the nodepool name without the hash.
 					dynamicNodePoolUpdate := nodepools.HasNodePoolTypeOf(tg, np)
 					// Static nodepools don't have any hashes.
@@ -1376,7 +1420,7 @@ func ScheduleDeletionsInNodePools(
 	lbrefresh2:
 		for _, lb := range current.LoadBalancers.Clusters {
 			for _, r := range lb.Roles {
-				for _, tg := range r.TargetPools {
+				for _, tg := range r.AllTargetPools() {
 					// Need to match against only the nodepool name without the hash.
 					dynamicNodePoolUpdate := nodepools.HasNodePoolTypeOf(tg, np)
 					// Static nodepools don't have any hashes.
@@ -1626,20 +1670,36 @@ func ScheduleMoveNodePoolFromAutoscaled(
 }
 
 // Schedules the addition of a new nodepool with the new templates
+// and infrastructure spec of the desired nodepool. The new nodepool
+// starts with as many nodes as the [spec.RollingUpdateStrategy] of the
+// desired nodepool allows, the remaining nodes are replaced in batches
+// tracked by [KubernetesDiffResult.RollingUpdatesInProgress].
 //
 // The returned [spec.TaskEvent] does not point to or share any
 // memory with the two passed in states.
 func ScheduleRollingUpdate(
 	current *spec.Clusters,
 	np string,
-	templates *spec.TemplateRepository,
+	desiredNodePool *spec.DynamicNodePool,
 	opts K8sNodeAdditionOptions,
 ) *spec.TaskEvent {
+	var (
+		target   = desiredNodePool.Count
+		surge, _ = desiredNodePool.GetRollingUpdate().Resolve(target)
+		existing = int32(len(nodepools.FindByName(np, current.K8S.ClusterInfo.NodePools).GetNodes()))
+		initial  = proto.Clone(desiredNodePool).(*spec.DynamicNodePool)
+	)
+
+	// With a surge smaller than the count of the nodepool only part of
+	// the nodes are added at first. If no surge is allowed at all, the
+	// new nodepool is added empty and the old nodes are deleted first.
+	initial.Count = max(min(target, target+surge-existing), 0)
+
 	newNodePool, err := CreateNodePoolForRollingUpdate(
 		current,
 		nil,
 		np,
-		templates,
+		initial,
 	)
 	if err != nil {
 		log.
@@ -1666,10 +1726,11 @@ func ScheduleRollingUpdate(
 		},
 	}
 
-	return ScheduleAdditionsInNodePools(
-		current,
-		desired,
-		&diff,
-		opts,
+	return describeRollingUpdate(
+		ScheduleAdditionsInNodePools(current, desired, &diff, opts),
+		np,
+		newNodePool.Name,
+		0,
+		int(target),
 	)
 }
@@ -218,8 +218,10 @@ func PostKubernetesDiff(r LoadBalancersReconciliate) *spec.TaskEvent {
 		// TargetPools modifications needs to be handled after changes to the kubernetes
 		// cluster, namely after adding new nodepools as old targetPools could be replaced
 		// by new and this could not be handled before the kubernetes changes, as the nodepools
-		// would not exist in the cluster yet and would make the workflow break.
-		if len(modified.Roles.TargetPoolsAdded) > 0 || len(modified.Roles.TargetPoolsDeleted) > 0 {
+		// would not exist in the cluster yet and would make the workflow break. The same
+		// applies to the routes, which reference target pools of their own.
+		targetPoolsChanged := len(modified.Roles.TargetPoolsAdded) > 0 || len(modified.Roles.TargetPoolsDeleted) > 0
+		if targetPoolsChanged || len(modified.Roles.RoutesChanged) > 0 {
 			return ScheduleReconcileRoleTargetPools(r.Current, r.Desired, cid, did)
 		}
 	}
@@ -275,7 +277,7 @@ func LoadBalancersLowPriority(r LoadBalancersReconciliate) *spec.TaskEvent {
 			)
 		}
 
-		for np, tpl := range modified.RollingUpdate {
+		for np, desired := range modified.RollingUpdate {
 			opts := LoadBalancerNodePoolsOptions{
 				UseProxy: r.Proxy.CurrentUsed,
 				IsStatic: false,
@@ -285,7 +287,7 @@ func LoadBalancersLowPriority(r LoadBalancersReconciliate) *spec.TaskEvent {
 				r.Current,
 				cid,
 				np,
-				tpl,
+				desired,
 				opts,
 			)
 		}
@@ -825,7 +827,7 @@ func ScheduleReplaceDns(
 	}
 
 	if desired.LoadBalancers.Clusters[did.Index].IsApiEndpoint() && apiEndpoint {
-		prev := current.LoadBalancers.Clusters[cid.Index].Dns.GetEndpoint()
+		prev := current.LoadBalancers.Clusters[cid.Index].Endpoint()
 		toReplace.OldApiEndpoint = &prev
 
 		ansStage := spec.Stage_Ansibler{
@@ -980,6 +982,60 @@ func ScheduleReplaceDns(
 	return &task
 }
 
+// Replaces the records of the [spec.DNS] of the loadbalancer in the current state, such that
+// they no longer point to the passed in unreachable endpoints. The [spec.DNS] itself is
+// kept as is, thus the endpoint of the loadbalancer does not change.
+//
+// The returned [spec.TaskEvent] does not point to or share any memory with the passed in state.
+func ScheduleReplaceDnsRecords(current *spec.Clusters, cid LoadBalancerIdentifier, unreachable []string) *spec.TaskEvent {
+	inFlight := proto.Clone(current).(*spec.Clusters)
+	updateOp := spec.Update{
+		State: &spec.Update_State{
+			K8S:           inFlight.K8S,
+			LoadBalancers: inFlight.LoadBalancers.Clusters,
+		},
+		Delta: &spec.Update_TfReplaceDnsRecords{
+			TfReplaceDnsRecords: &spec.Update_TerraformerReplaceDnsRecords{
+				Handle:               cid.Id,
+				UnreachableEndpoints: slices.Clone(unreachable),
+			},
+		},
+	}
+
+	return &spec.TaskEvent{
+		Id:        uuid.New().String(),
+		Timestamp: timestamppb.New(time.Now().UTC()),
+		Event:     spec.Event_UPDATE,
+		Task: &spec.Task{
+			Do: &spec.Task_Update{
+				Update: &updateOp,
+			},
+		},
+		Description: fmt.Sprintf("Reconciling DNS records for load balancer %q", cid.Id),
+		Pipeline: []*spec.Stage{
+			{
+				StageKind: &spec.Stage_Terraformer{
+					Terraformer: &spec.StageTerraformer{
+						Description: &spec.StageDescription{
+							About:      "Reconciling infrastructure",
+							ErrorLevel: spec.ErrorLevel_ERROR_FATAL,
+						},
+						SubPasses: []*spec.StageTerraformer_SubPass{
+							{
+								Kind: spec.StageTerraformer_UPDATE_INFRASTRUCTURE,
+								Description: &spec.StageDescription{
+									About:      "Pointing DNS records to the reachable nodes",
+									ErrorLevel: spec.ErrorLevel_ERROR_FATAL,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 // Configures the port 6443 [manifest.APIServerPort] on the control nodes of the [spec.Clusters] state.
 // Based on the supplied value of open, the port is either opened or closed on all of the control nodes.
 //
@@ -1628,7 +1684,7 @@ func ScheduleAddRoles(current, desired *spec.Clusters, cid, did LoadBalancerIden
 	}
 }
 
-// Reconciles the TargetPools in roles from the loadbalancer
+// Reconciles the TargetPools and Routes in roles from the loadbalancer
 // with the id identified from the passed in lb string, from
 // the desired [spec.Clusters] state into the current [spec.Clusters]
 // state.
@@ -1647,8 +1703,13 @@ func ScheduleReconcileRoleTargetPools(
 	for _, cr := range inFlight.LoadBalancers.Clusters[cid.Index].Roles {
 		for _, dr := range desired.LoadBalancers.Clusters[did.Index].Roles {
 			if cr.Name == dr.Name {
+				var routes []*spec.Role_Route
+				for _, r := range dr.Routes {
+					routes = append(routes, proto.Clone(r).(*spec.Role_Route))
+				}
 				toReconcile[cr.Name] = &spec.Update_AnsiblerReplaceTargetPools_TargetPools{
-					Pools: slices.Clone(dr.TargetPools),
+					Pools:  slices.Clone(dr.TargetPools),
+					Routes: routes,
 				}
 				break
 			}
@@ -1723,6 +1784,7 @@ func ScheduleReconcileRoleTargetPools(
 }
 
 // Schedules the addition of a new nodepool with the new templates
+// and infrastructure spec of the desired nodepool.
 //
 // The returned [spec.TaskEvent] does not point to or share any
 // memory with the two passed in states.
@@ -1730,14 +1792,14 @@ func ScheduleRollingUpdateLoadBalancer(
 	current *spec.Clusters,
 	cid LoadBalancerIdentifier,
 	np string,
-	templates *spec.TemplateRepository,
+	desiredNodePool *spec.DynamicNodePool,
 	opts LoadBalancerNodePoolsOptions,
 ) *spec.TaskEvent {
 	newNodePool, err := CreateNodePoolForRollingUpdate(
 		current,
 		&cid,
 		np,
-		templates,
+		desiredNodePool,
 	)
 	if err != nil {
 		log.
@@ -1869,6 +1931,7 @@ func ScheduleRefreshLoadBalancerRoleExternalSettings(
 		if dr.Name == role {
 			settings.TfReplaceRoleExternalSettings.Protocol = dr.Protocol
 			settings.TfReplaceRoleExternalSettings.Port = dr.Port
+			settings.TfReplaceRoleExternalSettings.AllowedSourceRanges = slices.Clone(dr.AllowedSourceRanges)
 			if dr.Port == manifest.APIServerPort {
 				settings.TfReplaceRoleExternalSettings.RoleType = spec.RoleType_ApiServer
 			} else {
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"slices"
 	"time"
 
 	"github.com/berops/claudie/internal/nodepools"
@@ -228,6 +229,77 @@ func ScheduleRefreshVPN(usesProxy bool, current *spec.Clusters) *spec.TaskEvent
 	}
 }
 
+// Schedules a [spec.TaskEvent] task for deploying or removing the controller of the Kubernetes
+// Services of type LoadBalancer, if the designation of the loadbalancers for the services, or the
+// token with which the controller authenticates, differs between the current and desired state.
+// Returns nil otherwise.
+//
+// The returned [spec.TaskEvent] does not point to or share any memory with the two passed in states.
+func ScheduleReconcileServiceController(current, desired *spec.Clusters) *spec.TaskEvent {
+	var (
+		inFlight = proto.Clone(current).(*spec.Clusters)
+		changed  bool
+	)
+
+	for _, lb := range inFlight.GetLoadBalancers().GetClusters() {
+		idx := slices.IndexFunc(desired.GetLoadBalancers().GetClusters(), func(d *spec.LBcluster) bool {
+			return d.ClusterInfo.Name == lb.ClusterInfo.Name
+		})
+		if idx < 0 {
+			continue
+		}
+
+		slb := desired.LoadBalancers.Clusters[idx].GetServiceLoadBalancer()
+		if lb.GetServiceLoadBalancer().GetToken() == slb.GetToken() {
+			continue
+		}
+
+		changed = true
+		lb.ServiceLoadBalancer = proto.CloneOf(slb)
+	}
+
+	if !changed {
+		return nil
+	}
+
+	kuber := spec.Stage_Kuber{
+		Kuber: &spec.StageKuber{
+			Description: &spec.StageDescription{
+				About:      "Reconciling controller for services of type LoadBalancer",
+				ErrorLevel: spec.ErrorLevel_ERROR_FATAL,
+			},
+			SubPasses: []*spec.StageKuber_SubPass{
+				{
+					Kind: spec.StageKuber_DEPLOY_SERVICE_CONTROLLER,
+					Description: &spec.StageDescription{
+						About:      "Deploying or removing controller for services of type LoadBalancer",
+						ErrorLevel: spec.ErrorLevel_ERROR_WARN,
+					},
+				},
+			},
+		},
+	}
+
+	return &spec.TaskEvent{
+		Id:        uuid.New().String(),
+		Timestamp: timestamppb.New(time.Now().UTC()),
+		Event:     spec.Event_UPDATE,
+		Task: &spec.Task{
+			Do: &spec.Task_Update{
+				Update: &spec.Update{
+					State: &spec.Update_State{
+						K8S:           inFlight.K8S,
+						LoadBalancers: inFlight.LoadBalancers.Clusters,
+					},
+					Delta: new(spec.Update_None_),
+				},
+			},
+		},
+		Description: "Reconciling controller for services of type LoadBalancer",
+		Pipeline:    []*spec.Stage{{StageKind: &kuber}},
+	}
+}
+
 func ScheduleRefreshInfrastructure(current *spec.Clusters) *spec.TaskEvent {
 	inFlight := proto.Clone(current).(*spec.Clusters)
 	// For the refresh it is expected that the cluster already exists
@@ -472,6 +544,16 @@ func CreatePipeline(clusters *spec.Clusters, isCreate bool) []*spec.Stage {
 		})
 	}
 
+	if slices.ContainsFunc(clusters.LoadBalancers.Clusters, func(lb *spec.LBcluster) bool { return lb.ServiceLoadBalancer != nil }) {
+		kuber.Kuber.SubPasses = append(kuber.Kuber.SubPasses, &spec.StageKuber_SubPass{
+			Kind: spec.StageKuber_DEPLOY_SERVICE_CONTROLLER,
+			Description: &spec.StageDescription{
+				About:      "Deploying controller for services of type LoadBalancer",
+				ErrorLevel: spec.ErrorLevel_ERROR_WARN,
+			},
+		})
+	}
+
 	if len(clusters.LoadBalancers.Clusters) > 0 {
 		kuber.Kuber.SubPasses = append(kuber.Kuber.SubPasses, &spec.StageKuber_SubPass{
 			Kind: spec.StageKuber_STORE_LB_SCRAPE_CONFIG,
@@ -438,6 +438,68 @@ Fix the unreachable nodes by either:
 	return nil, nil
 }
 
+// HandleLoadBalancerUnreachableDns checks whether the DNS records of the loadbalancers point to nodes
+// with unknown status, or whether they leave out nodes that are reachable again. If so, a task updating
+// only the DNS records is returned, without waiting for the unreachable nodes to be reconciled, which
+// may require input from the user. Returns nil if the records of all the loadbalancers are up to date.
+//
+// If an [*spec.TaskEvent] is scheduled it does not point to or share any memory with the passed in state.
+func HandleLoadBalancerUnreachableDns(current *spec.Clusters, ns UnknownNodeStatus) *spec.TaskEvent {
+	for i, lb := range current.GetLoadBalancers().GetClusters() {
+		if lb.Dns == nil {
+			continue
+		}
+
+		unreachable := unreachableDnsEndpoints(lb, ns.UnknownLoadBalancersNodes[lb.ClusterInfo.Id()])
+		if slices.Equal(unreachable, lb.Dns.UnreachableEndpoints) {
+			continue
+		}
+
+		id := LoadBalancerIdentifier{
+			Id:    lb.ClusterInfo.Id(),
+			Index: i,
+		}
+		return ScheduleReplaceDnsRecords(current, id, unreachable)
+	}
+	return nil
+}
+
+// unreachableDnsEndpoints returns the public endpoints of the unreachable nodes of the loadbalancer
+// which should be left out of its DNS records. If none of the nodes are reachable, nil is returned,
+// as it is more likely that claudie itself has connectivity issues and the records are left as is.
+func unreachableDnsEndpoints(lb *spec.LBcluster, unreachable UnreachableIPv4Map) []string {
+	var (
+		endpoints []string
+		reachable bool
+	)
+
+	for _, np := range lb.ClusterInfo.NodePools {
+		for _, n := range np.Nodes {
+			if slices.Contains(unreachable[np.Name], n.Public) {
+				endpoints = append(endpoints, n.Public)
+			} else {
+				reachable = true
+			}
+		}
+	}
+
+	if !reachable {
+		return nil
+	}
+	return endpoints
+}
+
+// hasUnreachableDnsEndpoints returns true if the DNS records of any of the
+// loadbalancers leave out nodes, which were found unreachable.
+func hasUnreachableDnsEndpoints(current *spec.Clusters) bool {
+	for _, lb := range current.GetLoadBalancers().GetClusters() {
+		if len(lb.GetDns().GetUnreachableEndpoints()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // Deletes the loadbalancer with the id specified in the passed in lb from the [spec.Clusters] state. The deletion
 // Only deletes the Infrastructure if any. Contrary to how the [ScheduleDeleteLoadBalancer] works this function will
 // not run/execute any other stages as part of its delete pipeline. This task is useful for scenarios where the loadbalancer
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleLoadBalancerUnreachableDns(t *testing.T) {
+	lb := &spec.LBcluster{
+		ClusterInfo: &spec.ClusterInfo{
+			Name: "lb",
+			Hash: "hash",
+			NodePools: []*spec.NodePool{
+				{Name: "np-1", Nodes: []*spec.Node{{Name: "n-1", Public: "1.1.1.1"}, {Name: "n-2", Public: "1.1.1.2"}}},
+				{Name: "np-2", Nodes: []*spec.Node{{Name: "n-3", Public: "1.1.1.3"}}},
+			},
+		},
+		Dns: &spec.DNS{DnsZone: "example.com", Endpoint: "lb.example.com"},
+	}
+	current := &spec.Clusters{
+		K8S:           &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{Name: "k8s", Hash: "hash"}},
+		LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{lb}},
+	}
+	unreachable := func(ips map[string][]string) UnknownNodeStatus {
+		return UnknownNodeStatus{UnknownLoadBalancersNodes: map[string]UnreachableIPv4Map{lb.ClusterInfo.Id(): ips}}
+	}
+
+	// all nodes reachable, records up to date.
+	assert.Nil(t, HandleLoadBalancerUnreachableDns(current, UnknownNodeStatus{}))
+	assert.False(t, hasUnreachableDnsEndpoints(current))
+
+	// unreachable nodes are left out of the records.
+	event := HandleLoadBalancerUnreachableDns(current, unreachable(map[string][]string{"np-1": {"1.1.1.2"}, "np-2": {"1.1.1.3"}}))
+	require.NotNil(t, event)
+	replace := event.GetTask().GetUpdate().GetTfReplaceDnsRecords()
+	assert.Equal(t, lb.ClusterInfo.Id(), replace.GetHandle())
+	assert.Equal(t, []string{"1.1.1.2", "1.1.1.3"}, replace.GetUnreachableEndpoints())
+	assert.Len(t, event.Pipeline, 1)
+	assert.Equal(t, spec.StageTerraformer_UPDATE_INFRASTRUCTURE, event.Pipeline[0].GetTerraformer().SubPasses[0].Kind)
+
+	// records already leave out the unreachable nodes.
+	lb.Dns.UnreachableEndpoints = []string{"1.1.1.2", "1.1.1.3"}
+	assert.True(t, hasUnreachableDnsEndpoints(current))
+	assert.Nil(t, HandleLoadBalancerUnreachableDns(current, unreachable(map[string][]string{"np-1": {"1.1.1.2"}, "np-2": {"1.1.1.3"}})))
+
+	// nodes reachable again are restored.
+	event = HandleLoadBalancerUnreachableDns(current, UnknownNodeStatus{})
+	require.NotNil(t, event)
+	assert.Empty(t, event.GetTask().GetUpdate().GetTfReplaceDnsRecords().GetUnreachableEndpoints())
+
+	// with none of the nodes reachable, the records point to all of them.
+	event = HandleLoadBalancerUnreachableDns(current, unreachable(map[string][]string{"np-1": {"1.1.1.1", "1.1.1.2"}, "np-2": {"1.1.1.3"}}))
+	require.NotNil(t, event)
+	assert.Empty(t, event.GetTask().GetUpdate().GetTfReplaceDnsRecords().GetUnreachableEndpoints())
+
+	// the scheduled task does not share memory with the state.
+	event.GetTask().GetUpdate().GetState().GetLoadBalancers()[0].Dns.UnreachableEndpoints = nil
+	assert.Equal(t, []string{"1.1.1.2", "1.1.1.3"}, lb.Dns.UnreachableEndpoints)
+}
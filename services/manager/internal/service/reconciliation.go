@@ -1,10 +1,13 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	"github.com/berops/claudie/internal/clusters"
+	"github.com/berops/claudie/internal/kube"
 	"github.com/berops/claudie/internal/loggerutils"
 	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
@@ -40,10 +43,35 @@ const (
 	NotReady
 )
 
+// reconciliateOpts alters the behaviour of [reconciliate].
+type reconciliateOpts struct {
+	// dryRun only plans the tasks, which are neither stored nor
+	// scheduled. Disables any side effects outside of the passed
+	// in config, such as storing or deleting the kubeconfig
+	// and metadata secrets in the management cluster.
+	dryRun bool
+
+	// outsideLoop schedules real tasks outside of the reconciliation
+	// loop, e.g. from within a RPC handler. The side effects of the
+	// scheduled tasks, such as the secrets in the management cluster,
+	// are handled as within the loop.
+	outsideLoop bool
+}
+
+// loop returns true if called by the reconciliation loop. The work done
+// periodically by the loop is skipped outside of it and left for its next
+// iteration, that is:
+//   - the infrastructure is not health checked, the health status recorded
+//     by the last iteration of the loop is reused instead, see [healthChecks].
+//   - the post-join hooks are not called.
+//   - the metrics of the unreachable nodes are not exported.
+func (o reconciliateOpts) loop() bool { return !o.dryRun && !o.outsideLoop }
+
 // Schedules tasks based on the difference between the current and desired state.
 // No changes to the passed in values are done. The passed in `desired` and `pending`
-// states will not be modified in any way.
-func reconciliate(pending *spec.Config, desiredStates map[string]*spec.Clusters) ScheduleResult {
+// states will not be modified in any way. The passed in context bounds any work
+// started in the background, such as the calls of the post-join hooks.
+func reconciliate(ctx context.Context, pending *spec.Config, desiredStates map[string]*spec.Clusters, opts reconciliateOpts) ScheduleResult {
 	PopulateEntriesForNewClusters(&pending.Clusters, desiredStates)
 
 	clusterResult := make(map[string]ScheduleResult, len(pending.Clusters))
@@ -56,6 +84,10 @@ func reconciliate(pending *spec.Config, desiredStates map[string]*spec.Clusters)
 		var (
 			logger = loggerutils.WithProjectAndCluster(pending.Name, cluster)
 
+			// Without any maintenance windows evaluated
+			// all changes are allowed to be worked on.
+			gate = maintenanceGate{open: true}
+
 			// It is guaranteed by validation, that within a single InputManifest
 			// no two clusters (including LB) can share the same name.
 			current      = state.Current
@@ -70,6 +102,25 @@ func reconciliate(pending *spec.Config, desiredStates map[string]*spec.Clusters)
 			isDestroy = (!isCurrentNil || hasInFlightState) && isDesiredNil
 		)
 
+		if state.Paused {
+			// Paused clusters are not touched in any way, including health
+			// checks and refreshes of the infrastructure, until resumed.
+			clusterResult[cluster] = Noop
+
+			if state.State == nil {
+				state.State = &spec.Workflow{}
+			}
+
+			if state.State.Status != spec.Workflow_PAUSED {
+				logger.Info().Msg("Reconciliation of the cluster is paused, skipping")
+
+				state.State.Status = spec.Workflow_PAUSED
+				state.State.Description = "Reconciliation of the cluster is paused"
+				clusterResult[cluster] = NotReady
+			}
+			continue
+		}
+
 	event_switch:
 		switch {
 		case noop:
@@ -133,24 +184,33 @@ func reconciliate(pending *spec.Config, desiredStates map[string]*spec.Clusters)
 				del = clustersUnion(current, cs)
 			}
 
-			if err := managementcluster.DeleteKubeconfig(del); err != nil {
-				logger.Err(err).Msg("Failed to delete kubeconfig secret in the management cluster")
-			}
+			if !opts.dryRun {
+				if err := managementcluster.DeleteKubeconfig(del); err != nil {
+					logger.Err(err).Msg("Failed to delete kubeconfig secret in the management cluster")
+				}
+
+				if err := managementcluster.DeleteClusterMetadata(del); err != nil {
+					logger.Err(err).Msg("Failed to delete metadata secret in the management cluster")
+				}
 
-			if err := managementcluster.DeleteClusterMetadata(del); err != nil {
-				logger.Err(err).Msg("Failed to delete metadata secret in the management cluster")
+				if del.GetK8S() != nil {
+					kube.Forget(del.K8S.ClusterInfo.Id())
+					healthChecks.forget(del.K8S.ClusterInfo.Id())
+				}
 			}
 
 			state.InFlight = ScheduleDeleteCluster(del)
 		default:
-			if err := managementcluster.StoreKubeconfig(pending.Name, current); err != nil {
-				logger.Err(err).Msg("Failed to store kubeconfig in the management cluster")
-			}
+			if !opts.dryRun {
+				if err := managementcluster.StoreKubeconfig(pending.Name, current); err != nil {
+					logger.Err(err).Msg("Failed to store kubeconfig in the management cluster")
+				}
 
-			if err := managementcluster.StoreClusterMetadata(pending.Name, current); err != nil {
-				logger.
-					Err(err).
-					Msg("Failed to store cluster metadata secret in the management cluster")
+				if err := managementcluster.StoreClusterMetadata(pending.Name, current); err != nil {
+					logger.
+						Err(err).
+						Msg("Failed to store cluster metadata secret in the management cluster")
+				}
 			}
 
 			clusterResult[cluster] = Noop
@@ -221,7 +281,7 @@ func reconciliate(pending *spec.Config, desiredStates map[string]*spec.Clusters)
 			// or healthchecks, as this is a pure loopback task which
 			// does not leave the manager service and can be updated
 			// in-place.
-			var updatedCredentials bool
+			var updatedCredentials, updatedSettings bool
 			if state.InFlight != nil {
 				inFlight, err := state.InFlight.Task.MutableClusters()
 				if err != nil {
@@ -233,8 +293,19 @@ Failed to extract state from failed 'InFlight' state: %v
 					break event_switch
 				}
 				updatedCredentials = updateCredentials(inFlight, desiredState)
+				updatedSettings = updateNodeHooks(inFlight, desiredState)
+				updatedSettings = updateDrainPolicies(inFlight, desiredState) || updatedSettings
 			}
 			updatedCredentials = updateCredentials(current, desiredState) || updatedCredentials
+			updatedSettings = updateNodeHooks(current, desiredState) || updatedSettings
+			updatedSettings = updateDrainPolicies(current, desiredState) || updatedSettings
+
+			if updatedSettings {
+				// Unlike the credentials, the node hooks and drain policies are
+				// not used by the diffs, thus the iteration continues with the update.
+				clusterResult[cluster] = NotReady
+				logger.Info().Msg("Propagating node hooks and drain policies update")
+			}
 
 			if updatedCredentials {
 				clusterResult[cluster] = NotReady
@@ -280,11 +351,41 @@ Failed to extract state from failed 'InFlight' state: %v
 			// is made all of the current,desired [spec.Clusters] state is considered
 			// immutable and is not modified to not invalidate cached indices for the
 			// returned diffs.
-			logger.Debug().Msg("Health checking current state")
-
-			hc := HealthCheck(logger, current)
+			var (
+				hc          HealthCheckStatus
+				nodesStatus UnknownNodeStatus
+				err         error
+			)
+			if opts.loop() {
+				logger.Debug().Msg("Health checking current state")
+
+				hc = HealthCheck(logger, current)
+				nodesStatus, err = CheckNodesStatus(logger, current, hc)
+				if err == nil {
+					healthChecks.record(current.K8S.ClusterInfo.Id(), hc, nodesStatus)
+				}
+			} else {
+				hc, nodesStatus = healthChecks.last(current)
+			}
 			diff := Diff(current, localDesired)
-			nodesStatus, err := CheckNodesStatus(logger, current, hc)
+
+			// Disruptive changes are only worked on within the maintenance windows
+			// of the cluster, a rollback of a failed task is not subject to them.
+			if lastTask == nil {
+				// Nodes not yet approved by the post-join hook hold back
+				// the next batch of the rolling updates in progress.
+				//
+				// The hooks are called in the background, the nodes approved
+				// by them are picked up in the next iterations of the loop.
+				hc.Cluster.PostJoinHookPending = postJoinHooks.pending(current.K8S, &hc)
+				if opts.loop() {
+					postJoinHooks.dispatch(ctx, logger, current.K8S, hc.Cluster.PostJoinHookPending)
+				}
+
+				TrackRollingUpdatesInProgress(&diff.Kubernetes, current.K8S, localDesired.K8S)
+				gate = newMaintenanceGate(logger, desiredState.K8S.GetMaintenanceWindows(), time.Now())
+				gate.deferDiff(&diff)
+			}
 			if err != nil {
 				clusterResult[cluster] = NotReady
 
@@ -298,6 +399,10 @@ Failed to extract state from failed 'InFlight' state: %v
 				break event_switch
 			}
 
+			if opts.loop() {
+				setUnreachableNodes(pending.Name, cluster, current, nodesStatus)
+			}
+
 			if shouldRescheduleInFlight(lastTask) {
 				clusterResult[cluster] = Reschedule
 
@@ -367,7 +472,7 @@ Failed to extract state from failed 'InFlight' state: %v
 				break
 			}
 
-			if state.InFlight = handleUpdate(hc, diff, current, localDesired); state.InFlight != nil {
+			if state.InFlight = gate.deferTask(handleUpdate(hc, diff, current, localDesired)); state.InFlight != nil {
 				clusterResult[cluster] = Reschedule
 
 				logger.
@@ -429,7 +534,9 @@ Failed to extract state from failed 'InFlight' state: %v
 						len(nodesStatus.UnknownKubernetesNodes) +
 						len(nodesStatus.UnknownLoadBalancersNodes)
 
-					if pendingUnhealthy > 0 {
+					// Nodes left out of the DNS records need to be added
+					// back once they're reachable again.
+					if pendingUnhealthy > 0 || hasUnreachableDnsEndpoints(current) {
 						// Check the reachability of the build infrastructure to avoid any
 						// ssues with unreachable nodes.
 						//
@@ -497,6 +604,10 @@ Failed to extract state from failed 'InFlight' state: %v
 			}
 		case NotReady, Noop:
 		}
+
+		if state.State != nil {
+			state.State.Deferred = gate.reason()
+		}
 	}
 
 	var (
@@ -723,6 +834,10 @@ func handleUpdate(hc HealthCheckStatus, diff DiffResult, current, desired *spec.
 		return next
 	}
 
+	if next := ScheduleReconcileServiceController(current, desired); next != nil {
+		return next
+	}
+
 	if hc.Cluster.VpnDrift {
 		return ScheduleRefreshVPN(kr.Diff.Proxy.CurrentUsed, current)
 	}
@@ -802,6 +917,13 @@ func handleClusterUnknownNodes(
 		Desired:    desired,
 	}
 
+	// Point the DNS of the loadbalancers only to the reachable nodes first,
+	// as reconciling the unreachable nodes can take a while, or may require
+	// input from the user.
+	if next := HandleLoadBalancerUnreachableDns(current, ns); next != nil {
+		return next, nil
+	}
+
 	// Handle loadbalancers first.
 	next, err := HandleLoadBalancerUnknownNodes(lbr)
 	if err != nil {
@@ -917,6 +1039,12 @@ func updateNodePoolCredentials(current, desired *spec.NodePool) (updated bool) {
 			return
 		}
 
+		// The provider of the nodepool is changed by a rolling update, the current
+		// nodepool keeps the credentials of its provider until it is deleted.
+		if cnp.DynamicNodePool.Provider.GetSpecName() != dnp.DynamicNodePool.Provider.GetSpecName() {
+			return
+		}
+
 		if !cnp.DynamicNodePool.Provider.CredentialsEqual(dnp.DynamicNodePool.Provider) {
 			if cnp.DynamicNodePool.Provider.CopyCredentials(dnp.DynamicNodePool.Provider) {
 				updated = true
@@ -943,3 +1071,21 @@ func updateNodePoolCredentials(current, desired *spec.NodePool) (updated bool) {
 
 	return
 }
+
+// updateDrainPolicies updates the drain policies of the nodepools of the kubernetes
+// cluster of the passed in current state to the ones of the desired state.
+func updateDrainPolicies(current, desired *spec.Clusters) (updated bool) {
+	for _, cnp := range current.GetK8S().GetClusterInfo().GetNodePools() {
+		dnp := nodepools.FindByName(cnp.Name, desired.GetK8S().GetClusterInfo().GetNodePools())
+		if dnp == nil || proto.Equal(cnp.DrainPolicy, dnp.DrainPolicy) {
+			continue
+		}
+
+		cnp.DrainPolicy = nil
+		if dnp.DrainPolicy != nil {
+			cnp.DrainPolicy = proto.Clone(dnp.DrainPolicy).(*spec.DrainPolicy)
+		}
+		updated = true
+	}
+	return
+}
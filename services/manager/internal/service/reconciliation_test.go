@@ -259,3 +259,67 @@ func TestUpdateNodePoolCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateDrainPolicies(t *testing.T) {
+	withPolicy := func(name string, policy *spec.DrainPolicy) *spec.Clusters {
+		np := staticNodePool(nil)
+		np.Name = name
+		np.DrainPolicy = policy
+		return &spec.Clusters{K8S: &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{NodePools: []*spec.NodePool{np}}}}
+	}
+
+	current := withPolicy("np", nil)
+	desired := withPolicy("np", &spec.DrainPolicy{Timeout: "1h", FailOnTimeout: true})
+
+	if !updateDrainPolicies(current, desired) {
+		t.Fatal("expected the drain policy to be updated")
+	}
+	if got := current.K8S.ClusterInfo.NodePools[0].DrainPolicy; got.GetTimeout() != "1h" || !got.GetFailOnTimeout() {
+		t.Errorf("DrainPolicy = %v, want the desired policy", got)
+	}
+	if updateDrainPolicies(current, desired) {
+		t.Error("expected no update for equal drain policies")
+	}
+	if updateDrainPolicies(current, withPolicy("other", nil)) {
+		t.Error("expected no update for a nodepool missing in the desired state")
+	}
+}
+
+func TestScheduleReconcileServiceController(t *testing.T) {
+	withToken := func(token string) *spec.Clusters {
+		lb := &spec.LBcluster{ClusterInfo: &spec.ClusterInfo{Name: "lb"}, TargetedK8S: "k8s"}
+		if token != "" {
+			lb.ServiceLoadBalancer = &spec.ServiceLoadBalancer{MinPort: 30000, MaxPort: 30100, Token: token}
+		}
+		return &spec.Clusters{
+			K8S:           &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{Name: "k8s"}},
+			LoadBalancers: &spec.LoadBalancers{Clusters: []*spec.LBcluster{lb}},
+		}
+	}
+
+	if next := ScheduleReconcileServiceController(withToken("a"), withToken("a")); next != nil {
+		t.Errorf("expected no task for unchanged designation, got %q", next.Description)
+	}
+
+	for _, tt := range []struct{ name, current, desired string }{
+		{name: "designated", current: "", desired: "a"},
+		{name: "removed", current: "a", desired: ""},
+		{name: "token", current: "a", desired: "b"},
+	} {
+		current := withToken(tt.current)
+		next := ScheduleReconcileServiceController(current, withToken(tt.desired))
+		if next == nil {
+			t.Fatalf("%s: expected task to be scheduled", tt.name)
+		}
+		got := next.Task.GetUpdate().GetState().GetLoadBalancers()[0].GetServiceLoadBalancer().GetToken()
+		if got != tt.desired {
+			t.Errorf("%s: token = %q, want %q", tt.name, got, tt.desired)
+		}
+		if token := current.LoadBalancers.Clusters[0].GetServiceLoadBalancer().GetToken(); token != tt.current {
+			t.Errorf("%s: current state modified, token = %q", tt.name, token)
+		}
+		if kind := next.Pipeline[0].GetKuber().GetSubPasses()[0].GetKind(); kind != spec.StageKuber_DEPLOY_SERVICE_CONTROLLER {
+			t.Errorf("%s: subpass = %v, want %v", tt.name, kind, spec.StageKuber_DEPLOY_SERVICE_CONTROLLER)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/berops/claudie/internal/hash"
 	"github.com/berops/claudie/internal/nodepools"
@@ -9,14 +11,40 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+// RequiresRollingUpdate returns true if the dynamic nodepool in the desired state
+// can not be reconciled in-place with the current state and needs to be replaced
+// by a new nodepool, i.e. its templates or its infrastructure spec changed.
+func RequiresRollingUpdate(current, desired *spec.DynamicNodePool) bool {
+	if current.Provider.Templates.CommitHash != desired.Provider.Templates.CommitHash {
+		return true
+	}
+	return !sameInfrastructureSpec(current, desired)
+}
+
+// sameInfrastructureSpec returns true if both of the dynamic nodepools
+// would result in the same VMs, when spawned. Fields that can be changed
+// in-place such as the count or the autoscaler config are not compared.
+func sameInfrastructureSpec(a, b *spec.DynamicNodePool) bool {
+	equal := a.Provider.GetSpecName() == b.Provider.GetSpecName()
+	equal = equal && a.ExternalNetworkName == b.ExternalNetworkName
+	equal = equal && a.ServerType == b.ServerType
+	equal = equal && a.Image == b.Image
+	equal = equal && a.StorageDiskSize == b.StorageDiskSize
+	equal = equal && a.Region == b.Region
+	equal = equal && a.Zone == b.Zone
+	equal = equal && proto.Equal(a.MachineSpec, b.MachineSpec)
+	return equal
+}
+
 // Creates a new nodepool from the 'nodepool' reference passed in 'current'
-// state [spec.Clusters]. The newly returned nodepool does not share any memory
-// with any of the passed in parameters and can be use as standalone.
+// state [spec.Clusters] with the infrastructure spec and templates of the
+// passed in 'desired' dynamic nodepool. The newly returned nodepool does not
+// share any memory with any of the passed in parameters and can be use as standalone.
 func CreateNodePoolForRollingUpdate(
 	current *spec.Clusters,
 	cid *LoadBalancerIdentifier, // optional, if set will look inside loadbalancer.
 	nodepool string,
-	newTemplates *spec.TemplateRepository,
+	desired *spec.DynamicNodePool,
 ) (*spec.NodePool, error) {
 	clusterId := current.K8S.ClusterInfo.Id()
 	search := current.K8S.ClusterInfo.NodePools
@@ -65,7 +93,26 @@ func CreateNodePoolForRollingUpdate(
 		return nil, err
 	}
 
-	// 3. Generate new CIDRs
+	// 3. Replace the provider, the infrastructure spec and the count.
+	inner.Count = desired.Count
+	inner.RollingUpdate = nil
+	if desired.RollingUpdate != nil {
+		inner.RollingUpdate = proto.Clone(desired.RollingUpdate).(*spec.RollingUpdateStrategy)
+	}
+	inner.Provider = proto.Clone(desired.Provider).(*spec.Provider)
+	inner.ExternalNetworkName = desired.ExternalNetworkName
+	inner.ServerType = desired.ServerType
+	inner.Image = desired.Image
+	inner.StorageDiskSize = desired.StorageDiskSize
+	inner.Region = desired.Region
+	inner.Zone = desired.Zone
+	inner.MachineSpec = nil
+	if desired.MachineSpec != nil {
+		inner.MachineSpec = proto.Clone(desired.MachineSpec).(*spec.MachineSpec)
+	}
+
+	// 4. Generate new CIDRs, after the provider and region are
+	// replaced, as the CIDRs are unique per provider and region.
 	{
 		wantedDesiredState := proto.Clone(current).(*spec.Clusters)
 
@@ -81,9 +128,6 @@ func CreateNodePoolForRollingUpdate(
 		}
 	}
 
-	// 4. Replace Templates
-	inner.Provider.Templates = proto.Clone(newTemplates).(*spec.TemplateRepository)
-
 	// 5. Generate Nodes.
 	PopulateDynamicNodes(clusterId, newNodePool)
 
@@ -91,3 +135,156 @@ func CreateNodePoolForRollingUpdate(
 
 	return newNodePool, nil
 }
+
+// TrackRollingUpdatesInProgress looks for nodepools in the passed in [KubernetesDiffResult]
+// that are deleted as their replacement, with the new templates and infrastructure spec,
+// is already part of the current state. For each such nodepool the deletion of the nodepool
+// and the addition of the remaining nodes into its replacement is removed from the diff and
+// is instead tracked in [KubernetesDiffResult.RollingUpdatesInProgress], so that the nodes
+// can be replaced in batches following the [spec.RollingUpdateStrategy] of the nodepool.
+//
+// Must not be called when rolling back to a previous state, as the state that is being
+// rolled back to could be interpreted as a replacement of the current nodepool.
+func TrackRollingUpdatesInProgress(diff *KubernetesDiffResult, current, desired *spec.K8Scluster) {
+	diff.RollingUpdatesInProgress = make(RollingUpdatesInProgressViewType)
+
+	for replaced := range diff.Dynamic.Deleted {
+		cnp := nodepools.FindByName(replaced, current.GetClusterInfo().GetNodePools())
+		if cnp.GetDynamicNodePool() == nil {
+			continue
+		}
+
+		typ, _ := nodepools.MustExtractNameAndHash(replaced)
+		for _, dnp := range desired.GetClusterInfo().GetNodePools() {
+			ddyn := dnp.GetDynamicNodePool()
+
+			isReplacement := ddyn != nil && dnp.IsControl == cnp.IsControl
+			isReplacement = isReplacement && nodepools.HasNodePoolTypeOf(typ, dnp.Name)
+			if !isReplacement {
+				continue
+			}
+
+			// The replacement must already be in the current state with
+			// the infrastructure spec of the desired state.
+			if _, ok := diff.Dynamic.Added[dnp.Name]; ok {
+				continue
+			}
+			if _, ok := diff.RollingUpdates[dnp.Name]; ok {
+				continue
+			}
+			if !RequiresRollingUpdate(cnp.GetDynamicNodePool(), ddyn) {
+				continue
+			}
+
+			diff.RollingUpdatesInProgress[replaced] = RollingUpdateProgress{
+				Replacement: dnp.Name,
+				Pending:     diff.Dynamic.PartiallyAdded[dnp.Name],
+				Target:      ddyn.Count,
+				Strategy:    proto.Clone(ddyn.GetRollingUpdate()).(*spec.RollingUpdateStrategy),
+			}
+
+			delete(diff.Dynamic.Deleted, replaced)
+			delete(diff.Dynamic.PartiallyAdded, dnp.Name)
+			break
+		}
+	}
+}
+
+// nextRollingUpdateBatch determines the next batch of the rolling update of the
+// 'replaced' nodepool. The returned diff either contains the nodes to be added
+// into the replacement nodepool or the nodes to be deleted from the replaced
+// nodepool. Returns nil if the nodes of the replacement nodepool are not yet
+// all joined and ready within the cluster or if there is nothing to be done.
+func nextRollingUpdateBatch(
+	hc *HealthCheckStatus,
+	current *spec.K8Scluster,
+	replaced string,
+	progress RollingUpdateProgress,
+) *NodePoolsDiffResult {
+	var (
+		old         = nodepools.FindByName(replaced, current.ClusterInfo.NodePools)
+		replacement = nodepools.FindByName(progress.Replacement, current.ClusterInfo.NodePools)
+	)
+	if old == nil || replacement == nil {
+		return nil
+	}
+
+	// Wait for the previous batch to be joined and ready
+	// and approved by the post-join hook, if any.
+	for _, n := range replacement.Nodes {
+		// kubernetes names have stripped cluster prefix.
+		k8sName := strings.TrimPrefix(n.Name, fmt.Sprintf("%s-", current.ClusterInfo.Id()))
+		if d, ok := hc.Cluster.Nodes[k8sName]; n.Status != spec.NodeStatus_Joined || !ok || !d.Ready {
+			return nil
+		}
+		if _, pending := hc.Cluster.PostJoinHookPending[k8sName]; pending {
+			return nil
+		}
+	}
+
+	var (
+		a                  = int32(len(old.Nodes))
+		b                  = int32(len(replacement.Nodes))
+		n                  = progress.Target
+		surge, unavailable = progress.Strategy.Resolve(n)
+	)
+
+	add := min(n-b, n+surge-(a+b), int32(len(progress.Pending)))
+	if add > 0 {
+		return &NodePoolsDiffResult{
+			PartiallyAdded: NodePoolsViewType{progress.Replacement: progress.Pending[:add]},
+		}
+	}
+
+	del := min(a, a+b-(n-unavailable))
+	if del <= 0 {
+		return nil
+	}
+
+	// The API endpoint is moved to the replacement nodepool before
+	// it can be deleted, thus it is always deleted as the last node.
+	var deletable []string
+	for _, node := range slices.Backward(old.Nodes) {
+		if node.NodeType != spec.NodeType_apiEndpoint {
+			deletable = append(deletable, node.Name)
+		}
+	}
+
+	if del == a && int32(len(deletable)) == a {
+		return &NodePoolsDiffResult{
+			Deleted: NodePoolsViewType{replaced: deletable},
+		}
+	}
+
+	if len(deletable) > 0 {
+		return &NodePoolsDiffResult{
+			PartiallyDeleted: NodePoolsViewType{replaced: deletable[:min(del, int32(len(deletable)))]},
+		}
+	}
+
+	// Only the API endpoint is left which can't be moved yet
+	// as the replacement nodepool has no nodes, surge by 1 node.
+	if len(progress.Pending) > 0 {
+		return &NodePoolsDiffResult{
+			PartiallyAdded: NodePoolsViewType{progress.Replacement: progress.Pending[:1]},
+		}
+	}
+
+	return nil
+}
+
+// describeRollingUpdate prefixes the description of the task with the progress of the rolling update.
+func describeRollingUpdate(te *spec.TaskEvent, replaced, replacement string, updated, target int) *spec.TaskEvent {
+	if te == nil {
+		return nil
+	}
+	te.Description = fmt.Sprintf(
+		"Rolling update of nodepool %s to %s, %v/%v nodes updated: %s",
+		replaced,
+		replacement,
+		updated,
+		target,
+		te.Description,
+	)
+	return te
+}
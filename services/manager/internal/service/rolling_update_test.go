@@ -0,0 +1,247 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func rollingUpdateNodePool(name, image string, nodes int, strategy *spec.RollingUpdateStrategy) *spec.NodePool {
+	np := &spec.NodePool{
+		Name: name,
+		Type: &spec.NodePool_DynamicNodePool{DynamicNodePool: &spec.DynamicNodePool{
+			ServerType:    "cpx22",
+			Image:         image,
+			Count:         int32(nodes),
+			Provider:      &spec.Provider{Templates: &spec.TemplateRepository{CommitHash: "commit"}},
+			RollingUpdate: strategy,
+		}},
+	}
+	for i := range nodes {
+		np.Nodes = append(np.Nodes, &spec.Node{
+			Name:   fmt.Sprintf("k8s-hash-%s-%02d", name, i+1),
+			Status: spec.NodeStatus_Joined,
+		})
+	}
+	return np
+}
+
+func TestRequiresRollingUpdate(t *testing.T) {
+	current := rollingUpdateNodePool("np", "image", 1, nil).GetDynamicNodePool()
+	current.Region = "fsn1"
+	current.Zone = "fsn1-dc14"
+	current.Provider.SpecName = "hetzner-1"
+
+	tests := []struct {
+		name   string
+		modify func(np *spec.DynamicNodePool)
+		want   bool
+	}{
+		{name: "unchanged", modify: func(*spec.DynamicNodePool) {}, want: false},
+		{name: "count", modify: func(np *spec.DynamicNodePool) { np.Count = 3 }, want: false},
+		{name: "image", modify: func(np *spec.DynamicNodePool) { np.Image = "image-2" }, want: true},
+		{name: "region", modify: func(np *spec.DynamicNodePool) { np.Region = "nbg1"; np.Zone = "nbg1-dc3" }, want: true},
+		{name: "zone", modify: func(np *spec.DynamicNodePool) { np.Zone = "" }, want: true},
+		{name: "provider", modify: func(np *spec.DynamicNodePool) { np.Provider.SpecName = "hetzner-2" }, want: true},
+		{name: "externalNetwork", modify: func(np *spec.DynamicNodePool) { np.ExternalNetworkName = "public" }, want: true},
+		{name: "templates", modify: func(np *spec.DynamicNodePool) { np.Provider.Templates.CommitHash = "commit-2" }, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := proto.Clone(current).(*spec.DynamicNodePool)
+			tt.modify(desired)
+			assert.Equal(t, tt.want, RequiresRollingUpdate(current, desired))
+		})
+	}
+}
+
+func TestTrackRollingUpdatesInProgress(t *testing.T) {
+	t.Parallel()
+
+	strategy := &spec.RollingUpdateStrategy{MaxSurge: "1"}
+	current := &spec.K8Scluster{InstallationProxy: &spec.InstallationProxy{Mode: ProxyOffMode}, ClusterInfo: &spec.ClusterInfo{
+		Name: "k8s",
+		Hash: "hash",
+		NodePools: []*spec.NodePool{
+			rollingUpdateNodePool("np-aaaaaaa", "ubuntu-22.04", 3, nil),
+			rollingUpdateNodePool("np-bbbbbbb", "ubuntu-24.04", 1, strategy),
+		},
+	}}
+
+	desired := proto.Clone(current).(*spec.K8Scluster)
+	desired.ClusterInfo.NodePools = []*spec.NodePool{rollingUpdateNodePool("np-bbbbbbb", "ubuntu-24.04", 3, strategy)}
+
+	diff := KubernetesDiff(current, desired)
+	assert.Contains(t, diff.Dynamic.Deleted, "np-aaaaaaa")
+	assert.Contains(t, diff.Dynamic.PartiallyAdded, "np-bbbbbbb")
+
+	TrackRollingUpdatesInProgress(&diff, current, desired)
+	assert.Empty(t, diff.Dynamic.Deleted)
+	assert.Empty(t, diff.Dynamic.PartiallyAdded)
+	assert.Len(t, diff.RollingUpdatesInProgress, 1)
+
+	progress := diff.RollingUpdatesInProgress["np-aaaaaaa"]
+	assert.Equal(t, "np-bbbbbbb", progress.Replacement)
+	assert.Equal(t, []string{"k8s-hash-np-bbbbbbb-02", "k8s-hash-np-bbbbbbb-03"}, progress.Pending)
+	assert.Equal(t, int32(3), progress.Target)
+	assert.True(t, proto.Equal(strategy, progress.Strategy))
+
+	// Deleting a nodepool with the same infrastructure spec is not a rolling update.
+	desired.ClusterInfo.NodePools = []*spec.NodePool{rollingUpdateNodePool("np-bbbbbbb", "ubuntu-22.04", 1, nil)}
+	diff = KubernetesDiff(current, desired)
+	TrackRollingUpdatesInProgress(&diff, current, desired)
+	assert.Contains(t, diff.Dynamic.Deleted, "np-aaaaaaa")
+	assert.Empty(t, diff.RollingUpdatesInProgress)
+}
+
+func TestNextRollingUpdateBatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		old      int
+		new      int
+		target   int
+		notReady bool
+		unhooked bool
+		apiOld   bool
+		strategy *spec.RollingUpdateStrategy
+		want     *NodePoolsDiffResult
+	}{
+		{
+			name:     "wait-for-ready",
+			old:      3,
+			new:      1,
+			target:   3,
+			notReady: true,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "1"},
+			want:     nil,
+		},
+		{
+			name:     "wait-for-post-join-hook",
+			old:      3,
+			new:      1,
+			target:   3,
+			unhooked: true,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "1"},
+			want:     nil,
+		},
+		{
+			name:     "delete-after-surge",
+			old:      3,
+			new:      1,
+			target:   3,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "1"},
+			want:     &NodePoolsDiffResult{PartiallyDeleted: NodePoolsViewType{"np-aaaaaaa": {"k8s-hash-np-aaaaaaa-03"}}},
+		},
+		{
+			name:     "add-after-delete",
+			old:      2,
+			new:      1,
+			target:   3,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "1"},
+			want:     &NodePoolsDiffResult{PartiallyAdded: NodePoolsViewType{"np-bbbbbbb": {"k8s-hash-np-bbbbbbb-02"}}},
+		},
+		{
+			name:     "max-unavailable",
+			old:      4,
+			new:      0,
+			target:   4,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "0", MaxUnavailable: "50%"},
+			want: &NodePoolsDiffResult{PartiallyDeleted: NodePoolsViewType{"np-aaaaaaa": {
+				"k8s-hash-np-aaaaaaa-04",
+				"k8s-hash-np-aaaaaaa-03",
+			}}},
+		},
+		{
+			name:     "surge-and-unavailable",
+			old:      3,
+			new:      2,
+			target:   4,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "1", MaxUnavailable: "1"},
+			want: &NodePoolsDiffResult{PartiallyDeleted: NodePoolsViewType{"np-aaaaaaa": {
+				"k8s-hash-np-aaaaaaa-03",
+				"k8s-hash-np-aaaaaaa-02",
+			}}},
+		},
+		{
+			name:     "delete-remaining",
+			old:      2,
+			new:      4,
+			target:   4,
+			strategy: nil,
+			want: &NodePoolsDiffResult{Deleted: NodePoolsViewType{"np-aaaaaaa": {
+				"k8s-hash-np-aaaaaaa-02",
+				"k8s-hash-np-aaaaaaa-01",
+			}}},
+		},
+		{
+			name:     "api-endpoint-last",
+			old:      2,
+			new:      4,
+			target:   4,
+			apiOld:   true,
+			strategy: nil,
+			want:     &NodePoolsDiffResult{PartiallyDeleted: NodePoolsViewType{"np-aaaaaaa": {"k8s-hash-np-aaaaaaa-02"}}},
+		},
+		{
+			name:     "api-endpoint-only",
+			old:      1,
+			new:      0,
+			target:   1,
+			apiOld:   true,
+			strategy: &spec.RollingUpdateStrategy{MaxSurge: "0", MaxUnavailable: "1"},
+			want:     &NodePoolsDiffResult{PartiallyAdded: NodePoolsViewType{"np-bbbbbbb": {"k8s-hash-np-bbbbbbb-01"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				old         = rollingUpdateNodePool("np-aaaaaaa", "ubuntu-22.04", tt.old, nil)
+				replacement = rollingUpdateNodePool("np-bbbbbbb", "ubuntu-24.04", tt.new, tt.strategy)
+				desired     = rollingUpdateNodePool("np-bbbbbbb", "ubuntu-24.04", tt.target, tt.strategy)
+				hc          HealthCheckStatus
+			)
+			if tt.apiOld {
+				old.Nodes[0].NodeType = spec.NodeType_apiEndpoint
+			}
+
+			hc.Cluster.Nodes = make(map[string]*NodeDescription)
+			for _, n := range replacement.Nodes {
+				k8sName := n.Name[len("k8s-hash-"):]
+				hc.Cluster.Nodes[k8sName] = &NodeDescription{K8sName: k8sName, Ready: !tt.notReady}
+				if tt.unhooked {
+					hc.Cluster.PostJoinHookPending = map[string]struct{}{k8sName: {}}
+				}
+			}
+
+			current := &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{
+				Name:      "k8s",
+				Hash:      "hash",
+				NodePools: []*spec.NodePool{old, replacement},
+			}}
+
+			var pending []string
+			for _, n := range desired.Nodes[tt.new:] {
+				pending = append(pending, n.Name)
+			}
+
+			progress := RollingUpdateProgress{
+				Replacement: "np-bbbbbbb",
+				Pending:     pending,
+				Target:      int32(tt.target),
+				Strategy:    tt.strategy,
+			}
+
+			got := nextRollingUpdateBatch(&hc, current, "np-aaaaaaa", progress)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
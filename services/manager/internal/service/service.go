@@ -26,6 +26,11 @@ var (
 	// Port on which the grpc server will be listening on.
 	Port = envs.GetOrDefaultInt("MANAGER_PORT", 50055)
 
+	// Port on which the grpc server serving only the ServiceLoadBalancerService will be
+	// listening on. Unlike the [Port] this one is meant to be reachable from the workload
+	// clusters, where the service controllers are deployed.
+	ServiceLoadBalancerPort = envs.GetOrDefaultInt("MANAGER_SERVICE_LB_PORT", 50056)
+
 	// Durable name of this service.
 	DurableName = envs.GetOrDefault("MANAGER_DURABLE_NAME", "manager")
 
@@ -33,6 +38,9 @@ var (
 	HealthCheckReadinessName = envs.GetOrDefault("MANAGER_HEALTHCHECK_READINESS_SERVICE_NAME", "manager-readiness")
 	HealthCheckLivenessName  = envs.GetOrDefault("MANAGER_HEALTHCHECK_LIVENESS_SERVICE_NAME", "manager-liveness")
 
+	// Database backend in which the configs are stored, either "mongodb" or "postgres".
+	DatabaseBackend = envs.GetOrDefault("MANAGER_DATABASE_BACKEND", store.BackendMongo)
+
 	// Ack wait time in minutes for processing incoming NATS messages.
 	AckWait = time.Duration(envs.GetOrDefaultInt("MANAGER_ACK_WAIT_TIME", 8)) * time.Minute
 
@@ -48,7 +56,10 @@ var (
 	TimeForNodeDeletion = time.Duration(envs.GetOrDefaultInt("MANAGER_TIME_FOR_NODE_DELETION", 10)) * time.Minute
 )
 
-var _ pb.ManagerServiceServer = (*Service)(nil)
+var (
+	_ pb.ManagerServiceServer             = (*Service)(nil)
+	_ pb.ServiceLoadBalancerServiceServer = (*serviceLoadBalancerServer)(nil)
+)
 
 type grpcServer struct {
 	tcpListener  net.Listener
@@ -56,6 +67,19 @@ type grpcServer struct {
 	healthServer *health.Server
 }
 
+// serviceLoadBalancerServer exposes only the UpdateServiceLoadBalancer
+// RPC of the [Service], so that the rest of the API of the manager is
+// not reachable from the workload clusters.
+type serviceLoadBalancerServer struct {
+	pb.UnimplementedServiceLoadBalancerServiceServer
+
+	service *Service
+}
+
+func (s *serviceLoadBalancerServer) UpdateServiceLoadBalancer(ctx context.Context, request *pb.UpdateServiceLoadBalancerRequest) (*pb.UpdateServiceLoadBalancerResponse, error) {
+	return s.service.UpdateServiceLoadBalancer(ctx, request)
+}
+
 type natsClient struct {
 	client     *natsutils.Client
 	inFlight   sync.WaitGroup
@@ -67,9 +91,16 @@ type Service struct {
 
 	store store.Store
 
+	// stateFiles holds the OpenTofu state files of the infrastructure
+	// of the configs, used only for exporting and importing the state.
+	stateFiles store.StateFiles
+
 	server *grpcServer
 	nts    *natsClient
 
+	// slbServer serves the [serviceLoadBalancerServer].
+	slbServer *grpcServer
+
 	done chan struct{}
 }
 
@@ -97,18 +128,34 @@ func New(ctx context.Context, opts ...grpc.ServerOption) (*Service, error) {
 
 	log.Info().Msgf("manager microservice bound to %s", listeningAddress)
 
-	mongo, err := store.NewMongoClient(ctx, envs.DatabaseURL)
+	slbListeningAddress := net.JoinHostPort("0.0.0.0", fmt.Sprint(ServiceLoadBalancerPort))
+	slbLis, err := lcfg.Listen(ctx, "tcp", slbListeningAddress)
 	if err != nil {
 		client.Close()
 		lis.Close()
-		return nil, fmt.Errorf("failed to initialize store: %w", err)
+		return nil, fmt.Errorf("failed to bind tcp socket for address: %q: %w", slbListeningAddress, err)
+	}
+
+	log.Info().Msgf("manager service loadbalancer API bound to %s", slbListeningAddress)
+
+	kms, err := newKMS()
+	if err != nil {
+		client.Close()
+		lis.Close()
+		slbLis.Close()
+		return nil, fmt.Errorf("failed to initialize KMS: %w", err)
+	}
+	if kms != nil {
+		store.SetEncryption(kms)
+		log.Info().Msgf("encryption at rest enabled using %q KMS", KMSProvider)
 	}
 
-	if err := mongo.Init(); err != nil {
+	db, err := store.New(ctx, DatabaseBackend, envs.DatabaseURL)
+	if err != nil {
 		client.Close()
 		lis.Close()
-		mongo.Close()
-		return nil, fmt.Errorf("failed to init mongo database: %w", err)
+		slbLis.Close()
+		return nil, fmt.Errorf("failed to initialize store: %w", err)
 	}
 
 	grpcserver := grpcutils.NewGRPCServer(opts...)
@@ -131,26 +178,62 @@ func New(ctx context.Context, opts ...grpc.ServerOption) (*Service, error) {
 	}
 
 	s := &Service{
-		store:  mongo,
-		server: &gserver,
-		nts:    &natsconsumer,
-		done:   make(chan struct{}),
+		store:      db,
+		stateFiles: store.NewS3StateFiles(),
+		server:     &gserver,
+		nts:        &natsconsumer,
+		slbServer: &grpcServer{
+			tcpListener: slbLis,
+			server:      grpcutils.NewGRPCServer(opts...),
+		},
+		done: make(chan struct{}),
 	}
 
 	pb.RegisterManagerServiceServer(s.server.server, s)
+	pb.RegisterServiceLoadBalancerServiceServer(s.slbServer.server, &serviceLoadBalancerServer{service: s})
 
 	go s.consumerLoop(consumerLoopChan)
 	go s.watchPending()
 	go s.watchScheduled()
 	go s.watchDoneOrError()
+	go s.watchClusterMetrics()
+	if kms != nil {
+		go s.watchEncryption()
+	}
 
 	return s, nil
 }
 
 // Serve will create a service goroutine for each connection
+// on both the manager API and the service loadbalancer API.
 func (s *Service) Serve() error {
-	if err := s.server.server.Serve(s.server.tcpListener); err != nil {
-		return fmt.Errorf("manager microservice grpc server failed to serve: %w", err)
+	errc := make(chan error, 2)
+	go func() {
+		if err := s.server.server.Serve(s.server.tcpListener); err != nil {
+			errc <- fmt.Errorf("manager microservice grpc server failed to serve: %w", err)
+			return
+		}
+		errc <- nil
+	}()
+	go func() {
+		if err := s.slbServer.server.Serve(s.slbServer.tcpListener); err != nil {
+			errc <- fmt.Errorf("manager service loadbalancer grpc server failed to serve: %w", err)
+			return
+		}
+		errc <- nil
+	}()
+
+	err := <-errc
+	if err != nil {
+		// do not keep serving only one of the APIs.
+		s.server.server.Stop()
+		s.slbServer.server.Stop()
+	}
+	if errs := <-errc; errs != nil {
+		err = errors.Join(err, errs)
+	}
+	if err != nil {
+		return err
 	}
 
 	log.Info().Msgf("Finished listening for incoming gRPC connections")
@@ -170,8 +253,9 @@ func (s *Service) Stop() error {
 	s.nts.client.Close()
 	s.server.server.GracefulStop()
 	s.server.healthServer.Shutdown()
+	s.slbServer.server.GracefulStop()
 
-	err := s.server.tcpListener.Close()
+	err := errors.Join(s.server.tcpListener.Close(), s.slbServer.tcpListener.Close())
 	if errc := s.store.Close(); errc != nil {
 		err = errors.Join(err, errc)
 	}
@@ -191,13 +275,18 @@ func (s *Service) PerformHealthCheckAndUpdateStatus() {
 }
 
 func (s *Service) watchPending() {
+	// Bounds the work started in the background by the reconciliation,
+	// such as the calls of the post-join hooks, to the lifetime of the loop.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	for {
 		select {
 		case <-s.done:
 			log.Info().Msg("Exited worker loop running WatchForPendingDocuments")
 			return
 		case <-time.After(PendingTick):
-			if err := s.WatchForPendingDocuments(context.Background()); err != nil {
+			if err := s.WatchForPendingDocuments(ctx); err != nil {
 				log.Err(err).Msg("Watch for pending documents failed")
 			}
 		}
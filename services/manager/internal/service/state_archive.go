@@ -0,0 +1,283 @@
+package service
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/berops/claudie/services/manager/internal/store"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// stateArchiveVersion is the version of the layout of the archives produced
+// by [Service.ExportState], to be bumped on any incompatible change.
+const stateArchiveVersion = 1
+
+const (
+	// Size of the chunks in which the archive is streamed.
+	stateArchiveChunkSize = 1 << 20
+
+	stateArchiveIndexFile     = "index.json"
+	stateArchiveConfigsDir    = "configs"
+	stateArchiveStateFilesDir = "statefiles"
+
+	// Parameters for deriving the encryption key from the passphrase.
+	stateArchiveSaltSize      = 16
+	stateArchiveKDFIterations = 600_000
+)
+
+// stateArchiveMagic prefixes the encrypted archives, to tell them apart from the plain ones.
+var stateArchiveMagic = []byte("CLAUDIE-STATE-ENC-V1")
+
+type stateArchiveIndex struct {
+	Version    int      `json:"version"`
+	Created    string   `json:"created"`
+	Configs    []string `json:"configs"`
+	StateFiles []string `json:"stateFiles"`
+}
+
+// stateArchive holds the state of the manager needed to restore it
+// on a fresh management cluster.
+type stateArchive struct {
+	configs    []*store.Config
+	stateFiles map[string][]byte
+}
+
+// write writes the archive as a gzip compressed tarball in the form of:
+//
+//	index.json
+//	configs/<config>.bson
+//	statefiles/<config>/<cluster-id>
+func (a *stateArchive) write(w io.Writer) error {
+	index := stateArchiveIndex{
+		Version: stateArchiveVersion,
+		Created: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	encoded := make([][]byte, 0, len(a.configs))
+	for _, cfg := range a.configs {
+		b, err := bson.Marshal(cfg)
+		if err != nil {
+			return fmt.Errorf("failed to encode config %q: %w", cfg.Name, err)
+		}
+		index.Configs = append(index.Configs, cfg.Name)
+		encoded = append(encoded, b)
+	}
+
+	index.StateFiles = slices.Sorted(maps.Keys(a.stateFiles))
+
+	b, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode archive index: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	// The index is always written first, so that the version
+	// can be checked before reading the rest of the archive.
+	if err := writeTarFile(tw, stateArchiveIndexFile, b); err != nil {
+		return err
+	}
+	for i, cfg := range index.Configs {
+		if err := writeTarFile(tw, path.Join(stateArchiveConfigsDir, cfg+".bson"), encoded[i]); err != nil {
+			return err
+		}
+	}
+	for _, key := range index.StateFiles {
+		if err := writeTarFile(tw, path.Join(stateArchiveStateFilesDir, key), a.stateFiles[key]); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish archive compression: %w", err)
+	}
+	return nil
+}
+
+func writeTarFile(tw *tar.Writer, name string, b []byte) error {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0o600,
+		Size:     int64(len(b)),
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write header of %q: %w", name, err)
+	}
+	if _, err := tw.Write(b); err != nil {
+		return fmt.Errorf("failed to write %q: %w", name, err)
+	}
+	return nil
+}
+
+// readStateArchive reads the archive produced by [stateArchive.write], validating
+// that all of the configs and state files listed in the index are present.
+func readStateArchive(r io.Reader) (*stateArchive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress archive: %w", err)
+	}
+	defer gz.Close()
+
+	var (
+		tr    = tar.NewReader(gz)
+		index *stateArchiveIndex
+		out   = &stateArchive{stateFiles: make(map[string][]byte)}
+		seen  = make(map[string]struct{})
+	)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %q from archive: %w", hdr.Name, err)
+		}
+
+		if index == nil {
+			if hdr.Name != stateArchiveIndexFile {
+				return nil, fmt.Errorf("invalid archive, expected %q as the first entry, got %q", stateArchiveIndexFile, hdr.Name)
+			}
+			index = new(stateArchiveIndex)
+			if err := json.Unmarshal(b, index); err != nil {
+				return nil, fmt.Errorf("failed to decode archive index: %w", err)
+			}
+			if index.Version != stateArchiveVersion {
+				return nil, fmt.Errorf("unsupported archive version %v, expected %v", index.Version, stateArchiveVersion)
+			}
+			continue
+		}
+
+		dir, name, _ := strings.Cut(hdr.Name, "/")
+		switch dir {
+		case stateArchiveConfigsDir:
+			var cfg store.Config
+			if err := bson.Unmarshal(b, &cfg); err != nil {
+				return nil, fmt.Errorf("failed to decode config %q: %w", name, err)
+			}
+			out.configs = append(out.configs, &cfg)
+			seen[cfg.Name] = struct{}{}
+		case stateArchiveStateFilesDir:
+			out.stateFiles[name] = b
+		default:
+			return nil, fmt.Errorf("unexpected entry %q in archive", hdr.Name)
+		}
+	}
+
+	if index == nil {
+		return nil, errors.New("invalid archive, missing index")
+	}
+	for _, cfg := range index.Configs {
+		if _, ok := seen[cfg]; !ok {
+			return nil, fmt.Errorf("invalid archive, missing config %q", cfg)
+		}
+	}
+	for _, key := range index.StateFiles {
+		if _, ok := out.stateFiles[key]; !ok {
+			return nil, fmt.Errorf("invalid archive, missing state file %q", key)
+		}
+	}
+
+	return out, nil
+}
+
+// isEncryptedStateArchive returns whether the archive was encrypted by [encryptStateArchive].
+func isEncryptedStateArchive(b []byte) bool { return bytes.HasPrefix(b, stateArchiveMagic) }
+
+// encryptStateArchive encrypts the archive with AES-256-GCM using a key derived from the
+// passphrase. The output is in the form of: magic | salt | nonce | ciphertext.
+func encryptStateArchive(passphrase string, plain []byte) ([]byte, error) {
+	salt := make([]byte, stateArchiveSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	aead, err := stateArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(stateArchiveMagic)+len(salt)+len(nonce)+len(plain)+aead.Overhead())
+	out = append(out, stateArchiveMagic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plain, stateArchiveMagic), nil
+}
+
+// decryptStateArchive reverses [encryptStateArchive].
+func decryptStateArchive(passphrase string, b []byte) ([]byte, error) {
+	if !isEncryptedStateArchive(b) {
+		return nil, errors.New("archive is not encrypted")
+	}
+	b = b[len(stateArchiveMagic):]
+
+	if len(b) < stateArchiveSaltSize {
+		return nil, errors.New("encrypted archive is truncated")
+	}
+	salt, b := b[:stateArchiveSaltSize], b[stateArchiveSaltSize:]
+
+	aead, err := stateArchiveCipher(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < aead.NonceSize() {
+		return nil, errors.New("encrypted archive is truncated")
+	}
+	nonce, b := b[:aead.NonceSize()], b[aead.NonceSize():]
+
+	plain, err := aead.Open(nil, nonce, b, stateArchiveMagic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt archive, wrong passphrase or corrupted archive: %w", err)
+	}
+	return plain, nil
+}
+
+func stateArchiveCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, stateArchiveKDFIterations, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return aead, nil
+}
@@ -45,7 +45,7 @@ func (s *Service) WatchForScheduledDocuments(ctx context.Context) error {
 
 	clusters:
 		for cluster, state := range scheduled.Clusters {
-			if state.State.Status == spec.Workflow_DONE.String() {
+			if state.State.Status == spec.Workflow_DONE.String() || state.State.Status == spec.Workflow_PAUSED.String() {
 				clustersDone++
 				continue clusters
 			}
@@ -144,6 +144,19 @@ func (s *Service) WatchForScheduledDocuments(ctx context.Context) error {
 						break
 					}
 					logger.Err(err).Msgf("Failed to move event %q cluster %q state to InProgress", event.Id, cluster)
+				} else {
+					clusterStats.stageStarted(event.Id, event.CurrentStage, time.Now())
+					recordHistory(ctx, logger, s.store, &store.HistoryEntry{
+						Config:      scheduled.Name,
+						Cluster:     cluster,
+						TaskId:      event.Id,
+						Kind:        spec.TaskHistoryEntry_STAGE_STARTED.String(),
+						Timestamp:   time.Now().UTC().Format(time.RFC3339),
+						Type:        event.Type,
+						Description: msgDescription,
+						Stage:       event.Pipeline[event.CurrentStage].Kind,
+						StageIndex:  event.CurrentStage,
+					})
 				}
 
 				logger.Info().Msgf("Moved event %q for cluster %q into the work queue %q", event.Id, cluster, msg.Subject)
@@ -217,6 +230,7 @@ func (s *Service) WatchForPendingDocuments(ctx context.Context) error {
 			logger.Err(err).Msgf("Failed to create desired state, skipping.")
 			continue
 		}
+		keepRolledBack(pending, desiredState)
 
 		ok, err := manifest.ValidStateTransitionString(pending.Manifest.State.String(), manifest.Scheduled)
 		if err != nil || !ok {
@@ -224,7 +238,7 @@ func (s *Service) WatchForPendingDocuments(ctx context.Context) error {
 			continue
 		}
 
-		result := reconciliate(pending, desiredState)
+		result := reconciliate(ctx, pending, desiredState, reconciliateOpts{})
 
 		switch result {
 		case Noop:
@@ -258,6 +272,8 @@ func (s *Service) WatchForPendingDocuments(ctx context.Context) error {
 			continue
 		}
 
+		recordHistory(ctx, logger, s.store, scheduledHistory(cfg, modified)...)
+
 		switch result {
 		case NotReady:
 			// do nothing.
@@ -348,18 +364,18 @@ func messageForStage(
 	pipeline []*spec.Stage,
 ) (nats.Msg, string, error) {
 	var (
-		task         spec.Task
 		work         spec.Work
 		subject      string
 		replySubject string
 		description  string
 	)
 
-	if err := proto.Unmarshal(marshalledTask, &task); err != nil {
+	task, err := store.ConvertToGRPCTask(marshalledTask)
+	if err != nil {
 		return nats.Msg{}, "", err
 	}
 
-	work.Task = &task
+	work.Task = task
 
 	switch stage := pipeline[stage].GetStageKind().(type) {
 	case *spec.Stage_Ansibler:
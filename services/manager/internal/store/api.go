@@ -19,6 +19,24 @@ type ListFilter struct {
 	ManifestState []string
 }
 
+// HistoryFilter wraps supported filters for listing the task history.
+type HistoryFilter struct {
+	// Config for which to list the history, required.
+	Config string
+
+	// If not empty, only entries of the cluster are listed.
+	Cluster string
+
+	// If not empty, only entries recorded at or after the RFC3339 timestamp are listed.
+	Since string
+
+	// Number of matching entries to skip.
+	Offset int64
+
+	// Maximum number of entries to return, 0 for no limit.
+	Limit int64
+}
+
 type Store interface {
 	io.Closer
 	healthcheck.HealthChecker
@@ -56,6 +74,16 @@ type Store interface {
 	// deletion. If No documents with the given combination were marked for deletion the ErrNotFoundOrDirty err is returned. It is up
 	// to the application code to handle the case in which a Dirty Write occurred or the document does not exist.
 	MarkForDeletion(ctx context.Context, name string, version uint64) error
+
+	// History
+
+	// AppendHistory appends the entry to the task history. Once appended the entry is never
+	// modified or deleted, not even after the config itself is deleted.
+	AppendHistory(ctx context.Context, entry *HistoryEntry) error
+
+	// ListHistory queries the history entries that satisfy the passed in HistoryFilter, ordered
+	// from the oldest to the newest entry.
+	ListHistory(ctx context.Context, filter *HistoryFilter) ([]*HistoryEntry, error)
 }
 
 type Config struct {
@@ -84,6 +112,13 @@ type ClusterState struct {
 	InFlight *TaskEvent `bson:"inFlight"`
 	State    Workflow   `bson:"state"`
 	Counters Counters   `bson:"counters"`
+	Paused   bool       `bson:"paused"`
+	Rollback *Rollback  `bson:"rollback"`
+}
+
+type Rollback struct {
+	TaskId   string `bson:"taskId"`
+	Checksum []byte `bson:"checksum"`
 }
 
 type Counters struct {
@@ -139,6 +174,7 @@ type Workflow struct {
 	Timestamp         string             `bson:"timestamp"`
 	Previous          []FinishedWorkflow `bson:"previous"`
 	TicksUntilRefresh int32              `bson:"ticksUntilRefresh"`
+	Deferred          string             `bson:"deferred"`
 }
 
 type FinishedWorkflow struct {
@@ -148,6 +184,25 @@ type FinishedWorkflow struct {
 	Timestamp       string    `bson:"timestamp"`
 }
 
+type HistoryError struct {
+	Kind        string `bson:"kind"`
+	Description string `bson:"description"`
+}
+
+type HistoryEntry struct {
+	Config      string        `bson:"config"`
+	Cluster     string        `bson:"cluster"`
+	TaskId      string        `bson:"taskId"`
+	Kind        string        `bson:"kind"`
+	Timestamp   string        `bson:"timestamp"`
+	Type        string        `bson:"event"`
+	Description string        `bson:"description"`
+	Stage       StageKind     `bson:"stage"`
+	StageIndex  uint32        `bson:"stageIndex"`
+	Result      string        `bson:"result"`
+	Error       *HistoryError `bson:"error"`
+}
+
 func (cs *ClusterState) Exists() bool {
 	// len(current.K8S) == 0 is here, as its possible the cluster was just deleted
 	// but its still in the store and will be deleted from the store on the next
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Supported database backends of the Store.
+const (
+	BackendMongo    = "mongodb"
+	BackendPostgres = "postgres"
+)
+
+// New connects to the database of the requested backend at the uri and
+// initializes it, so that the returned Store is ready for use.
+func New(ctx context.Context, backend, uri string) (Store, error) {
+	switch backend {
+	case BackendMongo:
+		mongo, err := NewMongoClient(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		if err := mongo.Init(); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to init mongo database: %w", err), mongo.Close())
+		}
+		return mongo, nil
+	case BackendPostgres:
+		postgres, err := NewPostgresClient(ctx, uri)
+		if err != nil {
+			return nil, err
+		}
+		if err := postgres.Init(); err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to init postgres database: %w", err), postgres.Close())
+		}
+		return postgres, nil
+	default:
+		return nil, fmt.Errorf("unsupported database backend %q, expected one of %q, %q", backend, BackendMongo, BackendPostgres)
+	}
+}
@@ -188,10 +188,18 @@ func ConvertFromGRPCStages(stages []*spec.Stage) ([]Stage, error) {
 
 func ConvertFromGRPCTask(t *spec.Task) ([]byte, error) {
 	marshaller := proto.MarshalOptions{Deterministic: true}
-	return marshaller.Marshal(t)
+	b, err := marshaller.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return seal(b)
 }
 
 func ConvertToGRPCTask(t []byte) (*spec.Task, error) {
+	t, err := open(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt task: %w", err)
+	}
 	var task spec.Task
 	if err := proto.Unmarshal(t, &task); err != nil {
 		return nil, err
@@ -291,6 +299,7 @@ func ConvertFromGRPCWorkflow(w *spec.Workflow) Workflow {
 		Timestamp:         time.Now().UTC().Format(time.RFC3339),
 		Previous:          previous,
 		TicksUntilRefresh: w.TicksUntilRefresh,
+		Deferred:          w.GetDeferred(),
 	}
 }
 
@@ -319,6 +328,7 @@ func ConvertToGRPCWorkflow(w Workflow) *spec.Workflow {
 		Description:       w.Description,
 		Previous:          previous,
 		TicksUntilRefresh: w.TicksUntilRefresh,
+		Deferred:          w.Deferred,
 	}
 }
 
@@ -456,6 +466,10 @@ func ConvertFromGRPCClusters(cluster *spec.Clusters) (Clusters, error) {
 
 // ConvertToGRPCCluster converts the database representation to the GRPC representation.
 func ConvertToGRPCCluster(k8s []byte) (*spec.K8Scluster, error) {
+	k8s, err := open(k8s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt kubernetes cluster: %w", err)
+	}
 	var cluster spec.K8Scluster
 	if err := proto.Unmarshal(k8s, &cluster); err != nil {
 		return nil, fmt.Errorf("failed to unmarshall kuberentes cluster: %w", err)
@@ -465,6 +479,10 @@ func ConvertToGRPCCluster(k8s []byte) (*spec.K8Scluster, error) {
 
 // ConvertToGRPCLoadBalancers converts the database representation to the GRPC representation.
 func ConvertToGRPCLoadBalancers(lbs []byte) (*spec.LoadBalancers, error) {
+	lbs, err := open(lbs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt load balancer clusters: %w", err)
+	}
 	var loadbalancers spec.LoadBalancers
 	if err := proto.Unmarshal(lbs, &loadbalancers); err != nil {
 		return nil, fmt.Errorf("failed to unmarshall load balancer clusters: %w", err)
@@ -479,7 +497,7 @@ func ConvertFromGRPCLoadBalancers(lbs *spec.LoadBalancers) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal loadbalancer cluster: %w", err)
 	}
-	return b, nil
+	return seal(b)
 }
 
 // ConvertFromGRPCCluster deterministically converts the grpc representation to the database representation.
@@ -489,7 +507,7 @@ func ConvertFromGRPCCluster(k8s *spec.K8Scluster) ([]byte, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal kubernetes cluster: %w", err)
 	}
-	return b, nil
+	return seal(b)
 }
 
 func ConvertToGRPCClusterState(cluster *ClusterState) (*spec.ClusterState, error) {
@@ -517,6 +535,14 @@ func ConvertToGRPCClusterState(cluster *ClusterState) (*spec.ClusterState, error
 		Counters: &spec.Counters{
 			K8SNodePoolScaleUpFailed: maps.Clone(cluster.Counters.K8sNodePoolScaleUpFailed),
 		},
+		Paused: cluster.Paused,
+	}
+
+	if cluster.Rollback != nil {
+		out.Rollback = &spec.Rollback{
+			TaskId:   cluster.Rollback.TaskId,
+			Checksum: cluster.Rollback.Checksum,
+		}
 	}
 
 	if out.Counters.K8SNodePoolScaleUpFailed == nil {
@@ -544,6 +570,14 @@ func ConvertFromGRPCClusterState(cluster *spec.ClusterState) (*ClusterState, err
 		Counters: Counters{
 			K8sNodePoolScaleUpFailed: maps.Clone(cluster.GetCounters().GetK8SNodePoolScaleUpFailed()),
 		},
+		Paused: cluster.GetPaused(),
+	}
+
+	if rb := cluster.GetRollback(); rb != nil {
+		out.Rollback = &Rollback{
+			TaskId:   rb.TaskId,
+			Checksum: rb.Checksum,
+		}
 	}
 
 	if out.Counters.K8sNodePoolScaleUpFailed == nil {
@@ -552,3 +586,56 @@ func ConvertFromGRPCClusterState(cluster *spec.ClusterState) (*ClusterState, err
 
 	return &out, nil
 }
+
+func ConvertFromGRPCHistoryEntry(e *spec.TaskHistoryEntry) *HistoryEntry {
+	out := &HistoryEntry{
+		Config:      e.GetConfig(),
+		Cluster:     e.GetCluster(),
+		TaskId:      e.GetTaskId(),
+		Kind:        e.GetKind().String(),
+		Timestamp:   e.GetTimestamp().AsTime().UTC().Format(time.RFC3339),
+		Type:        e.GetEvent().String(),
+		Description: e.GetDescription(),
+		Stage:       StageKind(e.GetStage()),
+		StageIndex:  e.GetStageIndex(),
+		Result:      e.GetResult(),
+	}
+
+	if err := e.GetError(); err != nil {
+		out.Error = &HistoryError{
+			Kind:        err.GetKind().String(),
+			Description: err.GetDescription(),
+		}
+	}
+
+	return out
+}
+
+func ConvertToGRPCHistoryEntry(e *HistoryEntry) *spec.TaskHistoryEntry {
+	var timestamp *timestamppb.Timestamp
+	if t, err := time.Parse(time.RFC3339, e.Timestamp); err == nil {
+		timestamp = timestamppb.New(t.UTC())
+	}
+
+	out := &spec.TaskHistoryEntry{
+		Config:      e.Config,
+		Cluster:     e.Cluster,
+		TaskId:      e.TaskId,
+		Kind:        spec.TaskHistoryEntry_Kind(spec.TaskHistoryEntry_Kind_value[e.Kind]),
+		Timestamp:   timestamp,
+		Event:       spec.Event(spec.Event_value[e.Type]),
+		Description: e.Description,
+		Stage:       string(e.Stage),
+		StageIndex:  e.StageIndex,
+		Result:      e.Result,
+	}
+
+	if e.Error != nil {
+		out.Error = &spec.TaskResult_Error{
+			Kind:        spec.TaskResult_Error_Kind(spec.TaskResult_Error_Kind_value[e.Error.Kind]),
+			Description: e.Error.Description,
+		}
+	}
+
+	return out
+}
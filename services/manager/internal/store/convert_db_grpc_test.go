@@ -1670,3 +1670,55 @@ func TestConvertToGRPCLoadBalancers(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertHistoryEntryToDBAndBack(t *testing.T) {
+	t.Parallel()
+
+	timestamp := timestamppb.New(time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		input *spec.TaskHistoryEntry
+	}{
+		{
+			name: "scheduled task",
+			input: &spec.TaskHistoryEntry{
+				Config:      "config",
+				Cluster:     "cluster",
+				TaskId:      "id",
+				Kind:        spec.TaskHistoryEntry_TASK_SCHEDULED,
+				Timestamp:   timestamp,
+				Event:       spec.Event_UPDATE,
+				Description: "adding nodes",
+			},
+		},
+		{
+			name: "stage finished with error",
+			input: &spec.TaskHistoryEntry{
+				Config:      "config",
+				Cluster:     "cluster",
+				TaskId:      "id",
+				Kind:        spec.TaskHistoryEntry_STAGE_FINISHED,
+				Timestamp:   timestamp,
+				Event:       spec.Event_DELETE,
+				Description: "destroying infrastructure",
+				Stage:       string(store.Terraformer),
+				StageIndex:  2,
+				Result:      "Clear",
+				Error: &spec.TaskResult_Error{
+					Kind:        spec.TaskResult_Error_PARTIAL,
+					Description: "failed to destroy",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			db := store.ConvertFromGRPCHistoryEntry(tt.input)
+			got := store.ConvertToGRPCHistoryEntry(db)
+			assert.Empty(t, cmp.Diff(tt.input, got, protocmp.Transform()))
+		})
+	}
+}
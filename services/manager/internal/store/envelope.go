@@ -0,0 +1,316 @@
+package store
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+const (
+	// dataKeySize is the size of the AES-256 data encryption keys.
+	dataKeySize = 32
+
+	// dataKeyMaxUses is the number of encryptions after which a new
+	// data key is generated, well below the limits of AES-GCM with
+	// random nonces.
+	dataKeyMaxUses = 1 << 24
+)
+
+// envelopeMagic prefixes the encrypted values. A valid protobuf message
+// never starts with a zero byte, which makes it possible to tell apart
+// the encrypted values from the values stored before the encryption was
+// enabled.
+var envelopeMagic = []byte("\x00CLAUDIE-ENVELOPE\x01")
+
+// secrets is the Envelope used for encrypting the database representation
+// of the clusters and tasks, nil if the encryption at rest is disabled.
+var secrets *Envelope
+
+// SetEncryption enables the encryption at rest of the clusters and tasks
+// within the configs, using the data keys wrapped by the kms, or disables it
+// if the kms is nil. Must be called before any of the conversions between the
+// GRPC and the database representation.
+func SetEncryption(kms KMS) {
+	if kms == nil {
+		secrets = nil
+		return
+	}
+	secrets = NewEnvelope(kms)
+}
+
+// Envelope implements envelope encryption, where the values are encrypted with
+// a data key, that is itself encrypted by the [KMS] and stored alongside the value.
+// The encrypted values are in the form of:
+//
+//	magic | len(wrapped data key) | wrapped data key | nonce | ciphertext
+type Envelope struct {
+	kms KMS
+
+	lock    sync.Mutex
+	current *dataKey
+	// unwrapped caches the unwrapped data keys, keyed by the wrapped data key.
+	unwrapped map[string][]byte
+}
+
+type dataKey struct {
+	plain   []byte
+	wrapped []byte
+	uses    int
+}
+
+func NewEnvelope(kms KMS) *Envelope {
+	return &Envelope{
+		kms:       kms,
+		unwrapped: make(map[string][]byte),
+	}
+}
+
+// Seal encrypts the value. Empty values are left as is.
+func (e *Envelope) Seal(plain []byte) ([]byte, error) {
+	if len(plain) == 0 {
+		return plain, nil
+	}
+
+	key, err := e.dataKey()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key.plain)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, len(envelopeMagic)+2+len(key.wrapped)+len(nonce)+len(plain)+aead.Overhead())
+	out = append(out, envelopeMagic...)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(key.wrapped)))
+	out = append(out, key.wrapped...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plain, envelopeMagic), nil
+}
+
+// Open decrypts the value encrypted by [Envelope.Seal]. Values that were
+// stored before the encryption was enabled are returned as is.
+func (e *Envelope) Open(b []byte) ([]byte, error) {
+	if !IsSealed(b) {
+		return b, nil
+	}
+
+	wrapped, rest, err := splitEnvelope(b)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := e.unwrap(wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("encrypted value is truncated")
+	}
+
+	nonce, ciphertext := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, ciphertext, envelopeMagic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt value: %w", err)
+	}
+	return plain, nil
+}
+
+// Outdated returns whether the value needs to be encrypted again, that is
+// if it is not encrypted at all or its data key was wrapped by a key of the
+// [KMS] that was rotated since.
+func (e *Envelope) Outdated(b []byte) (bool, error) {
+	if len(b) == 0 {
+		return false, nil
+	}
+	if !IsSealed(b) {
+		return true, nil
+	}
+
+	wrapped, _, err := splitEnvelope(b)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := e.kms.IsCurrent(wrapped)
+	if err != nil {
+		return false, err
+	}
+	return !current, nil
+}
+
+// NeedsReencryption returns whether any of the clusters or tasks within the
+// config are not encrypted with the current key, if the encryption is enabled.
+func NeedsReencryption(cfg *Config) (bool, error) {
+	if secrets == nil {
+		return false, nil
+	}
+
+	for _, b := range encryptedFields(cfg) {
+		outdated, err := secrets.Outdated(*b)
+		if err != nil {
+			return false, err
+		}
+		if outdated {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Reencrypt encrypts the clusters and tasks within the config with the current
+// key, including the ones stored before the encryption was enabled. The config
+// is modified in place and needs to be written back to the store afterwards.
+func Reencrypt(cfg *Config) error {
+	if secrets == nil {
+		return nil
+	}
+
+	for _, b := range encryptedFields(cfg) {
+		plain, err := open(*b)
+		if err != nil {
+			return err
+		}
+		sealed, err := secrets.Seal(plain)
+		if err != nil {
+			return err
+		}
+		*b = sealed
+	}
+
+	return nil
+}
+
+// encryptedFields returns the fields of the config that are encrypted at rest.
+func encryptedFields(cfg *Config) []*[]byte {
+	var out []*[]byte
+	for _, cs := range cfg.Clusters {
+		out = append(out, &cs.Current.K8s, &cs.Current.LoadBalancers)
+		for te := cs.InFlight; te != nil; te = te.LowerPriority {
+			out = append(out, &te.Task)
+		}
+	}
+	return out
+}
+
+// IsSealed returns whether the value was encrypted by [Envelope.Seal].
+func IsSealed(b []byte) bool { return bytes.HasPrefix(b, envelopeMagic) }
+
+// dataKey returns the data key to encrypt with, generating a new one if the
+// current one was used too many times or the key of the KMS was rotated.
+func (e *Envelope) dataKey() (*dataKey, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.current != nil && e.current.uses < dataKeyMaxUses {
+		current, err := e.kms.IsCurrent(e.current.wrapped)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check data key: %w", err)
+		}
+		if current {
+			e.current.uses++
+			return e.current, nil
+		}
+	}
+
+	plain := make([]byte, dataKeySize)
+	if _, err := rand.Read(plain); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+
+	wrapped, err := e.kms.Encrypt(plain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	if len(wrapped) > 0xFFFF {
+		return nil, fmt.Errorf("wrapped data key too large: %v bytes", len(wrapped))
+	}
+
+	e.current = &dataKey{plain: plain, wrapped: wrapped, uses: 1}
+	e.unwrapped[string(wrapped)] = plain
+	return e.current, nil
+}
+
+func (e *Envelope) unwrap(wrapped []byte) ([]byte, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if key, ok := e.unwrapped[string(wrapped)]; ok {
+		return key, nil
+	}
+
+	key, err := e.kms.Decrypt(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	if len(key) != dataKeySize {
+		return nil, fmt.Errorf("unwrapped data key has invalid size %v", len(key))
+	}
+
+	e.unwrapped[string(wrapped)] = key
+	return key, nil
+}
+
+// seal encrypts the value if the encryption is enabled.
+func seal(b []byte) ([]byte, error) {
+	if secrets == nil {
+		return b, nil
+	}
+	return secrets.Seal(b)
+}
+
+// open decrypts the value if it was encrypted.
+func open(b []byte) ([]byte, error) {
+	if !IsSealed(b) {
+		return b, nil
+	}
+	if secrets == nil {
+		return nil, errors.New("value is encrypted but no KMS is configured")
+	}
+	return secrets.Open(b)
+}
+
+func splitEnvelope(b []byte) (wrapped, rest []byte, err error) {
+	b = b[len(envelopeMagic):]
+	if len(b) < 2 {
+		return nil, nil, errors.New("encrypted value is truncated")
+	}
+
+	n := int(binary.BigEndian.Uint16(b))
+	b = b[2:]
+	if len(b) < n {
+		return nil, nil, errors.New("encrypted value is truncated")
+	}
+
+	return b[:n], b[n:], nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return aead, nil
+}
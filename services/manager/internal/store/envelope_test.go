@@ -0,0 +1,332 @@
+package store_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/manager/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestEnvelope(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	first, second := newKey(t), newKey(t)
+
+	kms, err := store.NewLocalKMS(writeKeys(t, dir, "keys", "# current key first", "first="+first))
+	require.NoError(t, err)
+
+	e := store.NewEnvelope(kms)
+
+	plain := []byte("kubeconfig")
+	sealed, err := e.Seal(plain)
+	require.NoError(t, err)
+	assert.True(t, store.IsSealed(sealed))
+	assert.NotContains(t, string(sealed), string(plain))
+
+	got, err := e.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	// values stored before the encryption was enabled are passed through.
+	got, err = e.Open(plain)
+	require.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	outdated, err := e.Outdated(plain)
+	require.NoError(t, err)
+	assert.True(t, outdated)
+
+	outdated, err = e.Outdated(sealed)
+	require.NoError(t, err)
+	assert.False(t, outdated)
+
+	tampered := bytes.Clone(sealed)
+	tampered[len(tampered)-1] ^= 0xFF
+	_, err = e.Open(tampered)
+	assert.Error(t, err)
+
+	_, err = e.Open(sealed[:len(sealed)/2])
+	assert.Error(t, err)
+
+	// after rotation the old values can still be decrypted, but are outdated.
+	rotated, err := store.NewLocalKMS(writeKeys(t, dir, "rotated", "second="+second, "first="+first))
+	require.NoError(t, err)
+
+	e = store.NewEnvelope(rotated)
+
+	got, err = e.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	outdated, err = e.Outdated(sealed)
+	require.NoError(t, err)
+	assert.True(t, outdated)
+
+	resealed, err := e.Seal(plain)
+	require.NoError(t, err)
+	outdated, err = e.Outdated(resealed)
+	require.NoError(t, err)
+	assert.False(t, outdated)
+
+	// without the old key the values can no longer be decrypted.
+	only, err := store.NewLocalKMS(writeKeys(t, dir, "only", "second="+second))
+	require.NoError(t, err)
+	_, err = store.NewEnvelope(only).Open(sealed)
+	assert.Error(t, err)
+}
+
+func TestNewLocalKMS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := newKey(t)
+
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{name: "empty", lines: []string{"# no keys"}},
+		{name: "missing-id", lines: []string{"=" + key}},
+		{name: "missing-separator", lines: []string{key}},
+		{name: "invalid-base64", lines: []string{"first=not base64"}},
+		{name: "short-key", lines: []string{"first=" + base64.StdEncoding.EncodeToString([]byte("short"))}},
+		{name: "duplicate-id", lines: []string{"first=" + key, "first=" + key}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := store.NewLocalKMS(writeKeys(t, dir, tt.name, tt.lines...))
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := store.NewLocalKMS(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestVaultKMS(t *testing.T) {
+	t.Parallel()
+
+	vault := newFakeVault()
+	srv := httptest.NewServer(vault)
+	t.Cleanup(srv.Close)
+
+	_, err := store.NewVaultKMS(srv.URL, "", "transit", "claudie")
+	assert.Error(t, err)
+
+	kms, err := store.NewVaultKMS(srv.URL, "token", "transit", "claudie")
+	require.NoError(t, err)
+
+	wrapped, err := kms.Encrypt([]byte("data-key"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(wrapped), "vault:v1:"))
+
+	plain, err := kms.Decrypt(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), plain)
+
+	current, err := kms.IsCurrent(wrapped)
+	require.NoError(t, err)
+	assert.True(t, current)
+
+	// the latest version is cached, a fresh client sees the rotation right away.
+	vault.rotate()
+
+	kms, err = store.NewVaultKMS(srv.URL, "token", "transit", "claudie")
+	require.NoError(t, err)
+
+	current, err = kms.IsCurrent(wrapped)
+	require.NoError(t, err)
+	assert.False(t, current)
+
+	plain, err = kms.Decrypt(wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("data-key"), plain)
+
+	_, err = kms.IsCurrent([]byte("not-a-vault-ciphertext"))
+	assert.Error(t, err)
+
+	unauthorized, err := store.NewVaultKMS(srv.URL, "wrong", "transit", "claudie")
+	require.NoError(t, err)
+	_, err = unauthorized.Encrypt([]byte("data-key"))
+	assert.ErrorContains(t, err, "permission denied")
+}
+
+// TestEncryptionAtRest modifies the encryption of the whole package and
+// therefore must not be run in parallel with the other tests.
+func TestEncryptionAtRest(t *testing.T) {
+	dir := t.TempDir()
+	first, second := newKey(t), newKey(t)
+
+	kms, err := store.NewLocalKMS(writeKeys(t, dir, "keys", "first="+first))
+	require.NoError(t, err)
+
+	k8s := &spec.K8Scluster{ClusterInfo: &spec.ClusterInfo{Name: "cluster", Hash: "hash"}, Kubeconfig: "kubeconfig"}
+	task := &spec.Task{Do: &spec.Task_Create{Create: &spec.Create{K8S: k8s}}}
+
+	// stored before the encryption was enabled.
+	legacyK8s, err := store.ConvertFromGRPCCluster(k8s)
+	require.NoError(t, err)
+	legacyTask, err := store.ConvertFromGRPCTask(task)
+	require.NoError(t, err)
+
+	store.SetEncryption(kms)
+	t.Cleanup(func() { store.SetEncryption(nil) })
+
+	sealed, err := store.ConvertFromGRPCCluster(k8s)
+	require.NoError(t, err)
+	assert.True(t, store.IsSealed(sealed))
+	assert.NotContains(t, string(sealed), "kubeconfig")
+
+	got, err := store.ConvertToGRPCCluster(sealed)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(k8s, got))
+
+	got, err = store.ConvertToGRPCCluster(legacyK8s)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(k8s, got))
+
+	empty, err := store.ConvertFromGRPCLoadBalancers(&spec.LoadBalancers{})
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	cfg := &store.Config{
+		Name: "config",
+		Clusters: map[string]*store.ClusterState{
+			"cluster": {
+				Current:  store.Clusters{K8s: legacyK8s},
+				InFlight: &store.TaskEvent{Task: legacyTask, LowerPriority: &store.TaskEvent{Task: legacyTask}},
+			},
+		},
+	}
+
+	reencrypt, err := store.NeedsReencryption(cfg)
+	require.NoError(t, err)
+	assert.True(t, reencrypt)
+
+	require.NoError(t, store.Reencrypt(cfg))
+
+	reencrypt, err = store.NeedsReencryption(cfg)
+	require.NoError(t, err)
+	assert.False(t, reencrypt)
+
+	cs := cfg.Clusters["cluster"]
+	for _, b := range [][]byte{cs.Current.K8s, cs.InFlight.Task, cs.InFlight.LowerPriority.Task} {
+		assert.True(t, store.IsSealed(b))
+	}
+	assert.Empty(t, cs.Current.LoadBalancers)
+
+	gotTask, err := store.ConvertToGRPCTask(cs.InFlight.LowerPriority.Task)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(task, gotTask))
+
+	// rotate the key.
+	rotated, err := store.NewLocalKMS(writeKeys(t, dir, "rotated", "second="+second, "first="+first))
+	require.NoError(t, err)
+	store.SetEncryption(rotated)
+
+	reencrypt, err = store.NeedsReencryption(cfg)
+	require.NoError(t, err)
+	assert.True(t, reencrypt)
+
+	require.NoError(t, store.Reencrypt(cfg))
+
+	reencrypt, err = store.NeedsReencryption(cfg)
+	require.NoError(t, err)
+	assert.False(t, reencrypt)
+
+	got, err = store.ConvertToGRPCCluster(cs.Current.K8s)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(k8s, got))
+
+	// encrypted values cannot be read once the encryption is disabled.
+	store.SetEncryption(nil)
+	_, err = store.ConvertToGRPCCluster(cs.Current.K8s)
+	assert.Error(t, err)
+}
+
+func newKey(t *testing.T) string {
+	t.Helper()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func writeKeys(t *testing.T, dir, name string, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+	return path
+}
+
+// fakeVault implements the subset of the transit secrets engine API used by
+// the [store.VaultKMS]. The ciphertext is just the base64 encoded plaintext.
+type fakeVault struct {
+	lock    sync.Mutex
+	version int
+}
+
+func newFakeVault() *fakeVault { return &fakeVault{version: 1} }
+
+func (f *fakeVault) rotate() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.version++
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != "token" {
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string][]string{"errors": {"permission denied"}})
+		return
+	}
+
+	var req map[string]string
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+
+	var data map[string]any
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/transit/keys/claudie":
+		data = map[string]any{"latest_version": f.version}
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/transit/encrypt/claudie":
+		data = map[string]any{"ciphertext": fmt.Sprintf("vault:v%d:%s", f.version, req["plaintext"])}
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/transit/decrypt/claudie":
+		parts := strings.SplitN(req["ciphertext"], ":", 3)
+		if len(parts) != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data = map[string]any{"plaintext": parts[2]}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
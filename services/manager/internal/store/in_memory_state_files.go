@@ -0,0 +1,61 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var _ StateFiles = (*InMemoryStateFiles)(nil)
+
+type InMemoryStateFiles struct {
+	lock  sync.Mutex
+	files map[string][]byte
+}
+
+func NewInMemoryStateFiles() *InMemoryStateFiles {
+	return &InMemoryStateFiles{files: make(map[string][]byte)}
+}
+
+func (i *InMemoryStateFiles) List(_ context.Context, config string) ([]string, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var out []string
+	for k := range i.files {
+		if strings.HasPrefix(k, config+"/") && !strings.HasSuffix(k, lockFileSuffix) {
+			out = append(out, k)
+		}
+	}
+	slices.Sort(out)
+	return out, nil
+}
+
+func (i *InMemoryStateFiles) Get(_ context.Context, key string) ([]byte, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	b, ok := i.files[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrStateFileNotFound, key)
+	}
+	return slices.Clone(b), nil
+}
+
+func (i *InMemoryStateFiles) Put(_ context.Context, key string, data []byte) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.files[key] = slices.Clone(data)
+	return nil
+}
+
+func (i *InMemoryStateFiles) Delete(_ context.Context, key string) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	delete(i.files, key)
+	return nil
+}
@@ -14,6 +14,9 @@ var _ Store = (*InMemoryStore)(nil)
 
 type InMemoryStore struct {
 	db *sync.Map
+
+	historyLock sync.Mutex
+	history     []*HistoryEntry
 }
 
 func NewInMemoryStore() *InMemoryStore {
@@ -40,8 +43,7 @@ func (i *InMemoryStore) CreateConfig(_ context.Context, config *Config) error {
 
 func (i *InMemoryStore) UpdateConfig(_ context.Context, config *Config) error {
 	existing, exists := i.db.Load(config.Name)
-	cfg := existing.(*Config)
-	if !exists || cfg.Version != config.Version {
+	if !exists || existing.(*Config).Version != config.Version {
 		return ErrNotFoundOrDirty
 	}
 
@@ -63,7 +65,7 @@ func (i *InMemoryStore) ListConfigs(_ context.Context, filter *ListFilter) ([]*C
 	var out []*Config
 	i.db.Range(func(key, value any) bool {
 		cfg := value.(*Config)
-		if !slices.Contains(filter.ManifestState, cfg.Manifest.State) {
+		if filter != nil && len(filter.ManifestState) > 0 && !slices.Contains(filter.ManifestState, cfg.Manifest.State) {
 			return true
 		}
 		out = append(out, cfg)
@@ -82,11 +84,12 @@ func (i *InMemoryStore) DeleteConfig(_ context.Context, name string, version uin
 
 func (i *InMemoryStore) MarkForDeletion(_ context.Context, name string, version uint64) error {
 	existing, exists := i.db.Load(name)
-	cfg := existing.(*Config)
-	if !exists || cfg.Version != version {
+	if !exists || existing.(*Config).Version != version {
 		return ErrNotFoundOrDirty
 	}
 
+	cfg := existing.(*Config)
+
 	cfg.Manifest.Raw = ""
 	cfg.Manifest.Checksum = nil
 	cfg.Manifest.LastAppliedChecksum = hash.Digest("delete")
@@ -97,3 +100,45 @@ func (i *InMemoryStore) MarkForDeletion(_ context.Context, name string, version
 
 	return nil
 }
+
+func (i *InMemoryStore) AppendHistory(_ context.Context, entry *HistoryEntry) error {
+	i.historyLock.Lock()
+	defer i.historyLock.Unlock()
+
+	e := *entry
+	i.history = append(i.history, &e)
+	return nil
+}
+
+func (i *InMemoryStore) ListHistory(_ context.Context, filter *HistoryFilter) ([]*HistoryEntry, error) {
+	i.historyLock.Lock()
+	defer i.historyLock.Unlock()
+
+	var (
+		out     []*HistoryEntry
+		skipped int64
+	)
+
+	for _, e := range i.history {
+		if e.Config != filter.Config {
+			continue
+		}
+		if filter.Cluster != "" && e.Cluster != filter.Cluster {
+			continue
+		}
+		if filter.Since != "" && e.Timestamp < filter.Since {
+			continue
+		}
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+		if filter.Limit > 0 && int64(len(out)) >= filter.Limit {
+			break
+		}
+		c := *e
+		out = append(out, &c)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,143 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// KMS wraps the data keys used for encrypting the clusters and tasks within the configs.
+type KMS interface {
+	// Encrypt encrypts the plaintext with the current key.
+	Encrypt(plaintext []byte) ([]byte, error)
+	// Decrypt decrypts the ciphertext with the key it was encrypted with.
+	Decrypt(ciphertext []byte) ([]byte, error)
+	// IsCurrent returns whether the ciphertext was encrypted with the current key.
+	IsCurrent(ciphertext []byte) (bool, error)
+}
+
+// LocalKMS wraps the data keys with AES-256-GCM keys read from a file.
+// The ciphertext is in the form of: len(key id) | key id | nonce | ciphertext.
+type LocalKMS struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewLocalKMS reads the keys from the file. Each non-empty line, except comments
+// starting with '#', holds a key in the form of <id>=<base64 encoded 32 byte key>.
+// The key on the first line is used for encryption, the others are only kept for
+// decrypting the data keys encrypted before the key was rotated.
+func NewLocalKMS(path string) (*LocalKMS, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys file: %w", err)
+	}
+
+	kms := &LocalKMS{keys: make(map[string][]byte)}
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; scanner.Scan(); line++ {
+		l := strings.TrimSpace(scanner.Text())
+		if l == "" || strings.HasPrefix(l, "#") {
+			continue
+		}
+
+		id, encoded, ok := strings.Cut(l, "=")
+		id = strings.TrimSpace(id)
+		if !ok || id == "" || len(id) > 0xFF {
+			return nil, fmt.Errorf("invalid key on line %v, expected <id>=<base64 key>", line)
+		}
+		if _, exists := kms.keys[id]; exists {
+			return nil, fmt.Errorf("duplicate key id %q on line %v", id, line)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key %q: %w", id, err)
+		}
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("key %q must be %v bytes long, got %v", id, dataKeySize, len(key))
+		}
+
+		if kms.current == "" {
+			kms.current = id
+		}
+		kms.keys[id] = key
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read keys file: %w", err)
+	}
+
+	if kms.current == "" {
+		return nil, errors.New("no keys found in keys file")
+	}
+
+	return kms, nil
+}
+
+func (k *LocalKMS) Encrypt(plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(k.keys[k.current])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	out := make([]byte, 0, 1+len(k.current)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, byte(len(k.current)))
+	out = append(out, k.current...)
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, []byte(k.current)), nil
+}
+
+func (k *LocalKMS) Decrypt(ciphertext []byte) ([]byte, error) {
+	id, rest, err := splitLocalCiphertext(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in keys file", id)
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is truncated")
+	}
+
+	nonce, rest := rest[:aead.NonceSize()], rest[aead.NonceSize():]
+	plain, err := aead.Open(nil, nonce, rest, []byte(id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with key %q: %w", id, err)
+	}
+	return plain, nil
+}
+
+func (k *LocalKMS) IsCurrent(ciphertext []byte) (bool, error) {
+	id, _, err := splitLocalCiphertext(ciphertext)
+	if err != nil {
+		return false, err
+	}
+	return id == k.current, nil
+}
+
+func splitLocalCiphertext(b []byte) (id string, rest []byte, err error) {
+	if len(b) < 1 || len(b) < 1+int(b[0]) {
+		return "", nil, errors.New("ciphertext is truncated")
+	}
+	n := int(b[0])
+	return string(b[1 : 1+n]), b[1+n:], nil
+}
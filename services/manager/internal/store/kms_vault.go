@@ -0,0 +1,197 @@
+package store
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// vaultRequestTimeout is the timeout of a single request to Vault.
+	vaultRequestTimeout = 10 * time.Second
+
+	// vaultKeyVersionTTL is how long the latest version of the transit key is
+	// cached for, which bounds how long after a rotation of the key in Vault
+	// the data keys are still wrapped with the previous version.
+	vaultKeyVersionTTL = time.Minute
+)
+
+// VaultKMS wraps the data keys with a key of the HashiCorp Vault transit secrets engine.
+// The key is rotated within Vault, the ciphertexts of older versions of the key can be
+// decrypted as long as the versions are not trimmed or below the min_decryption_version.
+type VaultKMS struct {
+	addr  string
+	token string
+	mount string
+	key   string
+
+	client *http.Client
+
+	lock          sync.Mutex
+	latest        int
+	latestFetched time.Time
+}
+
+// NewVaultKMS returns a KMS using the transit key mounted at the mount path
+// of the Vault at addr, authenticating with the token.
+func NewVaultKMS(addr, token, mount, key string) (*VaultKMS, error) {
+	if addr == "" {
+		return nil, errors.New("missing address of vault")
+	}
+	if token == "" {
+		return nil, errors.New("missing vault token")
+	}
+	if mount == "" || key == "" {
+		return nil, errors.New("missing transit mount path or key name")
+	}
+
+	return &VaultKMS{
+		addr:   strings.TrimSuffix(addr, "/"),
+		token:  token,
+		mount:  strings.Trim(mount, "/"),
+		key:    key,
+		client: &http.Client{Timeout: vaultRequestTimeout},
+	}, nil
+}
+
+func (v *VaultKMS) Encrypt(plaintext []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(plaintext)}
+	if err := v.do(http.MethodPost, "encrypt", req, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Ciphertext == "" {
+		return nil, errors.New("vault returned empty ciphertext")
+	}
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+func (v *VaultKMS) Decrypt(ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+
+	req := map[string]string{"ciphertext": string(ciphertext)}
+	if err := v.do(http.MethodPost, "decrypt", req, &resp); err != nil {
+		return nil, err
+	}
+
+	plain, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode plaintext returned by vault: %w", err)
+	}
+	return plain, nil
+}
+
+func (v *VaultKMS) IsCurrent(ciphertext []byte) (bool, error) {
+	version, err := vaultKeyVersion(ciphertext)
+	if err != nil {
+		return false, err
+	}
+
+	latest, err := v.latestVersion()
+	if err != nil {
+		return false, err
+	}
+
+	return version >= latest, nil
+}
+
+func (v *VaultKMS) latestVersion() (int, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.latest > 0 && time.Since(v.latestFetched) < vaultKeyVersionTTL {
+		return v.latest, nil
+	}
+
+	var resp struct {
+		Data struct {
+			LatestVersion int `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := v.do(http.MethodGet, "keys", nil, &resp); err != nil {
+		return 0, err
+	}
+	if resp.Data.LatestVersion <= 0 {
+		return 0, fmt.Errorf("vault returned invalid latest version %v of key %q", resp.Data.LatestVersion, v.key)
+	}
+
+	v.latest, v.latestFetched = resp.Data.LatestVersion, time.Now()
+	return v.latest, nil
+}
+
+func (v *VaultKMS) do(method, op string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode vault request: %w", err)
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/%s/%s/%s", v.addr, v.mount, op, url.PathEscape(v.key))
+	req, err := http.NewRequest(method, endpoint, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", v.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to %s with vault transit key %q: %w", op, v.key, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read vault response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var verr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(b, &verr)
+		return fmt.Errorf("failed to %s with vault transit key %q: %s: %s", op, v.key, resp.Status, strings.Join(verr.Errors, ", "))
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	return nil
+}
+
+// vaultKeyVersion parses the version of the key from a ciphertext in the form of vault:v<version>:<ciphertext>.
+func vaultKeyVersion(ciphertext []byte) (int, error) {
+	parts := strings.SplitN(string(ciphertext), ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" || !strings.HasPrefix(parts[1], "v") {
+		return 0, errors.New("invalid vault ciphertext")
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid key version in vault ciphertext: %w", err)
+	}
+	return version, nil
+}
@@ -0,0 +1,10 @@
+-- Configs are stored as BSON encoded documents, the same layout as
+-- used by the MongoDB store, alongside the columns used for lookups.
+CREATE TABLE configs (
+    name           TEXT PRIMARY KEY,
+    version        BIGINT NOT NULL,
+    manifest_state TEXT NOT NULL,
+    document       BYTEA NOT NULL
+);
+
+CREATE INDEX configs_manifest_state_idx ON configs (manifest_state);
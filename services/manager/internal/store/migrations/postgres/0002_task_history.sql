@@ -0,0 +1,10 @@
+-- The id preserves the order in which the entries were appended.
+CREATE TABLE task_history (
+    id              BIGSERIAL PRIMARY KEY,
+    config          TEXT NOT NULL,
+    cluster         TEXT NOT NULL,
+    entry_timestamp TEXT NOT NULL,
+    document        BYTEA NOT NULL
+);
+
+CREATE INDEX task_history_config_cluster_timestamp_idx ON task_history (config, cluster, entry_timestamp);
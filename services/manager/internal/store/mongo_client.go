@@ -19,9 +19,10 @@ import (
 )
 
 const (
-	databaseName   = "claudie"
-	collectionName = "inputManifests"
-	pingTimeout    = 5 * time.Second
+	databaseName          = "claudie"
+	collectionName        = "inputManifests"
+	historyCollectionName = "taskHistory"
+	pingTimeout           = 5 * time.Second
 )
 
 var _ Store = (*Mongo)(nil)
@@ -29,6 +30,7 @@ var _ Store = (*Mongo)(nil)
 type Mongo struct {
 	conn       *mongo.Client
 	collection *mongo.Collection
+	history    *mongo.Collection
 }
 
 func NewMongoClient(ctx context.Context, uri string) (*Mongo, error) {
@@ -57,6 +59,14 @@ func (m *Mongo) Init() error {
 	if err != nil {
 		return fmt.Errorf("failed to create index: %q: %w", index, err)
 	}
+
+	m.history = m.conn.Database(databaseName).Collection(historyCollectionName)
+	index, err = m.history.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{{Key: "config", Value: 1}, {Key: "cluster", Value: 1}, {Key: "timestamp", Value: 1}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create history index: %q: %w", index, err)
+	}
 	return nil
 }
 
@@ -195,3 +205,45 @@ func (m *Mongo) MarkForDeletion(ctx context.Context, name string, version uint64
 
 	return nil
 }
+
+func (m *Mongo) AppendHistory(ctx context.Context, entry *HistoryEntry) error {
+	if _, err := m.history.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("failed to append history entry for task %q of config %q: %w", entry.TaskId, entry.Config, err)
+	}
+	return nil
+}
+
+func (m *Mongo) ListHistory(ctx context.Context, filter *HistoryFilter) ([]*HistoryEntry, error) {
+	f := bson.D{{Key: "config", Value: filter.Config}}
+	if filter.Cluster != "" {
+		f = append(f, bson.E{Key: "cluster", Value: filter.Cluster})
+	}
+	if filter.Since != "" {
+		f = append(f, bson.E{Key: "timestamp", Value: bson.M{"$gte": filter.Since}})
+	}
+
+	// The _id is an ObjectId which is monotonically increasing
+	// with each inserted document, thus preserving the order in
+	// which the entries were appended.
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetSkip(filter.Offset)
+	if filter.Limit > 0 {
+		opts = opts.SetLimit(filter.Limit)
+	}
+
+	cursor, err := m.history.Find(ctx, f, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history of config %q: %w", filter.Config, err)
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			log.Err(err).Msgf("failed to close mongoDB cursor")
+		}
+	}()
+
+	var out []*HistoryEntry
+	if err := cursor.All(ctx, &out); err != nil {
+		return nil, fmt.Errorf("failed to decode history of config %q: %w", filter.Config, err)
+	}
+
+	return out, nil
+}
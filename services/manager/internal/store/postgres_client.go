@@ -0,0 +1,366 @@
+package store
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/berops/claudie/internal/api/manifest"
+	"github.com/berops/claudie/internal/hash"
+	"github.com/berops/claudie/internal/sanitise"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// migrationsLockID is the key of the advisory lock held while applying the
+// migrations, as multiple replicas of the manager may start at the same time.
+const migrationsLockID = 0x636c617564696500 // "claudie\x00"
+
+//go:embed migrations/postgres/*.sql
+var postgresMigrations embed.FS
+
+var _ Store = (*Postgres)(nil)
+
+// Postgres implements the Store on top of PostgreSQL. The documents are stored
+// BSON encoded, with the same layout as in the [Mongo] store, alongside the
+// columns needed for querying them.
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgresClient(ctx context.Context, uri string) (*Postgres, error) {
+	pool, err := pgxpool.New(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %q: %w", sanitise.URI(uri), err)
+	}
+	return &Postgres{pool: pool}, nil
+}
+
+func (p *Postgres) Close() error {
+	p.pool.Close()
+	return nil
+}
+
+func (p *Postgres) HealthCheck() error {
+	ctx, done := context.WithTimeout(context.Background(), pingTimeout)
+	defer done()
+	return p.pool.Ping(ctx)
+}
+
+// Init applies the schema migrations that were not yet applied to the database.
+// The migrations are applied within a single transaction, either all of them
+// succeed or the schema is left untouched.
+func (p *Postgres) Init() error {
+	ctx := context.Background()
+
+	migrations, err := fs.Glob(postgresMigrations, "migrations/postgres/*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start migration transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(ctx); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			log.Err(err).Msgf("failed to rollback migration transaction")
+		}
+	}()
+
+	if _, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", migrationsLockID); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER PRIMARY KEY,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create migrations table: %w", err)
+	}
+
+	var applied int
+	if err := tx.QueryRow(ctx, "SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&applied); err != nil {
+		return fmt.Errorf("failed to query applied migrations: %w", err)
+	}
+
+	// fs.Glob returns the matches in lexical order, which is
+	// also the order in which the migrations are to be applied.
+	for _, m := range migrations {
+		version, err := migrationVersion(m)
+		if err != nil {
+			return err
+		}
+		if version <= applied {
+			continue
+		}
+
+		stmt, err := postgresMigrations.ReadFile(m)
+		if err != nil {
+			return fmt.Errorf("failed to read migration %q: %w", m, err)
+		}
+
+		if _, err := tx.Exec(ctx, string(stmt)); err != nil {
+			return fmt.Errorf("failed to apply migration %q: %w", m, err)
+		}
+
+		if _, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version); err != nil {
+			return fmt.Errorf("failed to record migration %q: %w", m, err)
+		}
+
+		log.Info().Msgf("Applied database migration %q", m)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit migrations: %w", err)
+	}
+	return nil
+}
+
+// migrationVersion parses the version from the migration file name
+// in the form of <version>_<description>.sql.
+func migrationVersion(file string) (int, error) {
+	prefix, _, _ := strings.Cut(path.Base(file), "_")
+	v, err := strconv.Atoi(prefix)
+	if err != nil {
+		return 0, fmt.Errorf("invalid migration file name %q, expected <version>_<description>.sql: %w", file, err)
+	}
+	return v, nil
+}
+
+func (p *Postgres) GetConfig(ctx context.Context, name string) (*Config, error) {
+	var doc []byte
+	err := p.pool.QueryRow(ctx, "SELECT document FROM configs WHERE name = $1", name).Scan(&doc)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFoundOrDirty
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to query document %q: %w", name, err)
+	}
+
+	var db Config
+	if err := bson.Unmarshal(doc, &db); err != nil {
+		return nil, fmt.Errorf("failed to decode config %q: %w", name, err)
+	}
+
+	return &db, nil
+}
+
+func (p *Postgres) ListConfigs(ctx context.Context, filter *ListFilter) ([]*Config, error) {
+	var (
+		query = "SELECT document FROM configs"
+		args  []any
+	)
+
+	if filter != nil && len(filter.ManifestState) > 0 {
+		query += " WHERE manifest_state = ANY($1)"
+		args = append(args, filter.ManifestState)
+	}
+
+	rows, err := p.pool.Query(ctx, query+" ORDER BY name", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*Config
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			return nil, fmt.Errorf("failed to read db config: %w", err)
+		}
+
+		var db Config
+		if err := bson.Unmarshal(doc, &db); err != nil {
+			return nil, fmt.Errorf("failed to decode db config: %w", err)
+		}
+		out = append(out, &db)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to advance rows: %w", err)
+	}
+
+	return out, nil
+}
+
+func (p *Postgres) CreateConfig(ctx context.Context, config *Config) error {
+	config.Version = 0                                // A new document always starts with 0 version.
+	config.Manifest.State = manifest.Pending.String() // A new document always starts with the Pending state
+
+	doc, err := bson.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to encode config %q: %w", config.Name, err)
+	}
+
+	_, err = p.pool.Exec(
+		ctx,
+		"INSERT INTO configs (name, version, manifest_state, document) VALUES ($1, $2, $3, $4)",
+		config.Name, int64(config.Version), config.Manifest.State, doc,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert config %q with version %v: %w", config.Name, config.Version, err)
+	}
+
+	log.Debug().Msgf("Inserted config %q with version %v", config.Name, config.Version)
+	return nil
+}
+
+func (p *Postgres) UpdateConfig(ctx context.Context, config *Config) error {
+	suppliedVersion := config.Version
+	config.Version += 1
+
+	doc, err := bson.Marshal(config)
+	if err != nil {
+		config.Version = suppliedVersion
+		return fmt.Errorf("failed to encode config %q with version %v: %w", config.Name, suppliedVersion, err)
+	}
+
+	tag, err := p.pool.Exec(
+		ctx,
+		"UPDATE configs SET version = $3, manifest_state = $4, document = $5 WHERE name = $1 AND version = $2",
+		config.Name, int64(suppliedVersion), int64(config.Version), config.Manifest.State, doc,
+	)
+	if err != nil {
+		config.Version = suppliedVersion
+		return fmt.Errorf("failed to update config %q with version %v: %w", config.Name, suppliedVersion, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		config.Version = suppliedVersion
+		return fmt.Errorf("failed to update config %q with version %v: %w", config.Name, suppliedVersion, ErrNotFoundOrDirty)
+	}
+
+	log.Debug().Msgf("Successfully Updated config %q with version %v, new version: %v", config.Name, suppliedVersion, config.Version)
+	return nil
+}
+
+func (p *Postgres) DeleteConfig(ctx context.Context, name string, version uint64) error {
+	tag, err := p.pool.Exec(ctx, "DELETE FROM configs WHERE name = $1 AND version = $2", name, int64(version))
+	if err != nil {
+		return fmt.Errorf("failed to delete config %q with version %v: %w", name, version, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to delete config %q under version %v: %w", name, version, ErrNotFoundOrDirty)
+	}
+
+	log.Info().Msgf("Document %q with version %v was deleted from database", name, version)
+	return nil
+}
+
+func (p *Postgres) MarkForDeletion(ctx context.Context, name string, version uint64) error {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		var doc []byte
+		err := tx.QueryRow(
+			ctx,
+			"SELECT document FROM configs WHERE name = $1 AND version = $2 FOR UPDATE",
+			name, int64(version),
+		).Scan(&doc)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFoundOrDirty
+		} else if err != nil {
+			return err
+		}
+
+		var db Config
+		if err := bson.Unmarshal(doc, &db); err != nil {
+			return fmt.Errorf("failed to decode config: %w", err)
+		}
+
+		db.Manifest.Raw = ""
+		db.Manifest.Checksum = nil
+		db.Manifest.LastAppliedChecksum = hash.Digest("delete") // modify the last applied checksum so that repeated deletion will trigger the process again.
+		db.Version += 1
+
+		if doc, err = bson.Marshal(&db); err != nil {
+			return fmt.Errorf("failed to encode config: %w", err)
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE configs SET version = $2, document = $3 WHERE name = $1", name, int64(db.Version), doc)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark config %q with version %v for deletion: %w", name, version, err)
+	}
+
+	log.Debug().Msgf("Successfully marked config %q with version %v for deletion", name, version)
+
+	return nil
+}
+
+func (p *Postgres) AppendHistory(ctx context.Context, entry *HistoryEntry) error {
+	doc, err := bson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode history entry for task %q of config %q: %w", entry.TaskId, entry.Config, err)
+	}
+
+	_, err = p.pool.Exec(
+		ctx,
+		"INSERT INTO task_history (config, cluster, entry_timestamp, document) VALUES ($1, $2, $3, $4)",
+		entry.Config, entry.Cluster, entry.Timestamp, doc,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append history entry for task %q of config %q: %w", entry.TaskId, entry.Config, err)
+	}
+	return nil
+}
+
+func (p *Postgres) ListHistory(ctx context.Context, filter *HistoryFilter) ([]*HistoryEntry, error) {
+	var (
+		query strings.Builder
+		args  = []any{filter.Config}
+	)
+
+	query.WriteString("SELECT document FROM task_history WHERE config = $1")
+	if filter.Cluster != "" {
+		args = append(args, filter.Cluster)
+		fmt.Fprintf(&query, " AND cluster = $%d", len(args))
+	}
+	if filter.Since != "" {
+		args = append(args, filter.Since)
+		fmt.Fprintf(&query, " AND entry_timestamp >= $%d", len(args))
+	}
+
+	args = append(args, filter.Offset)
+	fmt.Fprintf(&query, " ORDER BY id OFFSET $%d", len(args))
+
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		fmt.Fprintf(&query, " LIMIT $%d", len(args))
+	}
+
+	rows, err := p.pool.Query(ctx, query.String(), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query history of config %q: %w", filter.Config, err)
+	}
+	defer rows.Close()
+
+	var out []*HistoryEntry
+	for rows.Next() {
+		var doc []byte
+		if err := rows.Scan(&doc); err != nil {
+			return nil, fmt.Errorf("failed to read history of config %q: %w", filter.Config, err)
+		}
+
+		var e HistoryEntry
+		if err := bson.Unmarshal(doc, &e); err != nil {
+			return nil, fmt.Errorf("failed to decode history of config %q: %w", filter.Config, err)
+		}
+		out = append(out, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query history of config %q: %w", filter.Config, err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,148 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	transport "github.com/aws/smithy-go/endpoints"
+	"github.com/berops/claudie/internal/envs"
+)
+
+// lockFileSuffix is the suffix of the lock files created by OpenTofu
+// next to the state files, which are not part of the state.
+const lockFileSuffix = ".tflock"
+
+// ErrStateFileNotFound is returned when the requested state file is not present in the StateFiles.
+var ErrStateFileNotFound = errors.New("state file not found")
+
+// StateFiles is the S3 style storage of the OpenTofu state files of the infrastructure
+// build for the configs. The state files of a config are stored under the keys prefixed
+// with the name of the config, i.e. <config>/<cluster-id>.
+type StateFiles interface {
+	// List returns the keys of the state files stored for the config, excluding lock files.
+	List(ctx context.Context, config string) ([]string, error)
+
+	// Get returns the content of the state file. If there is no such state
+	// file the ErrStateFileNotFound err is returned.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Put stores the state file, replacing any existing content.
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Delete removes the state file. Deleting a state file that does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+var _ StateFiles = (*S3StateFiles)(nil)
+
+type S3StateFiles struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3StateFiles creates a StateFiles backed by the bucket used by the terraformer
+// service, configured via the same environment variables.
+func NewS3StateFiles() *S3StateFiles {
+	opts := s3.Options{
+		Region: envs.AwsRegion,
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: envs.AwsAccesskeyId, SecretAccessKey: envs.AwsSecretAccessKey}, nil
+		}),
+		RetryMaxAttempts: 10,
+		RetryMode:        aws.RetryModeStandard,
+	}
+	if envs.BucketEndpoint != "" {
+		opts.EndpointResolverV2 = &pathStyleResolver{endpoint: envs.BucketEndpoint}
+	}
+	return &S3StateFiles{client: s3.New(opts), bucket: envs.BucketName}
+}
+
+func (s *S3StateFiles) List(ctx context.Context, config string) ([]string, error) {
+	var (
+		out       []string
+		paginator = s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+			Bucket: aws.String(s.bucket),
+			Prefix: aws.String(config + "/"),
+		})
+	)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list state files of config %q: %w", config, err)
+		}
+		for _, o := range page.Contents {
+			if key := aws.ToString(o.Key); !strings.HasSuffix(key, lockFileSuffix) {
+				out = append(out, key)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func (s *S3StateFiles) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NoSuchKey
+		if errors.As(err, &notFound) {
+			return nil, fmt.Errorf("%w: %s", ErrStateFileNotFound, key)
+		}
+		return nil, fmt.Errorf("failed to get state file %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state file %s: %w", key, err)
+	}
+	return b, nil
+}
+
+func (s *S3StateFiles) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put state file %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *S3StateFiles) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete state file %s: %w", key, err)
+	}
+	return nil
+}
+
+// pathStyleResolver resolves the bucket to the path of the custom endpoint,
+// as used by MinIO, instead of the virtual host style of AWS S3.
+type pathStyleResolver struct{ endpoint string }
+
+func (r *pathStyleResolver) ResolveEndpoint(_ context.Context, params s3.EndpointParameters) (transport.Endpoint, error) {
+	u, err := url.Parse(r.endpoint)
+	if err != nil {
+		return transport.Endpoint{}, err
+	}
+
+	u.Path += "/" + *params.Bucket
+	return transport.Endpoint{URI: *u}, nil
+}
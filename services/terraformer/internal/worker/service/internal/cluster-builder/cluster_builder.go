@@ -1,11 +1,15 @@
 package cluster_builder
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"net/netip"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -76,10 +80,16 @@ type ClusterBuilder struct {
 
 	// SpawnProcessLimit limits the number of spawned tofu processes.
 	SpawnProcessLimit *semaphore.Weighted
+
+	// UnenforcedSourceRanges is set by [ClusterBuilder.ReconcileNodePools] to the
+	// providers of the loadbalancer nodepools, whose templates do not use the
+	// allowed source ranges of the roles in the firewall rules, in which case
+	// the ranges are enforced only by Envoy.
+	UnenforcedSourceRanges []string
 }
 
 // CreateNodepools creates node pools for the cluster.
-func (c ClusterBuilder) ReconcileNodePools() error {
+func (c *ClusterBuilder) ReconcileNodePools() error {
 	clusterDir := filepath.Join(Output, c.ClusterId)
 
 	defer func() {
@@ -130,7 +140,7 @@ func (c ClusterBuilder) ReconcileNodePools() error {
 				if target != n.Name {
 					continue
 				}
-				ip, sshPort, wgPort, err := parseNodeOutput(val)
+				ip, ipv6, sshPort, wgPort, err := parseNodeOutput(val)
 				if err != nil {
 					return fmt.Errorf("node %q from nodepool %q: %w", n.Name, nodepool.Name, err)
 				}
@@ -139,6 +149,7 @@ func (c ClusterBuilder) ReconcileNodePools() error {
 				}
 				found = true
 				n.Public = ip
+				n.PublicIPv6 = ipv6
 				if sshPort > 0 {
 					n.SshPort = sshPort
 				}
@@ -301,6 +312,16 @@ func (c *ClusterBuilder) generateFiles(clusterDir string) error {
 				NodePools:   nps,
 			}
 
+			if c.ClusterType == LoadBalancer && restrictsSourceRanges(c.LBInfo.Roles) {
+				ok, err := usesSourceRanges(filepath.Join(templatesDownloadDir, extofu.TemplatesPath(p)))
+				if err != nil {
+					return fmt.Errorf("failed to read templates of provider %q: %w", p.SpecName, err)
+				}
+				if !ok {
+					c.UnenforcedSourceRanges = append(c.UnenforcedSourceRanges, p.SpecName)
+				}
+			}
+
 			if err := g.GenerateNetworking(&n); err != nil {
 				return fmt.Errorf("failed to generate networking_common template files: %w", err)
 			}
@@ -314,25 +335,60 @@ func (c *ClusterBuilder) generateFiles(clusterDir string) error {
 	return nil
 }
 
+// restrictsSourceRanges returns true if any of the roles is reachable only from its allowed source ranges.
+func restrictsSourceRanges(roles []*spec.Role) bool {
+	return slices.ContainsFunc(roles, func(r *spec.Role) bool { return len(r.AllowedSourceRanges) > 0 })
+}
+
+// usesSourceRanges returns true if the "networking" or "nodepool" templates within the
+// passed in directory use the SourceRanges of the roles, see [spec.Role.SourceRanges].
+func usesSourceRanges(dir string) (bool, error) {
+	for _, sub := range []string{"networking", "nodepool"} {
+		files, err := os.ReadDir(filepath.Join(dir, sub))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return false, err
+		}
+		for _, f := range files {
+			if f.IsDir() {
+				continue
+			}
+			b, err := os.ReadFile(filepath.Join(dir, sub, f.Name()))
+			if err != nil {
+				return false, err
+			}
+			if bytes.Contains(b, []byte("SourceRanges")) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 // parseNodeOutput extracts the IP and optional per-node SSH and WireGuard ports
 // from a terraform output value. Templates may output any of:
 //   - a string (just the IP)
 //   - [IP, sshPort]
 //   - [IP, sshPort, wireguardPort]
+//   - [IP, sshPort, wireguardPort, IPv6]
 //
 // The ports are used by shared-IP / NAT nodes (e.g. CloudRift) where each VM is
 // reached on its own mapped host port. A zero/absent port means "use the default".
-func parseNodeOutput(val any) (ip string, sshPort, wgPort int32, err error) {
+// The IPv6 address is set for dual-stack nodes, while nodes reachable only via
+// IPv6 output the IPv6 address as the IP.
+func parseNodeOutput(val any) (ip, ipv6 string, sshPort, wgPort int32, err error) {
 	switch v := val.(type) {
 	case string:
-		return v, 0, 0, nil
+		return v, "", 0, 0, nil
 	case []any:
 		if len(v) == 0 {
-			return "", 0, 0, fmt.Errorf("empty output array")
+			return "", "", 0, 0, fmt.Errorf("empty output array")
 		}
 		ipStr, ok := v[0].(string)
 		if !ok || ipStr == "" {
-			return "", 0, 0, fmt.Errorf("invalid IP value type %T", v[0])
+			return "", "", 0, 0, fmt.Errorf("invalid IP value type %T", v[0])
 		}
 		if len(v) >= 2 {
 			sshPort = parsePort(v[1])
@@ -340,13 +396,30 @@ func parseNodeOutput(val any) (ip string, sshPort, wgPort int32, err error) {
 		if len(v) >= 3 {
 			wgPort = parsePort(v[2])
 		}
-		return ipStr, sshPort, wgPort, nil
+		if len(v) >= 4 {
+			ipv6 = parseIPv6(v[3])
+		}
+		return ipStr, ipv6, sshPort, wgPort, nil
 	default:
 		if val == nil {
-			return "", 0, 0, fmt.Errorf("nil output value")
+			return "", "", 0, 0, fmt.Errorf("nil output value")
 		}
-		return "", 0, 0, fmt.Errorf("unsupported output value type %T", val)
+		return "", "", 0, 0, fmt.Errorf("unsupported output value type %T", val)
+	}
+}
+
+// parseIPv6 parses a terraform output element into an IPv6 address,
+// returning an empty string when it is empty, null, or not an IPv6 address.
+func parseIPv6(val any) string {
+	s, ok := val.(string)
+	if !ok {
+		return ""
+	}
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil || !addr.Is6() || addr.Is4In6() {
+		return ""
 	}
+	return addr.String()
 }
 
 // parsePort parses a terraform output element into a positive port number,
@@ -1,6 +1,8 @@
 package cluster_builder
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -12,6 +14,7 @@ func Test_parseNodeOutput(t *testing.T) {
 		name        string
 		val         any
 		wantIP      string
+		wantIPv6    string
 		wantSSHPort int32
 		wantWGPort  int32
 		wantErr     bool
@@ -26,22 +29,25 @@ func Test_parseNodeOutput(t *testing.T) {
 		{name: "zero/invalid ports fall back to 0", val: []any{"1.2.3.4", float64(0), "notaport"}, wantIP: "1.2.3.4"},
 		{name: "port with suffix is rejected", val: []any{"1.2.3.4", "22222x", "41234x"}, wantIP: "1.2.3.4"},
 		{name: "out-of-range ports fall back to 0", val: []any{"1.2.3.4", float64(65536), float64(99999)}, wantIP: "1.2.3.4"},
+		{name: "ip + ports + ipv6", val: []any{"1.2.3.4", float64(22), float64(51820), "2001:db8::1"}, wantIP: "1.2.3.4", wantIPv6: "2001:db8::1", wantSSHPort: 22, wantWGPort: 51820},
+		{name: "invalid ipv6 is ignored", val: []any{"1.2.3.4", nil, nil, "1.2.3.5"}, wantIP: "1.2.3.4"},
+		{name: "ipv6 only", val: "2001:db8::1", wantIP: "2001:db8::1"},
 		{name: "non-string ip element errors", val: []any{float64(1234), "22222"}, wantErr: true},
 		{name: "empty array errors", val: []any{}, wantErr: true},
 		{name: "nil errors", val: nil, wantErr: true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ip, sshPort, wgPort, err := parseNodeOutput(tt.val)
+			ip, ipv6, sshPort, wgPort, err := parseNodeOutput(tt.val)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("parseNodeOutput() error = %v, wantErr %v", err, tt.wantErr)
 			}
 			if tt.wantErr {
 				return
 			}
-			if ip != tt.wantIP || sshPort != tt.wantSSHPort || wgPort != tt.wantWGPort {
-				t.Errorf("parseNodeOutput() = (%q, %d, %d), want (%q, %d, %d)",
-					ip, sshPort, wgPort, tt.wantIP, tt.wantSSHPort, tt.wantWGPort)
+			if ip != tt.wantIP || ipv6 != tt.wantIPv6 || sshPort != tt.wantSSHPort || wgPort != tt.wantWGPort {
+				t.Errorf("parseNodeOutput() = (%q, %q, %d, %d), want (%q, %q, %d, %d)",
+					ip, ipv6, sshPort, wgPort, tt.wantIP, tt.wantIPv6, tt.wantSSHPort, tt.wantWGPort)
 			}
 		})
 	}
@@ -86,3 +92,35 @@ func Test_readIPs(t *testing.T) {
 		})
 	}
 }
+
+func Test_usesSourceRanges(t *testing.T) {
+	dir := t.TempDir()
+
+	ok, err := usesSourceRanges(dir)
+	if err != nil || ok {
+		t.Fatalf("usesSourceRanges() on empty templates = %v, %v, want false, nil", ok, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "networking"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	open := `source_ips = ["0.0.0.0/0", "::/0"]`
+	if err := os.WriteFile(filepath.Join(dir, "networking", "networking.tpl"), []byte(open), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err = usesSourceRanges(dir)
+	if err != nil || ok {
+		t.Fatalf("usesSourceRanges() on templates open to anywhere = %v, %v, want false, nil", ok, err)
+	}
+
+	restricted := `source_ips = {{ toJson $role.SourceRanges }}`
+	if err := os.WriteFile(filepath.Join(dir, "networking", "networking.tpl"), []byte(restricted), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err = usesSourceRanges(dir)
+	if err != nil || !ok {
+		t.Fatalf("usesSourceRanges() on templates using the source ranges = %v, %v, want true, nil", ok, err)
+	}
+}
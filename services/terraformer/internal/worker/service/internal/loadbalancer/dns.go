@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	comm "github.com/berops/claudie/internal/command"
 	"github.com/berops/claudie/internal/extemplates/extofu"
@@ -25,7 +26,7 @@ type DNS struct {
 	ProjectName string
 	ClusterName string
 	ClusterHash string
-	NodeIPs     []string
+	NodeIPs     []extofu.IPData
 	Dns         *spec.DNS
 
 	// SpawnProcessLimit limits the number of spawned tofu processes.
@@ -199,12 +200,16 @@ func (d *DNS) generateProvider(dnsID, dnsDir string, dns *spec.DNS) error {
 }
 
 // generateFiles creates all the necessary terraform files used to create/destroy DNS.
-func (d *DNS) generateFiles(logger zerolog.Logger, dnsID, dnsDir string, dns *spec.DNS, nodeIPs []string) error {
+func (d *DNS) generateFiles(logger zerolog.Logger, dnsID, dnsDir string, dns *spec.DNS, nodeIPs []extofu.IPData) error {
 	templateDir := filepath.Join(TemplatesRootDir, dnsID, dns.GetProvider().GetSpecName())
 	if err := extofu.Download(templateDir, dns.GetProvider()); err != nil {
 		return fmt.Errorf("failed to download templates for DNS %q: %w", dnsID, err)
 	}
+	return d.renderFiles(logger, dnsID, dnsDir, templateDir, dns, nodeIPs)
+}
 
+// renderFiles renders the DNS templates of the provider read from the templateDir into the dnsDir.
+func (d *DNS) renderFiles(logger zerolog.Logger, dnsID, dnsDir, templateDir string, dns *spec.DNS, nodeIPs []extofu.IPData) error {
 	g := extofu.Generator{
 		ID:                dnsID,
 		TargetDirectory:   dnsDir,
@@ -218,7 +223,7 @@ func (d *DNS) generateFiles(logger zerolog.Logger, dnsID, dnsDir string, dns *sp
 		Hostname:    dns.Hostname,
 		ClusterName: d.ClusterName,
 		ClusterHash: d.ClusterHash,
-		RecordData:  extofu.RecordData{IP: templateIPData(nodeIPs)},
+		RecordData:  recordData(recordIPs(nodeIPs, dns.UnreachableEndpoints)),
 		Provider:    dns.Provider,
 
 		AlternativeNamesExtension: new(extofu.AlternativeNamesExtension),
@@ -272,11 +277,53 @@ func readDomain(data string) (extofu.DNSDomain, error) {
 	return result, err
 }
 
-func templateIPData(ips []string) []extofu.IPData {
-	out := make([]extofu.IPData, 0, len(ips))
+// recordIPs returns the IPs to which the DNS records point, leaving out the unreachable
+// nodes. If none of the nodes are reachable the records point to all of them, as there
+// is no better option.
+func recordIPs(nodeIPs []extofu.IPData, unreachable []string) []extofu.IPData {
+	reachable := slices.DeleteFunc(slices.Clone(nodeIPs), func(ip extofu.IPData) bool {
+		return slices.Contains(unreachable, ip.V4) || slices.Contains(unreachable, ip.V6)
+	})
+	if len(reachable) == 0 {
+		return nodeIPs
+	}
+	return reachable
+}
 
+// recordData splits the IPs into the ones for the A and AAAA records, so that
+// the templates never render a record for an empty address.
+func recordData(ips []extofu.IPData) extofu.RecordData {
+	var data extofu.RecordData
 	for _, ip := range ips {
-		out = append(out, extofu.IPData{V4: ip})
+		if ip.V4 != "" {
+			data.IP = append(data.IP, ip)
+		}
+		if ip.V6 != "" {
+			data.IPv6 = append(data.IPv6, ip)
+		}
+	}
+	return data
+}
+
+// RecordIPs returns the IPs to which the DNS records of the loadbalancer resolve,
+// which is its virtual IP if it has one, otherwise the IPs of its nodes.
+func RecordIPs(lb *spec.LBcluster) []extofu.IPData {
+	if ip := lb.GetVirtualIP().GetIp(); ip != "" {
+		return []extofu.IPData{{V4: ip}}
+	}
+	return NodeIPs(lb.GetClusterInfo().GetNodePools())
+}
+
+// NodeIPs returns the public IPv4 and IPv6 addresses of the nodes of the passed in nodepools,
+// for the A and AAAA records respectively. Either of them may be empty, as nodes may be
+// reachable only via IPv4 or only via IPv6.
+func NodeIPs(nps []*spec.NodePool) []extofu.IPData {
+	var out []extofu.IPData
+
+	for _, np := range nps {
+		for _, n := range np.Nodes {
+			out = append(out, extofu.IPData{V4: n.PublicV4(), V6: n.PublicV6()})
+		}
 	}
 
 	return out
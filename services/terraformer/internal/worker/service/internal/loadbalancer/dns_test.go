@@ -0,0 +1,230 @@
+package loadbalancer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/berops/claudie/internal/extemplates/extofu"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/terraformer/internal/worker/service/internal/templates"
+	"github.com/berops/claudie/services/terraformer/internal/worker/service/internal/tofu"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/semaphore"
+)
+
+// bindImage is the BIND server the records of the rfc2136 provider are tested against.
+const bindImage = "internetsystemsconsortium/bind9:9.20"
+
+// TestRFC2136 creates, replaces and deletes the records of a zone on a local BIND
+// server with the example templates of the rfc2136 provider from the docs, which
+// are kept in testdata. The test requires docker and tofu and is skipped without them.
+func TestRFC2136(t *testing.T) {
+	for _, bin := range []string{"docker", "tofu"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Skipf("%s is required to run the test against a BIND server: %v", bin, err)
+		}
+	}
+
+	secret := base64.StdEncoding.EncodeToString([]byte(rand.Text()))
+	addr := startBind(t, secret)
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	var p int32
+	_, err = fmt.Sscan(port, &p)
+	require.NoError(t, err)
+
+	dns := &spec.DNS{
+		DnsZone:          "example.com",
+		Hostname:         "api",
+		AlternativeNames: []*spec.AlternativeName{{Hostname: "www"}},
+		Provider: &spec.Provider{
+			SpecName:          "bind",
+			CloudProviderName: "rfc2136",
+			ProviderType: &spec.Provider_Rfc2136{Rfc2136: &spec.RFC2136Provider{
+				Server:       host,
+				Port:         p,
+				KeyName:      "claudie.",
+				KeyAlgorithm: "hmac-sha256",
+				KeySecret:    secret,
+			}},
+		},
+	}
+
+	var (
+		d = DNS{
+			ClusterName:       "lb",
+			ClusterHash:       "hash",
+			Dns:               dns,
+			SpawnProcessLimit: semaphore.NewWeighted(1),
+		}
+		dnsID = "lb-hash-dns"
+		dir   = t.TempDir()
+		tf    = tofu.Terraform{
+			Directory:         dir,
+			CacheDir:          t.TempDir(),
+			SpawnProcessLimit: d.SpawnProcessLimit,
+			Stdout:            t.Output(),
+			Stderr:            t.Output(),
+		}
+	)
+
+	apply := func(ips []extofu.IPData) {
+		t.Helper()
+		providers := templates.UsedProviders{ClusterName: dnsID, Directory: dir}
+		require.NoError(t, providers.CreateUsedProviderDNS(dns))
+		require.NoError(t, d.renderFiles(zerolog.Nop(), dnsID, dir, filepath.Join("testdata", "rfc2136"), dns, ips))
+		require.NoError(t, tf.Init())
+		require.NoError(t, tf.Apply())
+	}
+
+	// create
+	apply([]extofu.IPData{{V4: "192.0.2.1"}, {V4: "192.0.2.2", V6: "2001:db8::2"}})
+
+	output, err := tf.Output(extofu.DnsEndpointTerraformKey(dns, "lb-hash", ""))
+	require.NoError(t, err)
+	domain, err := readDomain(output)
+	require.NoError(t, err)
+	require.Equal(t, "api.example.com", domain.Domain["lb-hash-endpoint"])
+
+	output, err = tf.Output(extofu.DnsEndpointTerraformKey(dns, "lb-hash", "www"))
+	require.NoError(t, err)
+	domain, err = readDomain(output)
+	require.NoError(t, err)
+	require.Equal(t, "www.example.com", domain.Domain["lb-hash-www-endpoint"])
+
+	for _, name := range []string{"api", "www"} {
+		require.Equal(t, []string{"192.0.2.1", "192.0.2.2", "2001:db8::2"}, lookup(t, addr, name+".example.com."))
+	}
+
+	// replace, the nodes reachable only via IPv6 drop the A records.
+	apply([]extofu.IPData{{V6: "2001:db8::3"}})
+	for _, name := range []string{"api", "www"} {
+		require.Equal(t, []string{"2001:db8::3"}, lookup(t, addr, name+".example.com."))
+	}
+
+	// delete
+	require.NoError(t, tf.Destroy())
+	for _, name := range []string{"api", "www"} {
+		require.Empty(t, lookup(t, addr, name+".example.com."))
+	}
+}
+
+func TestRecordData(t *testing.T) {
+	got := recordData([]extofu.IPData{
+		{V4: "192.0.2.1"},
+		{V4: "192.0.2.2", V6: "2001:db8::2"},
+		{V6: "2001:db8::3"},
+	})
+
+	require.Equal(t, []extofu.IPData{{V4: "192.0.2.1"}, {V4: "192.0.2.2", V6: "2001:db8::2"}}, got.IP)
+	require.Equal(t, []extofu.IPData{{V4: "192.0.2.2", V6: "2001:db8::2"}, {V6: "2001:db8::3"}}, got.IPv6)
+}
+
+// startBind starts a BIND server in a container serving the example.com zone, which
+// accepts the updates signed with the passed in TSIG secret. Returns the address of the server.
+func startBind(t *testing.T, secret string) string {
+	t.Helper()
+
+	config := fmt.Sprintf(`options {
+	directory "/var/cache/bind";
+	listen-on { any; };
+	listen-on-v6 { none; };
+	recursion no;
+};
+
+key "claudie." {
+	algorithm hmac-sha256;
+	secret "%s";
+};
+
+zone "example.com" {
+	type primary;
+	file "/var/lib/bind/example.com.zone";
+	update-policy { grant claudie. zonesub ANY; };
+};
+`, secret)
+
+	zone := `$TTL 300
+@	IN SOA	ns1.example.com. admin.example.com. ( 1 3600 600 86400 300 )
+@	IN NS	ns1.example.com.
+ns1	IN A	127.0.0.1
+`
+
+	configDir, zoneDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(configDir, "named.conf"), []byte(config), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(zoneDir, "example.com.zone"), []byte(zone), 0o666))
+	// named runs as an unprivileged user within the container, which writes the journal of the zone.
+	require.NoError(t, os.Chmod(configDir, 0o755))
+	require.NoError(t, os.Chmod(zoneDir, 0o777))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	//nolint
+	out, err := exec.Command("docker", "run", "-d", "--rm",
+		"-p", addr+":53/tcp",
+		"-p", addr+":53/udp",
+		"-v", configDir+":/etc/bind:ro",
+		"-v", zoneDir+":/var/lib/bind",
+		bindImage,
+	).Output()
+	require.NoError(t, err, "failed to start %s", bindImage)
+
+	id := strings.TrimSpace(string(out))
+	t.Cleanup(func() {
+		//nolint
+		if err := exec.Command("docker", "rm", "-f", id).Run(); err != nil {
+			t.Logf("failed to remove container %s: %v", id, err)
+		}
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := resolver(addr).LookupNS(t.Context(), "example.com.")
+		return err == nil
+	}, 30*time.Second, 500*time.Millisecond, "BIND server did not start")
+
+	return addr
+}
+
+// lookup returns the sorted addresses of the name as served by the server at addr.
+func lookup(t *testing.T, addr, name string) []string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	ips, err := resolver(addr).LookupHost(ctx, name)
+	if dnsErr, ok := errors.AsType[*net.DNSError](err); ok && dnsErr.IsNotFound {
+		return nil
+	}
+	require.NoError(t, err)
+
+	slices.Sort(ips)
+	return ips
+}
+
+func resolver(addr string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, addr)
+		},
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
 	cluster_builder "github.com/berops/claudie/services/terraformer/internal/worker/service/internal/cluster-builder"
 	"github.com/rs/zerolog"
 
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -36,6 +36,9 @@ type LBcluster struct {
 
 	// SpawnProcessLimit  limits the number of spawned tofu processes.
 	SpawnProcessLimit *semaphore.Weighted
+
+	// Warnings are the non-fatal diagnostics of the last [LBcluster.Build] call.
+	Warnings []error
 }
 
 func (l *LBcluster) Id() string         { return l.Cluster.ClusterInfo.Id() }
@@ -47,7 +50,7 @@ func (l *LBcluster) Build(logger zerolog.Logger) error {
 	var (
 		projectName  = l.ProjectName
 		ci           = l.Cluster.ClusterInfo
-		roles        = l.Cluster.Roles
+		roles        = firewallRoles(l.Cluster.Roles)
 		processLimit = l.SpawnProcessLimit
 	)
 
@@ -65,11 +68,25 @@ func (l *LBcluster) Build(logger zerolog.Logger) error {
 		SpawnProcessLimit: processLimit,
 	}
 
-	if err := clusterBuilder.ReconcileNodePools(); err != nil {
+	l.Warnings = nil
+	err := clusterBuilder.ReconcileNodePools()
+	for _, p := range clusterBuilder.UnenforcedSourceRanges {
+		l.Warnings = append(l.Warnings, fmt.Errorf(
+			"templates of provider %q do not use the allowedSourceRanges of the roles of loadbalancer %s in the firewall rules, the ranges are enforced only by Envoy",
+			p,
+			ci.Name,
+		))
+	}
+	if err != nil {
 		return fmt.Errorf("%w: error while creating the LB cluster %s : %w", ErrCreateNodePools, ci.Name, err)
 	}
 
-	nodeIPs := nodepools.PublicEndpoints(l.Cluster.ClusterInfo.NodePools)
+	if l.Cluster.Dns == nil {
+		// without DNS the loadbalancer is reachable only at its virtual IP.
+		return nil
+	}
+
+	nodeIPs := RecordIPs(l.Cluster)
 	dns := DNS{
 		ProjectName:       projectName,
 		ClusterName:       ci.Name,
@@ -93,7 +110,7 @@ func (l *LBcluster) Destroy(logger zerolog.Logger) error {
 		projectName  = l.ProjectName
 		ci           = l.Cluster.ClusterInfo
 		processLimit = l.SpawnProcessLimit
-		nodeIPs      = nodepools.PublicEndpoints(ci.NodePools)
+		nodeIPs      = RecordIPs(l.Cluster)
 	)
 
 	group := errgroup.Group{}
@@ -121,7 +138,7 @@ func (l *LBcluster) Destroy(logger zerolog.Logger) error {
 
 		var emptycount int
 		for _, ip := range nodeIPs {
-			if ip == "" {
+			if ip.V4 == "" && ip.V6 == "" {
 				emptycount += 1
 			}
 		}
@@ -152,3 +169,24 @@ func (l *LBcluster) Destroy(logger zerolog.Logger) error {
 
 	return group.Wait()
 }
+
+// firewallRoles returns the roles as expected by the firewall rules of the templates,
+// which only know the transport protocols. Roles with the http protocol are served over tcp.
+func firewallRoles(roles []*spec.Role) []*spec.Role {
+	result := make([]*spec.Role, 0, len(roles))
+	for _, r := range roles {
+		if r.Protocol == "http" {
+			r = proto.Clone(r).(*spec.Role)
+			r.Protocol = "tcp"
+		}
+		// The nodes of the kubernetes cluster reach the api server via the public
+		// endpoint of the loadbalancer, thus the firewall is left open for it and
+		// the source ranges are enforced by envoy, which also allows the nodes.
+		if r.RoleType == spec.RoleType_ApiServer && len(r.AllowedSourceRanges) > 0 {
+			r = proto.Clone(r).(*spec.Role)
+			r.AllowedSourceRanges = nil
+		}
+		result = append(result, r)
+	}
+	return result
+}
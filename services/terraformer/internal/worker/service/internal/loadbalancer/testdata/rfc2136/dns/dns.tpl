@@ -0,0 +1,66 @@
+{{- $specName := .Data.Provider.SpecName }}
+{{- $rfc2136 := .Data.Provider.GetRfc2136 }}
+{{- $clusterId := printf "%s-%s" .Data.ClusterName .Data.ClusterHash }}
+{{- $resourceSuffix := printf "%s_%s" $specName .Fingerprint }}
+{{- $zone := .Data.DNSZone }}
+{{- $v4 := .Data.RecordData.IP }}
+{{- $v6 := .Data.RecordData.IPv6 }}
+
+provider "dns" {
+  update {
+    server        = "{{ $rfc2136.Server }}"
+    port          = {{ $rfc2136.Port }}
+    key_name      = "{{ $rfc2136.KeyName }}"
+    key_algorithm = "{{ $rfc2136.KeyAlgorithm }}"
+    key_secret    = trimspace(file("./{{ $specName }}"))
+  }
+  alias = "dns_{{ $resourceSuffix }}"
+}
+
+{{- $names := list .Data.Hostname }}
+{{- if hasExtension .Data "AlternativeNamesExtension" }}
+{{- $names = concat $names .Data.AlternativeNamesExtension.Names }}
+{{- end }}
+
+{{- range $i, $name := $names }}
+{{- $resource := $clusterId }}
+{{- $endpoint := $clusterId }}
+{{- if ne $i 0 }}
+{{- $resource = printf "%s_%s" $clusterId (sanitizeStringForResourceName $name) }}
+{{- $endpoint = printf "%s-%s" $clusterId $name }}
+{{- end }}
+
+{{- if $v4 }}
+
+resource "dns_a_record_set" "record_{{ $resource }}_{{ $resourceSuffix }}" {
+  provider  = dns.dns_{{ $resourceSuffix }}
+  zone      = "{{ $zone }}."
+  name      = "{{ $name }}"
+  addresses = [
+  {{- range $ip := $v4 }}
+    "{{ $ip.V4 }}",
+  {{- end }}
+  ]
+  ttl       = 300
+}
+{{- end }}
+
+{{- if $v6 }}
+
+resource "dns_aaaa_record_set" "record_{{ $resource }}_{{ $resourceSuffix }}" {
+  provider  = dns.dns_{{ $resourceSuffix }}
+  zone      = "{{ $zone }}."
+  name      = "{{ $name }}"
+  addresses = [
+  {{- range $ip := $v6 }}
+    "{{ $ip.V6 }}",
+  {{- end }}
+  ]
+  ttl       = 300
+}
+{{- end }}
+
+output "{{ $resource }}_{{ $resourceSuffix }}" {
+  value = { "{{ $endpoint }}-endpoint" = "{{ $name }}.{{ $zone }}" }
+}
+{{- end }}
@@ -31,6 +31,7 @@ type usedProvidersTemplateData struct {
 	CloudRift  bool
 	Verda      bool
 	OVH        bool
+	RFC2136    bool
 }
 
 // CreateUsedProviderDNS creates provider file used for DNS management.
@@ -130,5 +131,7 @@ func getDNSProvider(dns *spec.DNS, data *usedProvidersTemplateData) {
 		data.Exoscale = true
 	case "ovh":
 		data.OVH = true
+	case "rfc2136":
+		data.RFC2136 = true
 	}
 }
@@ -79,5 +79,11 @@ terraform {
       version = "~> 2.13"
     }
     {{- end }}
+    {{- if .RFC2136 }}
+    dns = {
+      source  = "hashicorp/dns"
+      version = "~> 3.4"
+    }
+    {{- end }}
   }
 }
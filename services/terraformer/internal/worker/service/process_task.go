@@ -53,6 +53,10 @@ type (
 
 		// Diagnostics during the processing of the received [Work.Task]
 		Diagnostics *Diagnostics
+
+		// Warnings are the non-fatal diagnostics during the processing
+		// of the received [Work.Task], reported back to the manager.
+		Warnings *Diagnostics
 	}
 
 	Diagnostics []error
@@ -78,6 +82,9 @@ func ProcessTask(ctx context.Context, stores Stores, work Work) *spec.TaskResult
 		// diags holds any errors throughout all of the passes.
 		diags Diagnostics
 
+		// warnings holds the non-fatal diagnostics throughout all of the passes.
+		warnings Diagnostics
+
 		// state the current state of the progress in [Work].
 		// Start with None and update result as passes make changes.
 		result = spec.TaskResult{Result: &spec.TaskResult_None_{None: new(spec.TaskResult_None)}}
@@ -100,6 +107,7 @@ passes:
 			Task:        work.Task,
 			Result:      &result,
 			Diagnostics: &diags,
+			Warnings:    &warnings,
 		}
 		last := len(diags)
 
@@ -149,6 +157,10 @@ passes:
 		}
 	}
 
+	for _, w := range warnings {
+		result.Warnings = append(result.Warnings, w.Error())
+	}
+
 	if len(diags) > 0 {
 		result.Error = &spec.TaskResult_Error{
 			Kind:        spec.TaskResult_Error_PARTIAL,
@@ -179,7 +191,7 @@ func BuildK8Scluster(logger zerolog.Logger, state kubernetes.K8Scluster) error {
 // the current and desired state based on the passed in [loadbalancer.LBcluster].
 // On success updates the [loadbalancer.LBcluster.CurrentState] to the desired state.
 // On failure, any desred infra is reverted back to current.
-func BuildLoadbalancers(logger zerolog.Logger, state loadbalancer.LBcluster) error {
+func BuildLoadbalancers(logger zerolog.Logger, state *loadbalancer.LBcluster) error {
 	logger.Info().Msg("Creating loadbalancer infrastructure")
 
 	if err := state.Build(logger); err != nil {
@@ -24,7 +24,7 @@ func addLoadBalancer(
 	}
 
 	buildLogger := logger.With().Str("cluster", lb.Cluster.ClusterInfo.Id()).Logger()
-	if err := BuildLoadbalancers(buildLogger, lb); err != nil {
+	if err := BuildLoadbalancers(buildLogger, &lb); err != nil {
 		buildLogger.Err(err).Msg("Failed to reconcile loadbalancer")
 		tracker.Diagnostics.Push(err)
 		// Some part of the loadbalancer infrastructure was not build successfully.
@@ -32,6 +32,10 @@ func addLoadBalancer(
 		// caller, fallthrough here.
 	}
 
+	for _, w := range lb.Warnings {
+		tracker.Warnings.Push(w)
+	}
+
 	update := tracker.Result.Update()
 	update.Loadbalancers(lb.Cluster)
 	update.Commit()
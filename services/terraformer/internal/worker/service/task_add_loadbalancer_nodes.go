@@ -83,7 +83,7 @@ func addLoadBalancerNodes(
 	}
 
 	buildLogger := logger.With().Str("cluster", lb.Id()).Logger()
-	if err := BuildLoadbalancers(buildLogger, lb); err != nil {
+	if err := BuildLoadbalancers(buildLogger, &lb); err != nil {
 		buildLogger.Err(err).Msg("Failed to reconcile cluster after nodes addition")
 		tracker.Diagnostics.Push(err)
 		// Contrary to the deletion process, during the addition if any partial changes
@@ -94,6 +94,10 @@ func addLoadBalancerNodes(
 		// fallthrough
 	}
 
+	for _, w := range lb.Warnings {
+		tracker.Warnings.Push(w)
+	}
+
 	update := tracker.Result.Update()
 	update.Loadbalancers(lb.Cluster)
 	update.Commit()
@@ -44,7 +44,7 @@ func addLoadBalancerRoles(
 	}
 
 	buildLogger := logger.With().Str("cluster", lb.Cluster.ClusterInfo.Id()).Logger()
-	if err := BuildLoadbalancers(buildLogger, lb); err != nil {
+	if err := BuildLoadbalancers(buildLogger, &lb); err != nil {
 		buildLogger.Err(err).Msg("Failed to reconcile cluster after roles addition")
 		tracker.Diagnostics.Push(err)
 		// Contrary to the deletion process, during the addition if any partial changes
@@ -55,6 +55,10 @@ func addLoadBalancerRoles(
 		// fallthrough
 	}
 
+	for _, w := range lb.Warnings {
+		tracker.Warnings.Push(w)
+	}
+
 	update := tracker.Result.Update()
 	update.Loadbalancers(lb.Cluster)
 	update.Commit()
@@ -81,9 +81,11 @@ func build(
 		})
 	}
 
-	err := concurrent.Exec(loadbalancers, func(_ int, cluster loadbalancer.LBcluster) error {
+	err := concurrent.Exec(loadbalancers, func(i int, cluster loadbalancer.LBcluster) error {
 		buildLogger := logger.With().Str("cluster", cluster.Id()).Logger()
-		return BuildLoadbalancers(buildLogger, cluster)
+		err := BuildLoadbalancers(buildLogger, &cluster)
+		loadbalancers[i].Warnings = cluster.Warnings
+		return err
 	})
 	if err != nil {
 		logger.Err(err).Msg("Failed to reconcile loadbalancers")
@@ -93,6 +95,12 @@ func build(
 		tracker.Diagnostics.Push(err)
 	}
 
+	for _, lb := range loadbalancers {
+		for _, w := range lb.Warnings {
+			tracker.Warnings.Push(w)
+		}
+	}
+
 	var (
 		updatedK8s                   = k8s
 		possiblyUpdatedLoadBalancers []*spec.LBcluster
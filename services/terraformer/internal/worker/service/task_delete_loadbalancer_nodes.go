@@ -70,12 +70,16 @@ func deleteLoadBalancerNodes(
 	}
 
 	buildLogger := logger.With().Str("cluster", lb.Id()).Logger()
-	if err := BuildLoadbalancers(buildLogger, lb); err != nil {
+	if err := BuildLoadbalancers(buildLogger, &lb); err != nil {
 		buildLogger.Err(err).Msg("Failed to reconcile cluster after nodes deletion")
 		tracker.Diagnostics.Push(err)
 		return
 	}
 
+	for _, w := range lb.Warnings {
+		tracker.Warnings.Push(w)
+	}
+
 	update := tracker.Result.Update()
 	update.Loadbalancers(lb.Cluster)
 	update.Commit()
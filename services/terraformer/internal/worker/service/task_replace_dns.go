@@ -2,7 +2,6 @@ package service
 
 import (
 	"github.com/berops/claudie/internal/clusters"
-	"github.com/berops/claudie/internal/nodepools"
 	"github.com/berops/claudie/proto/pb/spec"
 	"github.com/berops/claudie/services/terraformer/internal/worker/service/internal/loadbalancer"
 	"github.com/rs/zerolog"
@@ -49,7 +48,7 @@ func replaceDns(
 			ProjectName:       projectName,
 			ClusterName:       lb.ClusterInfo.Name,
 			ClusterHash:       lb.ClusterInfo.Hash,
-			NodeIPs:           nodepools.PublicEndpoints(lb.ClusterInfo.NodePools),
+			NodeIPs:           loadbalancer.RecordIPs(lb),
 			Dns:               current,
 			SpawnProcessLimit: processLimit,
 		}
@@ -70,7 +69,7 @@ func replaceDns(
 		ProjectName:       projectName,
 		ClusterName:       lb.ClusterInfo.Name,
 		ClusterHash:       lb.ClusterInfo.Hash,
-		NodeIPs:           nodepools.PublicEndpoints(lb.ClusterInfo.NodePools),
+		NodeIPs:           loadbalancer.RecordIPs(lb),
 		Dns:               lb.Dns,
 		SpawnProcessLimit: processLimit,
 	}
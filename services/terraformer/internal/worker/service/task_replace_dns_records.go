@@ -0,0 +1,67 @@
+package service
+
+import (
+	"github.com/berops/claudie/internal/clusters"
+	"github.com/berops/claudie/proto/pb/spec"
+	"github.com/berops/claudie/services/terraformer/internal/worker/service/internal/loadbalancer"
+	"github.com/rs/zerolog"
+
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/proto"
+)
+
+type ReplaceDnsRecords struct {
+	State   *spec.Update_State
+	Replace *spec.Update_TerraformerReplaceDnsRecords
+}
+
+func replaceDnsRecords(
+	logger zerolog.Logger,
+	projectName string,
+	processLimit *semaphore.Weighted,
+	action ReplaceDnsRecords,
+	tracker Tracker,
+) {
+	logger.Info().Msg("Replacing DNS records")
+
+	idx := clusters.IndexLoadbalancerById(action.Replace.Handle, action.State.LoadBalancers)
+	if idx < 0 {
+		logger.
+			Warn().
+			Msgf("Can't replace DNS records for loadbalancer %q that is missing from the received state", action.Replace.Handle)
+		return
+	}
+
+	lb := action.State.LoadBalancers[idx]
+	if lb.Dns == nil {
+		logger.
+			Warn().
+			Msgf("Can't replace DNS records for loadbalancer %q that has no DNS in the received state", action.Replace.Handle)
+		return
+	}
+
+	// The records are updated in-place, on failure the previous
+	// state is kept and the manager will schedule the task again.
+	dns := proto.Clone(lb.Dns).(*spec.DNS)
+	dns.UnreachableEndpoints = action.Replace.UnreachableEndpoints
+
+	records := loadbalancer.DNS{
+		ProjectName:       projectName,
+		ClusterName:       lb.ClusterInfo.Name,
+		ClusterHash:       lb.ClusterInfo.Hash,
+		NodeIPs:           loadbalancer.RecordIPs(lb),
+		Dns:               dns,
+		SpawnProcessLimit: processLimit,
+	}
+
+	if err := records.CreateDNSRecords(logger); err != nil {
+		logger.Err(err).Msg("Failed to replace DNS records")
+		tracker.Diagnostics.Push(err)
+		return
+	}
+
+	lb.Dns = dns
+	update := tracker.Result.Update()
+	update.Loadbalancers(lb)
+	update.Commit()
+}
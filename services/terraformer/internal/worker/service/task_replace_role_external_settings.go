@@ -51,6 +51,7 @@ func replaceRoleExternalSettings(
 	toEdit.Port = action.Replace.Port
 	toEdit.Protocol = action.Replace.Protocol
 	toEdit.RoleType = action.Replace.RoleType
+	toEdit.AllowedSourceRanges = action.Replace.AllowedSourceRanges
 
 	lb := loadbalancer.LBcluster{
 		ProjectName:       projectName,
@@ -59,7 +60,7 @@ func replaceRoleExternalSettings(
 	}
 
 	buildLogger := logger.With().Str("cluster", lb.Cluster.ClusterInfo.Id()).Logger()
-	if err := BuildLoadbalancers(buildLogger, lb); err != nil {
+	if err := BuildLoadbalancers(buildLogger, &lb); err != nil {
 		buildLogger.Err(err).Msg("Failed to reconcile cluster after role editing")
 		tracker.Diagnostics.Push(err)
 		// Contrary to the deletion process, during the addition if any partial changes
@@ -70,6 +71,10 @@ func replaceRoleExternalSettings(
 		// fallthrough
 	}
 
+	for _, w := range lb.Warnings {
+		tracker.Warnings.Push(w)
+	}
+
 	update := tracker.Result.Update()
 	update.Loadbalancers(lb.Cluster)
 	update.Commit()
@@ -90,6 +90,12 @@ func reconcileInfrastructure(
 			Replace: delta.TfReplaceDns,
 		}
 		replaceDns(logger, projectName, processLimit, action, tracker)
+	case *spec.Update_TfReplaceDnsRecords:
+		action := ReplaceDnsRecords{
+			State:   state,
+			Replace: delta.TfReplaceDnsRecords,
+		}
+		replaceDnsRecords(logger, projectName, processLimit, action, tracker)
 	case *spec.Update_DeleteLoadBalancer_:
 		id := delta.DeleteLoadBalancer.Handle
 		destroyLoadBalancer(logger, projectName, id, state.LoadBalancers, processLimit, stores, tracker)